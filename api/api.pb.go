@@ -21,6 +21,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type EntityCategory int32
+
+const (
+	EntityCategory_ENTITY_CATEGORY_NONE       EntityCategory = 0
+	EntityCategory_ENTITY_CATEGORY_CONFIG     EntityCategory = 1
+	EntityCategory_ENTITY_CATEGORY_DIAGNOSTIC EntityCategory = 2
+)
+
+var EntityCategory_name = map[int32]string{
+	0: "ENTITY_CATEGORY_NONE",
+	1: "ENTITY_CATEGORY_CONFIG",
+	2: "ENTITY_CATEGORY_DIAGNOSTIC",
+}
+
+var EntityCategory_value = map[string]int32{
+	"ENTITY_CATEGORY_NONE":       0,
+	"ENTITY_CATEGORY_CONFIG":     1,
+	"ENTITY_CATEGORY_DIAGNOSTIC": 2,
+}
+
+func (x EntityCategory) String() string {
+	return proto.EnumName(EntityCategory_name, int32(x))
+}
+
+func (EntityCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
 type LegacyCoverState int32
 
 const (
@@ -43,7 +71,7 @@ func (x LegacyCoverState) String() string {
 }
 
 func (LegacyCoverState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
 }
 
 type CoverOperation int32
@@ -71,7 +99,7 @@ func (x CoverOperation) String() string {
 }
 
 func (CoverOperation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 
 type LegacyCoverCommand int32
@@ -99,7 +127,7 @@ func (x LegacyCoverCommand) String() string {
 }
 
 func (LegacyCoverCommand) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 type FanSpeed int32
@@ -127,7 +155,142 @@ func (x FanSpeed) String() string {
 }
 
 func (FanSpeed) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+type FanDirection int32
+
+const (
+	FanDirection_FAN_DIRECTION_FORWARD FanDirection = 0
+	FanDirection_FAN_DIRECTION_REVERSE FanDirection = 1
+)
+
+var FanDirection_name = map[int32]string{
+	0: "FAN_DIRECTION_FORWARD",
+	1: "FAN_DIRECTION_REVERSE",
+}
+
+var FanDirection_value = map[string]int32{
+	"FAN_DIRECTION_FORWARD": 0,
+	"FAN_DIRECTION_REVERSE": 1,
+}
+
+func (x FanDirection) String() string {
+	return proto.EnumName(FanDirection_name, int32(x))
+}
+
+func (FanDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+// ==================== LIGHT ====================
+type ColorMode int32
+
+const (
+	ColorMode_COLOR_MODE_UNKNOWN               ColorMode = 0
+	ColorMode_COLOR_MODE_ON_OFF                ColorMode = 1
+	ColorMode_COLOR_MODE_BRIGHTNESS            ColorMode = 3
+	ColorMode_COLOR_MODE_WHITE                 ColorMode = 7
+	ColorMode_COLOR_MODE_COLOR_TEMPERATURE     ColorMode = 11
+	ColorMode_COLOR_MODE_COLD_WARM_WHITE       ColorMode = 19
+	ColorMode_COLOR_MODE_RGB                   ColorMode = 35
+	ColorMode_COLOR_MODE_RGB_WHITE             ColorMode = 39
+	ColorMode_COLOR_MODE_RGB_COLOR_TEMPERATURE ColorMode = 47
+	ColorMode_COLOR_MODE_RGB_COLD_WARM_WHITE   ColorMode = 51
+)
+
+var ColorMode_name = map[int32]string{
+	0:  "COLOR_MODE_UNKNOWN",
+	1:  "COLOR_MODE_ON_OFF",
+	3:  "COLOR_MODE_BRIGHTNESS",
+	7:  "COLOR_MODE_WHITE",
+	11: "COLOR_MODE_COLOR_TEMPERATURE",
+	19: "COLOR_MODE_COLD_WARM_WHITE",
+	35: "COLOR_MODE_RGB",
+	39: "COLOR_MODE_RGB_WHITE",
+	47: "COLOR_MODE_RGB_COLOR_TEMPERATURE",
+	51: "COLOR_MODE_RGB_COLD_WARM_WHITE",
+}
+
+var ColorMode_value = map[string]int32{
+	"COLOR_MODE_UNKNOWN":               0,
+	"COLOR_MODE_ON_OFF":                1,
+	"COLOR_MODE_BRIGHTNESS":            3,
+	"COLOR_MODE_WHITE":                 7,
+	"COLOR_MODE_COLOR_TEMPERATURE":     11,
+	"COLOR_MODE_COLD_WARM_WHITE":       19,
+	"COLOR_MODE_RGB":                   35,
+	"COLOR_MODE_RGB_WHITE":             39,
+	"COLOR_MODE_RGB_COLOR_TEMPERATURE": 47,
+	"COLOR_MODE_RGB_COLD_WARM_WHITE":   51,
+}
+
+func (x ColorMode) String() string {
+	return proto.EnumName(ColorMode_name, int32(x))
+}
+
+func (ColorMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+// ==================== SENSOR ====================
+type SensorStateClass int32
+
+const (
+	SensorStateClass_STATE_CLASS_NONE             SensorStateClass = 0
+	SensorStateClass_STATE_CLASS_MEASUREMENT      SensorStateClass = 1
+	SensorStateClass_STATE_CLASS_TOTAL_INCREASING SensorStateClass = 2
+	SensorStateClass_STATE_CLASS_TOTAL            SensorStateClass = 3
+)
+
+var SensorStateClass_name = map[int32]string{
+	0: "STATE_CLASS_NONE",
+	1: "STATE_CLASS_MEASUREMENT",
+	2: "STATE_CLASS_TOTAL_INCREASING",
+	3: "STATE_CLASS_TOTAL",
+}
+
+var SensorStateClass_value = map[string]int32{
+	"STATE_CLASS_NONE":             0,
+	"STATE_CLASS_MEASUREMENT":      1,
+	"STATE_CLASS_TOTAL_INCREASING": 2,
+	"STATE_CLASS_TOTAL":            3,
+}
+
+func (x SensorStateClass) String() string {
+	return proto.EnumName(SensorStateClass_name, int32(x))
+}
+
+func (SensorStateClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+}
+
+type SensorLastResetType int32
+
+const (
+	SensorLastResetType_LAST_RESET_NONE  SensorLastResetType = 0
+	SensorLastResetType_LAST_RESET_NEVER SensorLastResetType = 1
+	SensorLastResetType_LAST_RESET_AUTO  SensorLastResetType = 2
+)
+
+var SensorLastResetType_name = map[int32]string{
+	0: "LAST_RESET_NONE",
+	1: "LAST_RESET_NEVER",
+	2: "LAST_RESET_AUTO",
+}
+
+var SensorLastResetType_value = map[string]int32{
+	"LAST_RESET_NONE":  0,
+	"LAST_RESET_NEVER": 1,
+	"LAST_RESET_AUTO":  2,
+}
+
+func (x SensorLastResetType) String() string {
+	return proto.EnumName(SensorLastResetType_name, int32(x))
+}
+
+func (SensorLastResetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 // ==================== SUBSCRIBE LOGS ====================
@@ -138,9 +301,10 @@ const (
 	LogLevel_LOG_LEVEL_ERROR        LogLevel = 1
 	LogLevel_LOG_LEVEL_WARN         LogLevel = 2
 	LogLevel_LOG_LEVEL_INFO         LogLevel = 3
-	LogLevel_LOG_LEVEL_DEBUG        LogLevel = 4
-	LogLevel_LOG_LEVEL_VERBOSE      LogLevel = 5
-	LogLevel_LOG_LEVEL_VERY_VERBOSE LogLevel = 6
+	LogLevel_LOG_LEVEL_CONFIG       LogLevel = 4
+	LogLevel_LOG_LEVEL_DEBUG        LogLevel = 5
+	LogLevel_LOG_LEVEL_VERBOSE      LogLevel = 6
+	LogLevel_LOG_LEVEL_VERY_VERBOSE LogLevel = 7
 )
 
 var LogLevel_name = map[int32]string{
@@ -148,9 +312,10 @@ var LogLevel_name = map[int32]string{
 	1: "LOG_LEVEL_ERROR",
 	2: "LOG_LEVEL_WARN",
 	3: "LOG_LEVEL_INFO",
-	4: "LOG_LEVEL_DEBUG",
-	5: "LOG_LEVEL_VERBOSE",
-	6: "LOG_LEVEL_VERY_VERBOSE",
+	4: "LOG_LEVEL_CONFIG",
+	5: "LOG_LEVEL_DEBUG",
+	6: "LOG_LEVEL_VERBOSE",
+	7: "LOG_LEVEL_VERY_VERBOSE",
 }
 
 var LogLevel_value = map[string]int32{
@@ -158,9 +323,10 @@ var LogLevel_value = map[string]int32{
 	"LOG_LEVEL_ERROR":        1,
 	"LOG_LEVEL_WARN":         2,
 	"LOG_LEVEL_INFO":         3,
-	"LOG_LEVEL_DEBUG":        4,
-	"LOG_LEVEL_VERBOSE":      5,
-	"LOG_LEVEL_VERY_VERBOSE": 6,
+	"LOG_LEVEL_CONFIG":       4,
+	"LOG_LEVEL_DEBUG":        5,
+	"LOG_LEVEL_VERBOSE":      6,
+	"LOG_LEVEL_VERY_VERBOSE": 7,
 }
 
 func (x LogLevel) String() string {
@@ -168,7 +334,7 @@ func (x LogLevel) String() string {
 }
 
 func (LogLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
 // ==================== USER-DEFINES SERVICES ====================
@@ -212,37 +378,40 @@ func (x ServiceArgType) String() string {
 }
 
 func (ServiceArgType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
 // ==================== CLIMATE ====================
 type ClimateMode int32
 
 const (
-	ClimateMode_CLIMATE_MODE_OFF      ClimateMode = 0
-	ClimateMode_CLIMATE_MODE_AUTO     ClimateMode = 1
-	ClimateMode_CLIMATE_MODE_COOL     ClimateMode = 2
-	ClimateMode_CLIMATE_MODE_HEAT     ClimateMode = 3
-	ClimateMode_CLIMATE_MODE_FAN_ONLY ClimateMode = 4
-	ClimateMode_CLIMATE_MODE_DRY      ClimateMode = 5
+	ClimateMode_CLIMATE_MODE_OFF       ClimateMode = 0
+	ClimateMode_CLIMATE_MODE_HEAT_COOL ClimateMode = 1
+	ClimateMode_CLIMATE_MODE_COOL      ClimateMode = 2
+	ClimateMode_CLIMATE_MODE_HEAT      ClimateMode = 3
+	ClimateMode_CLIMATE_MODE_FAN_ONLY  ClimateMode = 4
+	ClimateMode_CLIMATE_MODE_DRY       ClimateMode = 5
+	ClimateMode_CLIMATE_MODE_AUTO      ClimateMode = 6
 )
 
 var ClimateMode_name = map[int32]string{
 	0: "CLIMATE_MODE_OFF",
-	1: "CLIMATE_MODE_AUTO",
+	1: "CLIMATE_MODE_HEAT_COOL",
 	2: "CLIMATE_MODE_COOL",
 	3: "CLIMATE_MODE_HEAT",
 	4: "CLIMATE_MODE_FAN_ONLY",
 	5: "CLIMATE_MODE_DRY",
+	6: "CLIMATE_MODE_AUTO",
 }
 
 var ClimateMode_value = map[string]int32{
-	"CLIMATE_MODE_OFF":      0,
-	"CLIMATE_MODE_AUTO":     1,
-	"CLIMATE_MODE_COOL":     2,
-	"CLIMATE_MODE_HEAT":     3,
-	"CLIMATE_MODE_FAN_ONLY": 4,
-	"CLIMATE_MODE_DRY":      5,
+	"CLIMATE_MODE_OFF":       0,
+	"CLIMATE_MODE_HEAT_COOL": 1,
+	"CLIMATE_MODE_COOL":      2,
+	"CLIMATE_MODE_HEAT":      3,
+	"CLIMATE_MODE_FAN_ONLY":  4,
+	"CLIMATE_MODE_DRY":       5,
+	"CLIMATE_MODE_AUTO":      6,
 }
 
 func (x ClimateMode) String() string {
@@ -250,7 +419,7 @@ func (x ClimateMode) String() string {
 }
 
 func (ClimateMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
 type ClimateFanMode int32
@@ -265,6 +434,7 @@ const (
 	ClimateFanMode_CLIMATE_FAN_MIDDLE  ClimateFanMode = 6
 	ClimateFanMode_CLIMATE_FAN_FOCUS   ClimateFanMode = 7
 	ClimateFanMode_CLIMATE_FAN_DIFFUSE ClimateFanMode = 8
+	ClimateFanMode_CLIMATE_FAN_QUIET   ClimateFanMode = 9
 )
 
 var ClimateFanMode_name = map[int32]string{
@@ -277,6 +447,7 @@ var ClimateFanMode_name = map[int32]string{
 	6: "CLIMATE_FAN_MIDDLE",
 	7: "CLIMATE_FAN_FOCUS",
 	8: "CLIMATE_FAN_DIFFUSE",
+	9: "CLIMATE_FAN_QUIET",
 }
 
 var ClimateFanMode_value = map[string]int32{
@@ -289,6 +460,7 @@ var ClimateFanMode_value = map[string]int32{
 	"CLIMATE_FAN_MIDDLE":  6,
 	"CLIMATE_FAN_FOCUS":   7,
 	"CLIMATE_FAN_DIFFUSE": 8,
+	"CLIMATE_FAN_QUIET":   9,
 }
 
 func (x ClimateFanMode) String() string {
@@ -296,7 +468,7 @@ func (x ClimateFanMode) String() string {
 }
 
 func (ClimateFanMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
 type ClimateSwingMode int32
@@ -305,21 +477,21 @@ const (
 	ClimateSwingMode_CLIMATE_SWING_OFF        ClimateSwingMode = 0
 	ClimateSwingMode_CLIMATE_SWING_BOTH       ClimateSwingMode = 1
 	ClimateSwingMode_CLIMATE_SWING_VERTICAL   ClimateSwingMode = 2
-	ClimateSwingMode_CLIMATE_SWINT_HORIZONTAL ClimateSwingMode = 3
+	ClimateSwingMode_CLIMATE_SWING_HORIZONTAL ClimateSwingMode = 3
 )
 
 var ClimateSwingMode_name = map[int32]string{
 	0: "CLIMATE_SWING_OFF",
 	1: "CLIMATE_SWING_BOTH",
 	2: "CLIMATE_SWING_VERTICAL",
-	3: "CLIMATE_SWINT_HORIZONTAL",
+	3: "CLIMATE_SWING_HORIZONTAL",
 }
 
 var ClimateSwingMode_value = map[string]int32{
 	"CLIMATE_SWING_OFF":        0,
 	"CLIMATE_SWING_BOTH":       1,
 	"CLIMATE_SWING_VERTICAL":   2,
-	"CLIMATE_SWINT_HORIZONTAL": 3,
+	"CLIMATE_SWING_HORIZONTAL": 3,
 }
 
 func (x ClimateSwingMode) String() string {
@@ -327,7 +499,7 @@ func (x ClimateSwingMode) String() string {
 }
 
 func (ClimateSwingMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 
 type ClimateAction int32
@@ -365,1987 +537,8299 @@ func (x ClimateAction) String() string {
 }
 
 func (ClimateAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-// Message sent at the beginning of each connection
-// Can only be sent by the client and only at the beginning of the connection
-type HelloRequest struct {
-	// Description of client (like User Agent)
-	// For example "Home Assistant"
-	// Not strictly necessary to send but nice for debugging
-	// purposes.
-	ClientInfo           string   `protobuf:"bytes,1,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ClimatePreset int32
+
+const (
+	ClimatePreset_CLIMATE_PRESET_NONE     ClimatePreset = 0
+	ClimatePreset_CLIMATE_PRESET_HOME     ClimatePreset = 1
+	ClimatePreset_CLIMATE_PRESET_AWAY     ClimatePreset = 2
+	ClimatePreset_CLIMATE_PRESET_BOOST    ClimatePreset = 3
+	ClimatePreset_CLIMATE_PRESET_COMFORT  ClimatePreset = 4
+	ClimatePreset_CLIMATE_PRESET_ECO      ClimatePreset = 5
+	ClimatePreset_CLIMATE_PRESET_SLEEP    ClimatePreset = 6
+	ClimatePreset_CLIMATE_PRESET_ACTIVITY ClimatePreset = 7
+)
+
+var ClimatePreset_name = map[int32]string{
+	0: "CLIMATE_PRESET_NONE",
+	1: "CLIMATE_PRESET_HOME",
+	2: "CLIMATE_PRESET_AWAY",
+	3: "CLIMATE_PRESET_BOOST",
+	4: "CLIMATE_PRESET_COMFORT",
+	5: "CLIMATE_PRESET_ECO",
+	6: "CLIMATE_PRESET_SLEEP",
+	7: "CLIMATE_PRESET_ACTIVITY",
 }
 
-func (m *HelloRequest) Reset()         { *m = HelloRequest{} }
-func (m *HelloRequest) String() string { return proto.CompactTextString(m) }
-func (*HelloRequest) ProtoMessage()    {}
-func (*HelloRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+var ClimatePreset_value = map[string]int32{
+	"CLIMATE_PRESET_NONE":     0,
+	"CLIMATE_PRESET_HOME":     1,
+	"CLIMATE_PRESET_AWAY":     2,
+	"CLIMATE_PRESET_BOOST":    3,
+	"CLIMATE_PRESET_COMFORT":  4,
+	"CLIMATE_PRESET_ECO":      5,
+	"CLIMATE_PRESET_SLEEP":    6,
+	"CLIMATE_PRESET_ACTIVITY": 7,
 }
 
-func (m *HelloRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HelloRequest.Unmarshal(m, b)
+func (x ClimatePreset) String() string {
+	return proto.EnumName(ClimatePreset_name, int32(x))
 }
-func (m *HelloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HelloRequest.Marshal(b, m, deterministic)
+
+func (ClimatePreset) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
-func (m *HelloRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HelloRequest.Merge(m, src)
+
+// ==================== NUMBER ====================
+type NumberMode int32
+
+const (
+	NumberMode_NUMBER_MODE_AUTO   NumberMode = 0
+	NumberMode_NUMBER_MODE_BOX    NumberMode = 1
+	NumberMode_NUMBER_MODE_SLIDER NumberMode = 2
+)
+
+var NumberMode_name = map[int32]string{
+	0: "NUMBER_MODE_AUTO",
+	1: "NUMBER_MODE_BOX",
+	2: "NUMBER_MODE_SLIDER",
 }
-func (m *HelloRequest) XXX_Size() int {
-	return xxx_messageInfo_HelloRequest.Size(m)
+
+var NumberMode_value = map[string]int32{
+	"NUMBER_MODE_AUTO":   0,
+	"NUMBER_MODE_BOX":    1,
+	"NUMBER_MODE_SLIDER": 2,
 }
-func (m *HelloRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HelloRequest.DiscardUnknown(m)
+
+func (x NumberMode) String() string {
+	return proto.EnumName(NumberMode_name, int32(x))
 }
 
-var xxx_messageInfo_HelloRequest proto.InternalMessageInfo
+func (NumberMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+}
 
-func (m *HelloRequest) GetClientInfo() string {
-	if m != nil {
-		return m.ClientInfo
-	}
-	return ""
+// ==================== LOCK ====================
+type LockState int32
+
+const (
+	LockState_LOCK_STATE_NONE      LockState = 0
+	LockState_LOCK_STATE_LOCKED    LockState = 1
+	LockState_LOCK_STATE_UNLOCKED  LockState = 2
+	LockState_LOCK_STATE_JAMMED    LockState = 3
+	LockState_LOCK_STATE_LOCKING   LockState = 4
+	LockState_LOCK_STATE_UNLOCKING LockState = 5
+)
+
+var LockState_name = map[int32]string{
+	0: "LOCK_STATE_NONE",
+	1: "LOCK_STATE_LOCKED",
+	2: "LOCK_STATE_UNLOCKED",
+	3: "LOCK_STATE_JAMMED",
+	4: "LOCK_STATE_LOCKING",
+	5: "LOCK_STATE_UNLOCKING",
 }
 
-// Confirmation of successful connection request.
-// Can only be sent by the server and only at the beginning of the connection
-type HelloResponse struct {
-	// The version of the API to use. The _client_ (for example Home Assistant) needs to check
-	// for compatibility and if necessary adopt to an older API.
-	// Major is for breaking changes in the base protocol - a mismatch will lead to immediate disconnect_client_
-	// Minor is for breaking changes in individual messages - a mismatch will lead to a warning message
-	ApiVersionMajor uint32 `protobuf:"varint,1,opt,name=api_version_major,json=apiVersionMajor,proto3" json:"api_version_major,omitempty"`
-	ApiVersionMinor uint32 `protobuf:"varint,2,opt,name=api_version_minor,json=apiVersionMinor,proto3" json:"api_version_minor,omitempty"`
-	// A string identifying the server (ESP); like client info this may be empty
-	// and only exists for debugging/logging purposes.
-	// For example "ESPHome v1.10.0 on ESP8266"
-	ServerInfo           string   `protobuf:"bytes,3,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+var LockState_value = map[string]int32{
+	"LOCK_STATE_NONE":      0,
+	"LOCK_STATE_LOCKED":    1,
+	"LOCK_STATE_UNLOCKED":  2,
+	"LOCK_STATE_JAMMED":    3,
+	"LOCK_STATE_LOCKING":   4,
+	"LOCK_STATE_UNLOCKING": 5,
 }
 
-func (m *HelloResponse) Reset()         { *m = HelloResponse{} }
-func (m *HelloResponse) String() string { return proto.CompactTextString(m) }
-func (*HelloResponse) ProtoMessage()    {}
-func (*HelloResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+func (x LockState) String() string {
+	return proto.EnumName(LockState_name, int32(x))
 }
 
-func (m *HelloResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HelloResponse.Unmarshal(m, b)
+func (LockState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
-func (m *HelloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HelloResponse.Marshal(b, m, deterministic)
+
+type LockCommand int32
+
+const (
+	LockCommand_LOCK_UNLOCK LockCommand = 0
+	LockCommand_LOCK_LOCK   LockCommand = 1
+	LockCommand_LOCK_OPEN   LockCommand = 2
+)
+
+var LockCommand_name = map[int32]string{
+	0: "LOCK_UNLOCK",
+	1: "LOCK_LOCK",
+	2: "LOCK_OPEN",
 }
-func (m *HelloResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HelloResponse.Merge(m, src)
+
+var LockCommand_value = map[string]int32{
+	"LOCK_UNLOCK": 0,
+	"LOCK_LOCK":   1,
+	"LOCK_OPEN":   2,
 }
-func (m *HelloResponse) XXX_Size() int {
-	return xxx_messageInfo_HelloResponse.Size(m)
+
+func (x LockCommand) String() string {
+	return proto.EnumName(LockCommand_name, int32(x))
 }
-func (m *HelloResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HelloResponse.DiscardUnknown(m)
+
+func (LockCommand) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
-var xxx_messageInfo_HelloResponse proto.InternalMessageInfo
+// ==================== MEDIA PLAYER ====================
+type MediaPlayerState int32
 
-func (m *HelloResponse) GetApiVersionMajor() uint32 {
-	if m != nil {
-		return m.ApiVersionMajor
-	}
-	return 0
+const (
+	MediaPlayerState_MEDIA_PLAYER_STATE_NONE    MediaPlayerState = 0
+	MediaPlayerState_MEDIA_PLAYER_STATE_IDLE    MediaPlayerState = 1
+	MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING MediaPlayerState = 2
+	MediaPlayerState_MEDIA_PLAYER_STATE_PAUSED  MediaPlayerState = 3
+)
+
+var MediaPlayerState_name = map[int32]string{
+	0: "MEDIA_PLAYER_STATE_NONE",
+	1: "MEDIA_PLAYER_STATE_IDLE",
+	2: "MEDIA_PLAYER_STATE_PLAYING",
+	3: "MEDIA_PLAYER_STATE_PAUSED",
 }
 
-func (m *HelloResponse) GetApiVersionMinor() uint32 {
-	if m != nil {
-		return m.ApiVersionMinor
-	}
-	return 0
+var MediaPlayerState_value = map[string]int32{
+	"MEDIA_PLAYER_STATE_NONE":    0,
+	"MEDIA_PLAYER_STATE_IDLE":    1,
+	"MEDIA_PLAYER_STATE_PLAYING": 2,
+	"MEDIA_PLAYER_STATE_PAUSED":  3,
 }
 
-func (m *HelloResponse) GetServerInfo() string {
-	if m != nil {
-		return m.ServerInfo
-	}
-	return ""
+func (x MediaPlayerState) String() string {
+	return proto.EnumName(MediaPlayerState_name, int32(x))
 }
 
-// Message sent at the beginning of each connection to authenticate the client
-// Can only be sent by the client and only at the beginning of the connection
-type ConnectRequest struct {
-	// The password to log in with
-	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (MediaPlayerState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
-func (m *ConnectRequest) Reset()         { *m = ConnectRequest{} }
-func (m *ConnectRequest) String() string { return proto.CompactTextString(m) }
-func (*ConnectRequest) ProtoMessage()    {}
-func (*ConnectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+type MediaPlayerCommand int32
+
+const (
+	MediaPlayerCommand_MEDIA_PLAYER_COMMAND_PLAY   MediaPlayerCommand = 0
+	MediaPlayerCommand_MEDIA_PLAYER_COMMAND_PAUSE  MediaPlayerCommand = 1
+	MediaPlayerCommand_MEDIA_PLAYER_COMMAND_STOP   MediaPlayerCommand = 2
+	MediaPlayerCommand_MEDIA_PLAYER_COMMAND_MUTE   MediaPlayerCommand = 3
+	MediaPlayerCommand_MEDIA_PLAYER_COMMAND_UNMUTE MediaPlayerCommand = 4
+)
+
+var MediaPlayerCommand_name = map[int32]string{
+	0: "MEDIA_PLAYER_COMMAND_PLAY",
+	1: "MEDIA_PLAYER_COMMAND_PAUSE",
+	2: "MEDIA_PLAYER_COMMAND_STOP",
+	3: "MEDIA_PLAYER_COMMAND_MUTE",
+	4: "MEDIA_PLAYER_COMMAND_UNMUTE",
 }
 
-func (m *ConnectRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConnectRequest.Unmarshal(m, b)
+var MediaPlayerCommand_value = map[string]int32{
+	"MEDIA_PLAYER_COMMAND_PLAY":   0,
+	"MEDIA_PLAYER_COMMAND_PAUSE":  1,
+	"MEDIA_PLAYER_COMMAND_STOP":   2,
+	"MEDIA_PLAYER_COMMAND_MUTE":   3,
+	"MEDIA_PLAYER_COMMAND_UNMUTE": 4,
 }
-func (m *ConnectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConnectRequest.Marshal(b, m, deterministic)
+
+func (x MediaPlayerCommand) String() string {
+	return proto.EnumName(MediaPlayerCommand_name, int32(x))
 }
-func (m *ConnectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectRequest.Merge(m, src)
+
+func (MediaPlayerCommand) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
-func (m *ConnectRequest) XXX_Size() int {
-	return xxx_messageInfo_ConnectRequest.Size(m)
+
+type MediaPlayerFormatPurpose int32
+
+const (
+	MediaPlayerFormatPurpose_MEDIA_PLAYER_FORMAT_PURPOSE_DEFAULT      MediaPlayerFormatPurpose = 0
+	MediaPlayerFormatPurpose_MEDIA_PLAYER_FORMAT_PURPOSE_ANNOUNCEMENT MediaPlayerFormatPurpose = 1
+)
+
+var MediaPlayerFormatPurpose_name = map[int32]string{
+	0: "MEDIA_PLAYER_FORMAT_PURPOSE_DEFAULT",
+	1: "MEDIA_PLAYER_FORMAT_PURPOSE_ANNOUNCEMENT",
 }
-func (m *ConnectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectRequest.DiscardUnknown(m)
+
+var MediaPlayerFormatPurpose_value = map[string]int32{
+	"MEDIA_PLAYER_FORMAT_PURPOSE_DEFAULT":      0,
+	"MEDIA_PLAYER_FORMAT_PURPOSE_ANNOUNCEMENT": 1,
 }
 
-var xxx_messageInfo_ConnectRequest proto.InternalMessageInfo
+func (x MediaPlayerFormatPurpose) String() string {
+	return proto.EnumName(MediaPlayerFormatPurpose_name, int32(x))
+}
 
-func (m *ConnectRequest) GetPassword() string {
-	if m != nil {
-		return m.Password
-	}
-	return ""
+func (MediaPlayerFormatPurpose) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
-// Confirmation of successful connection. After this the connection is available for all traffic.
-// Can only be sent by the server and only at the beginning of the connection
-type ConnectResponse struct {
-	InvalidPassword      bool     `protobuf:"varint,1,opt,name=invalid_password,json=invalidPassword,proto3" json:"invalid_password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BluetoothDeviceRequestType int32
+
+const (
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT                  BluetoothDeviceRequestType = 0
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_DISCONNECT               BluetoothDeviceRequestType = 1
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_PAIR                     BluetoothDeviceRequestType = 2
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_UNPAIR                   BluetoothDeviceRequestType = 3
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT_V3_WITH_CACHE    BluetoothDeviceRequestType = 4
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT_V3_WITHOUT_CACHE BluetoothDeviceRequestType = 5
+	BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_CLEAR_CACHE              BluetoothDeviceRequestType = 6
+)
+
+var BluetoothDeviceRequestType_name = map[int32]string{
+	0: "BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT",
+	1: "BLUETOOTH_DEVICE_REQUEST_TYPE_DISCONNECT",
+	2: "BLUETOOTH_DEVICE_REQUEST_TYPE_PAIR",
+	3: "BLUETOOTH_DEVICE_REQUEST_TYPE_UNPAIR",
+	4: "BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT_V3_WITH_CACHE",
+	5: "BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT_V3_WITHOUT_CACHE",
+	6: "BLUETOOTH_DEVICE_REQUEST_TYPE_CLEAR_CACHE",
 }
 
-func (m *ConnectResponse) Reset()         { *m = ConnectResponse{} }
-func (m *ConnectResponse) String() string { return proto.CompactTextString(m) }
-func (*ConnectResponse) ProtoMessage()    {}
-func (*ConnectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+var BluetoothDeviceRequestType_value = map[string]int32{
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT":                  0,
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_DISCONNECT":               1,
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_PAIR":                     2,
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_UNPAIR":                   3,
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT_V3_WITH_CACHE":    4,
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT_V3_WITHOUT_CACHE": 5,
+	"BLUETOOTH_DEVICE_REQUEST_TYPE_CLEAR_CACHE":              6,
 }
 
-func (m *ConnectResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConnectResponse.Unmarshal(m, b)
+func (x BluetoothDeviceRequestType) String() string {
+	return proto.EnumName(BluetoothDeviceRequestType_name, int32(x))
 }
-func (m *ConnectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConnectResponse.Marshal(b, m, deterministic)
+
+func (BluetoothDeviceRequestType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+type VoiceAssistantEvent int32
+
+const (
+	VoiceAssistantEvent_VOICE_ASSISTANT_ERROR            VoiceAssistantEvent = 0
+	VoiceAssistantEvent_VOICE_ASSISTANT_RUN_START        VoiceAssistantEvent = 1
+	VoiceAssistantEvent_VOICE_ASSISTANT_RUN_END          VoiceAssistantEvent = 2
+	VoiceAssistantEvent_VOICE_ASSISTANT_STT_START        VoiceAssistantEvent = 3
+	VoiceAssistantEvent_VOICE_ASSISTANT_STT_END          VoiceAssistantEvent = 4
+	VoiceAssistantEvent_VOICE_ASSISTANT_INTENT_START     VoiceAssistantEvent = 5
+	VoiceAssistantEvent_VOICE_ASSISTANT_INTENT_END       VoiceAssistantEvent = 6
+	VoiceAssistantEvent_VOICE_ASSISTANT_TTS_START        VoiceAssistantEvent = 7
+	VoiceAssistantEvent_VOICE_ASSISTANT_TTS_END          VoiceAssistantEvent = 8
+	VoiceAssistantEvent_VOICE_ASSISTANT_WAKE_WORD_START  VoiceAssistantEvent = 9
+	VoiceAssistantEvent_VOICE_ASSISTANT_WAKE_WORD_END    VoiceAssistantEvent = 10
+	VoiceAssistantEvent_VOICE_ASSISTANT_STT_VAD_START    VoiceAssistantEvent = 11
+	VoiceAssistantEvent_VOICE_ASSISTANT_STT_VAD_END      VoiceAssistantEvent = 12
+	VoiceAssistantEvent_VOICE_ASSISTANT_TTS_STREAM_START VoiceAssistantEvent = 98
+	VoiceAssistantEvent_VOICE_ASSISTANT_TTS_STREAM_END   VoiceAssistantEvent = 99
+)
+
+var VoiceAssistantEvent_name = map[int32]string{
+	0:  "VOICE_ASSISTANT_ERROR",
+	1:  "VOICE_ASSISTANT_RUN_START",
+	2:  "VOICE_ASSISTANT_RUN_END",
+	3:  "VOICE_ASSISTANT_STT_START",
+	4:  "VOICE_ASSISTANT_STT_END",
+	5:  "VOICE_ASSISTANT_INTENT_START",
+	6:  "VOICE_ASSISTANT_INTENT_END",
+	7:  "VOICE_ASSISTANT_TTS_START",
+	8:  "VOICE_ASSISTANT_TTS_END",
+	9:  "VOICE_ASSISTANT_WAKE_WORD_START",
+	10: "VOICE_ASSISTANT_WAKE_WORD_END",
+	11: "VOICE_ASSISTANT_STT_VAD_START",
+	12: "VOICE_ASSISTANT_STT_VAD_END",
+	98: "VOICE_ASSISTANT_TTS_STREAM_START",
+	99: "VOICE_ASSISTANT_TTS_STREAM_END",
+}
+
+var VoiceAssistantEvent_value = map[string]int32{
+	"VOICE_ASSISTANT_ERROR":            0,
+	"VOICE_ASSISTANT_RUN_START":        1,
+	"VOICE_ASSISTANT_RUN_END":          2,
+	"VOICE_ASSISTANT_STT_START":        3,
+	"VOICE_ASSISTANT_STT_END":          4,
+	"VOICE_ASSISTANT_INTENT_START":     5,
+	"VOICE_ASSISTANT_INTENT_END":       6,
+	"VOICE_ASSISTANT_TTS_START":        7,
+	"VOICE_ASSISTANT_TTS_END":          8,
+	"VOICE_ASSISTANT_WAKE_WORD_START":  9,
+	"VOICE_ASSISTANT_WAKE_WORD_END":    10,
+	"VOICE_ASSISTANT_STT_VAD_START":    11,
+	"VOICE_ASSISTANT_STT_VAD_END":      12,
+	"VOICE_ASSISTANT_TTS_STREAM_START": 98,
+	"VOICE_ASSISTANT_TTS_STREAM_END":   99,
+}
+
+func (x VoiceAssistantEvent) String() string {
+	return proto.EnumName(VoiceAssistantEvent_name, int32(x))
+}
+
+func (VoiceAssistantEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+}
+
+type VoiceAssistantTimerEvent int32
+
+const (
+	VoiceAssistantTimerEvent_VOICE_ASSISTANT_TIMER_STARTED   VoiceAssistantTimerEvent = 0
+	VoiceAssistantTimerEvent_VOICE_ASSISTANT_TIMER_UPDATED   VoiceAssistantTimerEvent = 1
+	VoiceAssistantTimerEvent_VOICE_ASSISTANT_TIMER_CANCELLED VoiceAssistantTimerEvent = 2
+	VoiceAssistantTimerEvent_VOICE_ASSISTANT_TIMER_FINISHED  VoiceAssistantTimerEvent = 3
+)
+
+var VoiceAssistantTimerEvent_name = map[int32]string{
+	0: "VOICE_ASSISTANT_TIMER_STARTED",
+	1: "VOICE_ASSISTANT_TIMER_UPDATED",
+	2: "VOICE_ASSISTANT_TIMER_CANCELLED",
+	3: "VOICE_ASSISTANT_TIMER_FINISHED",
+}
+
+var VoiceAssistantTimerEvent_value = map[string]int32{
+	"VOICE_ASSISTANT_TIMER_STARTED":   0,
+	"VOICE_ASSISTANT_TIMER_UPDATED":   1,
+	"VOICE_ASSISTANT_TIMER_CANCELLED": 2,
+	"VOICE_ASSISTANT_TIMER_FINISHED":  3,
+}
+
+func (x VoiceAssistantTimerEvent) String() string {
+	return proto.EnumName(VoiceAssistantTimerEvent_name, int32(x))
+}
+
+func (VoiceAssistantTimerEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+
+// ==================== ALARM CONTROL PANEL ====================
+type AlarmControlPanelState int32
+
+const (
+	AlarmControlPanelState_ALARM_STATE_DISARMED            AlarmControlPanelState = 0
+	AlarmControlPanelState_ALARM_STATE_ARMED_HOME          AlarmControlPanelState = 1
+	AlarmControlPanelState_ALARM_STATE_ARMED_AWAY          AlarmControlPanelState = 2
+	AlarmControlPanelState_ALARM_STATE_ARMED_NIGHT         AlarmControlPanelState = 3
+	AlarmControlPanelState_ALARM_STATE_ARMED_VACATION      AlarmControlPanelState = 4
+	AlarmControlPanelState_ALARM_STATE_ARMED_CUSTOM_BYPASS AlarmControlPanelState = 5
+	AlarmControlPanelState_ALARM_STATE_PENDING             AlarmControlPanelState = 6
+	AlarmControlPanelState_ALARM_STATE_ARMING              AlarmControlPanelState = 7
+	AlarmControlPanelState_ALARM_STATE_DISARMING           AlarmControlPanelState = 8
+	AlarmControlPanelState_ALARM_STATE_TRIGGERED           AlarmControlPanelState = 9
+)
+
+var AlarmControlPanelState_name = map[int32]string{
+	0: "ALARM_STATE_DISARMED",
+	1: "ALARM_STATE_ARMED_HOME",
+	2: "ALARM_STATE_ARMED_AWAY",
+	3: "ALARM_STATE_ARMED_NIGHT",
+	4: "ALARM_STATE_ARMED_VACATION",
+	5: "ALARM_STATE_ARMED_CUSTOM_BYPASS",
+	6: "ALARM_STATE_PENDING",
+	7: "ALARM_STATE_ARMING",
+	8: "ALARM_STATE_DISARMING",
+	9: "ALARM_STATE_TRIGGERED",
+}
+
+var AlarmControlPanelState_value = map[string]int32{
+	"ALARM_STATE_DISARMED":            0,
+	"ALARM_STATE_ARMED_HOME":          1,
+	"ALARM_STATE_ARMED_AWAY":          2,
+	"ALARM_STATE_ARMED_NIGHT":         3,
+	"ALARM_STATE_ARMED_VACATION":      4,
+	"ALARM_STATE_ARMED_CUSTOM_BYPASS": 5,
+	"ALARM_STATE_PENDING":             6,
+	"ALARM_STATE_ARMING":              7,
+	"ALARM_STATE_DISARMING":           8,
+	"ALARM_STATE_TRIGGERED":           9,
+}
+
+func (x AlarmControlPanelState) String() string {
+	return proto.EnumName(AlarmControlPanelState_name, int32(x))
+}
+
+func (AlarmControlPanelState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+}
+
+type AlarmControlPanelStateCommand int32
+
+const (
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_DISARM            AlarmControlPanelStateCommand = 0
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_AWAY          AlarmControlPanelStateCommand = 1
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_HOME          AlarmControlPanelStateCommand = 2
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_NIGHT         AlarmControlPanelStateCommand = 3
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_VACATION      AlarmControlPanelStateCommand = 4
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_CUSTOM_BYPASS AlarmControlPanelStateCommand = 5
+	AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_TRIGGER           AlarmControlPanelStateCommand = 6
+)
+
+var AlarmControlPanelStateCommand_name = map[int32]string{
+	0: "ALARM_CONTROL_PANEL_DISARM",
+	1: "ALARM_CONTROL_PANEL_ARM_AWAY",
+	2: "ALARM_CONTROL_PANEL_ARM_HOME",
+	3: "ALARM_CONTROL_PANEL_ARM_NIGHT",
+	4: "ALARM_CONTROL_PANEL_ARM_VACATION",
+	5: "ALARM_CONTROL_PANEL_ARM_CUSTOM_BYPASS",
+	6: "ALARM_CONTROL_PANEL_TRIGGER",
+}
+
+var AlarmControlPanelStateCommand_value = map[string]int32{
+	"ALARM_CONTROL_PANEL_DISARM":            0,
+	"ALARM_CONTROL_PANEL_ARM_AWAY":          1,
+	"ALARM_CONTROL_PANEL_ARM_HOME":          2,
+	"ALARM_CONTROL_PANEL_ARM_NIGHT":         3,
+	"ALARM_CONTROL_PANEL_ARM_VACATION":      4,
+	"ALARM_CONTROL_PANEL_ARM_CUSTOM_BYPASS": 5,
+	"ALARM_CONTROL_PANEL_TRIGGER":           6,
+}
+
+func (x AlarmControlPanelStateCommand) String() string {
+	return proto.EnumName(AlarmControlPanelStateCommand_name, int32(x))
+}
+
+func (AlarmControlPanelStateCommand) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+// ===================== TEXT =====================
+type TextMode int32
+
+const (
+	TextMode_TEXT_MODE_TEXT     TextMode = 0
+	TextMode_TEXT_MODE_PASSWORD TextMode = 1
+)
+
+var TextMode_name = map[int32]string{
+	0: "TEXT_MODE_TEXT",
+	1: "TEXT_MODE_PASSWORD",
+}
+
+var TextMode_value = map[string]int32{
+	"TEXT_MODE_TEXT":     0,
+	"TEXT_MODE_PASSWORD": 1,
+}
+
+func (x TextMode) String() string {
+	return proto.EnumName(TextMode_name, int32(x))
+}
+
+func (TextMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+type ValveOperation int32
+
+const (
+	ValveOperation_VALVE_OPERATION_IDLE       ValveOperation = 0
+	ValveOperation_VALVE_OPERATION_IS_OPENING ValveOperation = 1
+	ValveOperation_VALVE_OPERATION_IS_CLOSING ValveOperation = 2
+)
+
+var ValveOperation_name = map[int32]string{
+	0: "VALVE_OPERATION_IDLE",
+	1: "VALVE_OPERATION_IS_OPENING",
+	2: "VALVE_OPERATION_IS_CLOSING",
+}
+
+var ValveOperation_value = map[string]int32{
+	"VALVE_OPERATION_IDLE":       0,
+	"VALVE_OPERATION_IS_OPENING": 1,
+	"VALVE_OPERATION_IS_CLOSING": 2,
+}
+
+func (x ValveOperation) String() string {
+	return proto.EnumName(ValveOperation_name, int32(x))
+}
+
+func (ValveOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+}
+
+// ==================== UPDATE ====================
+type UpdateCommand int32
+
+const (
+	UpdateCommand_UPDATE_COMMAND_NONE   UpdateCommand = 0
+	UpdateCommand_UPDATE_COMMAND_UPDATE UpdateCommand = 1
+	UpdateCommand_UPDATE_COMMAND_CHECK  UpdateCommand = 2
+)
+
+var UpdateCommand_name = map[int32]string{
+	0: "UPDATE_COMMAND_NONE",
+	1: "UPDATE_COMMAND_UPDATE",
+	2: "UPDATE_COMMAND_CHECK",
+}
+
+var UpdateCommand_value = map[string]int32{
+	"UPDATE_COMMAND_NONE":   0,
+	"UPDATE_COMMAND_UPDATE": 1,
+	"UPDATE_COMMAND_CHECK":  2,
+}
+
+func (x UpdateCommand) String() string {
+	return proto.EnumName(UpdateCommand_name, int32(x))
+}
+
+func (UpdateCommand) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+}
+
+// Message sent at the beginning of each connection
+// Can only be sent by the client and only at the beginning of the connection
+type HelloRequest struct {
+	// Description of client (like User Agent)
+	// For example "Home Assistant"
+	// Not strictly necessary to send but nice for debugging
+	// purposes.
+	ClientInfo           string   `protobuf:"bytes,1,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	ApiVersionMajor      uint32   `protobuf:"varint,2,opt,name=api_version_major,json=apiVersionMajor,proto3" json:"api_version_major,omitempty"`
+	ApiVersionMinor      uint32   `protobuf:"varint,3,opt,name=api_version_minor,json=apiVersionMinor,proto3" json:"api_version_minor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelloRequest) Reset()         { *m = HelloRequest{} }
+func (m *HelloRequest) String() string { return proto.CompactTextString(m) }
+func (*HelloRequest) ProtoMessage()    {}
+func (*HelloRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *HelloRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HelloRequest.Unmarshal(m, b)
+}
+func (m *HelloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HelloRequest.Marshal(b, m, deterministic)
+}
+func (m *HelloRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloRequest.Merge(m, src)
+}
+func (m *HelloRequest) XXX_Size() int {
+	return xxx_messageInfo_HelloRequest.Size(m)
+}
+func (m *HelloRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelloRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelloRequest proto.InternalMessageInfo
+
+func (m *HelloRequest) GetClientInfo() string {
+	if m != nil {
+		return m.ClientInfo
+	}
+	return ""
+}
+
+func (m *HelloRequest) GetApiVersionMajor() uint32 {
+	if m != nil {
+		return m.ApiVersionMajor
+	}
+	return 0
+}
+
+func (m *HelloRequest) GetApiVersionMinor() uint32 {
+	if m != nil {
+		return m.ApiVersionMinor
+	}
+	return 0
+}
+
+// Confirmation of successful connection request.
+// Can only be sent by the server and only at the beginning of the connection
+type HelloResponse struct {
+	// The version of the API to use. The _client_ (for example Home Assistant) needs to check
+	// for compatibility and if necessary adopt to an older API.
+	// Major is for breaking changes in the base protocol - a mismatch will lead to immediate disconnect_client_
+	// Minor is for breaking changes in individual messages - a mismatch will lead to a warning message
+	ApiVersionMajor uint32 `protobuf:"varint,1,opt,name=api_version_major,json=apiVersionMajor,proto3" json:"api_version_major,omitempty"`
+	ApiVersionMinor uint32 `protobuf:"varint,2,opt,name=api_version_minor,json=apiVersionMinor,proto3" json:"api_version_minor,omitempty"`
+	// A string identifying the server (ESP); like client info this may be empty
+	// and only exists for debugging/logging purposes.
+	// For example "ESPHome v1.10.0 on ESP8266"
+	ServerInfo string `protobuf:"bytes,3,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	// The name of the server (App.get_name())
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelloResponse) Reset()         { *m = HelloResponse{} }
+func (m *HelloResponse) String() string { return proto.CompactTextString(m) }
+func (*HelloResponse) ProtoMessage()    {}
+func (*HelloResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *HelloResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HelloResponse.Unmarshal(m, b)
+}
+func (m *HelloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HelloResponse.Marshal(b, m, deterministic)
+}
+func (m *HelloResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloResponse.Merge(m, src)
+}
+func (m *HelloResponse) XXX_Size() int {
+	return xxx_messageInfo_HelloResponse.Size(m)
+}
+func (m *HelloResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelloResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelloResponse proto.InternalMessageInfo
+
+func (m *HelloResponse) GetApiVersionMajor() uint32 {
+	if m != nil {
+		return m.ApiVersionMajor
+	}
+	return 0
+}
+
+func (m *HelloResponse) GetApiVersionMinor() uint32 {
+	if m != nil {
+		return m.ApiVersionMinor
+	}
+	return 0
+}
+
+func (m *HelloResponse) GetServerInfo() string {
+	if m != nil {
+		return m.ServerInfo
+	}
+	return ""
+}
+
+func (m *HelloResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Message sent at the beginning of each connection to authenticate the client
+// Can only be sent by the client and only at the beginning of the connection
+type ConnectRequest struct {
+	// The password to log in with
+	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConnectRequest) Reset()         { *m = ConnectRequest{} }
+func (m *ConnectRequest) String() string { return proto.CompactTextString(m) }
+func (*ConnectRequest) ProtoMessage()    {}
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *ConnectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectRequest.Unmarshal(m, b)
+}
+func (m *ConnectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectRequest.Marshal(b, m, deterministic)
+}
+func (m *ConnectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectRequest.Merge(m, src)
+}
+func (m *ConnectRequest) XXX_Size() int {
+	return xxx_messageInfo_ConnectRequest.Size(m)
+}
+func (m *ConnectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectRequest proto.InternalMessageInfo
+
+func (m *ConnectRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// Confirmation of successful connection. After this the connection is available for all traffic.
+// Can only be sent by the server and only at the beginning of the connection
+type ConnectResponse struct {
+	InvalidPassword      bool     `protobuf:"varint,1,opt,name=invalid_password,json=invalidPassword,proto3" json:"invalid_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConnectResponse) Reset()         { *m = ConnectResponse{} }
+func (m *ConnectResponse) String() string { return proto.CompactTextString(m) }
+func (*ConnectResponse) ProtoMessage()    {}
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *ConnectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectResponse.Unmarshal(m, b)
+}
+func (m *ConnectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectResponse.Marshal(b, m, deterministic)
+}
+func (m *ConnectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectResponse.Merge(m, src)
+}
+func (m *ConnectResponse) XXX_Size() int {
+	return xxx_messageInfo_ConnectResponse.Size(m)
+}
+func (m *ConnectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectResponse proto.InternalMessageInfo
+
+func (m *ConnectResponse) GetInvalidPassword() bool {
+	if m != nil {
+		return m.InvalidPassword
+	}
+	return false
+}
+
+// Request to close the connection.
+// Can be sent by both the client and server
+type DisconnectRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisconnectRequest) Reset()         { *m = DisconnectRequest{} }
+func (m *DisconnectRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectRequest) ProtoMessage()    {}
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *DisconnectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectRequest.Unmarshal(m, b)
+}
+func (m *DisconnectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectRequest.Marshal(b, m, deterministic)
+}
+func (m *DisconnectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectRequest.Merge(m, src)
+}
+func (m *DisconnectRequest) XXX_Size() int {
+	return xxx_messageInfo_DisconnectRequest.Size(m)
+}
+func (m *DisconnectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectRequest proto.InternalMessageInfo
+
+type DisconnectResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisconnectResponse) Reset()         { *m = DisconnectResponse{} }
+func (m *DisconnectResponse) String() string { return proto.CompactTextString(m) }
+func (*DisconnectResponse) ProtoMessage()    {}
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+func (m *DisconnectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectResponse.Unmarshal(m, b)
+}
+func (m *DisconnectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectResponse.Marshal(b, m, deterministic)
+}
+func (m *DisconnectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectResponse.Merge(m, src)
+}
+func (m *DisconnectResponse) XXX_Size() int {
+	return xxx_messageInfo_DisconnectResponse.Size(m)
+}
+func (m *DisconnectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectResponse proto.InternalMessageInfo
+
+type PingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+
+type PingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingResponse) Reset()         { *m = PingResponse{} }
+func (m *PingResponse) String() string { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()    {}
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+}
+
+func (m *PingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+}
+func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+}
+func (m *PingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingResponse.Merge(m, src)
+}
+func (m *PingResponse) XXX_Size() int {
+	return xxx_messageInfo_PingResponse.Size(m)
+}
+func (m *PingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+
+type DeviceInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceInfoRequest) Reset()         { *m = DeviceInfoRequest{} }
+func (m *DeviceInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*DeviceInfoRequest) ProtoMessage()    {}
+func (*DeviceInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+}
+
+func (m *DeviceInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceInfoRequest.Unmarshal(m, b)
+}
+func (m *DeviceInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *DeviceInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceInfoRequest.Merge(m, src)
+}
+func (m *DeviceInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_DeviceInfoRequest.Size(m)
+}
+func (m *DeviceInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceInfoRequest proto.InternalMessageInfo
+
+type DeviceInfoResponse struct {
+	UsesPassword bool `protobuf:"varint,1,opt,name=uses_password,json=usesPassword,proto3" json:"uses_password,omitempty"`
+	// The name of the node, given by "App.set_name()"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The mac address of the device. For example "AC:BC:32:89:0E:A9"
+	MacAddress string `protobuf:"bytes,3,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	// A string describing the ESPHome version. For example "1.10.0"
+	EsphomeVersion string `protobuf:"bytes,4,opt,name=esphome_version,json=esphomeVersion,proto3" json:"esphome_version,omitempty"`
+	// A string describing the date of compilation, this is generated by the compiler
+	// and therefore may not be in the same format all the time.
+	// If the user isn't using ESPHome, this will also not be set.
+	CompilationTime string `protobuf:"bytes,5,opt,name=compilation_time,json=compilationTime,proto3" json:"compilation_time,omitempty"`
+	// The model of the board. For example NodeMCU
+	Model        string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	HasDeepSleep bool   `protobuf:"varint,7,opt,name=has_deep_sleep,json=hasDeepSleep,proto3" json:"has_deep_sleep,omitempty"`
+	// The esphome project details if set
+	ProjectName                 string   `protobuf:"bytes,8,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectVersion              string   `protobuf:"bytes,9,opt,name=project_version,json=projectVersion,proto3" json:"project_version,omitempty"`
+	WebserverPort               uint32   `protobuf:"varint,10,opt,name=webserver_port,json=webserverPort,proto3" json:"webserver_port,omitempty"`
+	LegacyBluetoothProxyVersion uint32   `protobuf:"varint,11,opt,name=legacy_bluetooth_proxy_version,json=legacyBluetoothProxyVersion,proto3" json:"legacy_bluetooth_proxy_version,omitempty"`
+	BluetoothProxyFeatureFlags  uint32   `protobuf:"varint,15,opt,name=bluetooth_proxy_feature_flags,json=bluetoothProxyFeatureFlags,proto3" json:"bluetooth_proxy_feature_flags,omitempty"`
+	Manufacturer                string   `protobuf:"bytes,12,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	FriendlyName                string   `protobuf:"bytes,13,opt,name=friendly_name,json=friendlyName,proto3" json:"friendly_name,omitempty"`
+	LegacyVoiceAssistantVersion uint32   `protobuf:"varint,14,opt,name=legacy_voice_assistant_version,json=legacyVoiceAssistantVersion,proto3" json:"legacy_voice_assistant_version,omitempty"`
+	VoiceAssistantFeatureFlags  uint32   `protobuf:"varint,17,opt,name=voice_assistant_feature_flags,json=voiceAssistantFeatureFlags,proto3" json:"voice_assistant_feature_flags,omitempty"`
+	SuggestedArea               string   `protobuf:"bytes,16,opt,name=suggested_area,json=suggestedArea,proto3" json:"suggested_area,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *DeviceInfoResponse) Reset()         { *m = DeviceInfoResponse{} }
+func (m *DeviceInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*DeviceInfoResponse) ProtoMessage()    {}
+func (*DeviceInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+}
+
+func (m *DeviceInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceInfoResponse.Unmarshal(m, b)
+}
+func (m *DeviceInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *DeviceInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceInfoResponse.Merge(m, src)
+}
+func (m *DeviceInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_DeviceInfoResponse.Size(m)
+}
+func (m *DeviceInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceInfoResponse proto.InternalMessageInfo
+
+func (m *DeviceInfoResponse) GetUsesPassword() bool {
+	if m != nil {
+		return m.UsesPassword
+	}
+	return false
+}
+
+func (m *DeviceInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetMacAddress() string {
+	if m != nil {
+		return m.MacAddress
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetEsphomeVersion() string {
+	if m != nil {
+		return m.EsphomeVersion
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetCompilationTime() string {
+	if m != nil {
+		return m.CompilationTime
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetHasDeepSleep() bool {
+	if m != nil {
+		return m.HasDeepSleep
+	}
+	return false
+}
+
+func (m *DeviceInfoResponse) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetProjectVersion() string {
+	if m != nil {
+		return m.ProjectVersion
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetWebserverPort() uint32 {
+	if m != nil {
+		return m.WebserverPort
+	}
+	return 0
+}
+
+func (m *DeviceInfoResponse) GetLegacyBluetoothProxyVersion() uint32 {
+	if m != nil {
+		return m.LegacyBluetoothProxyVersion
+	}
+	return 0
+}
+
+func (m *DeviceInfoResponse) GetBluetoothProxyFeatureFlags() uint32 {
+	if m != nil {
+		return m.BluetoothProxyFeatureFlags
+	}
+	return 0
+}
+
+func (m *DeviceInfoResponse) GetManufacturer() string {
+	if m != nil {
+		return m.Manufacturer
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetFriendlyName() string {
+	if m != nil {
+		return m.FriendlyName
+	}
+	return ""
+}
+
+func (m *DeviceInfoResponse) GetLegacyVoiceAssistantVersion() uint32 {
+	if m != nil {
+		return m.LegacyVoiceAssistantVersion
+	}
+	return 0
+}
+
+func (m *DeviceInfoResponse) GetVoiceAssistantFeatureFlags() uint32 {
+	if m != nil {
+		return m.VoiceAssistantFeatureFlags
+	}
+	return 0
+}
+
+func (m *DeviceInfoResponse) GetSuggestedArea() string {
+	if m != nil {
+		return m.SuggestedArea
+	}
+	return ""
+}
+
+type ListEntitiesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEntitiesRequest) Reset()         { *m = ListEntitiesRequest{} }
+func (m *ListEntitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesRequest) ProtoMessage()    {}
+func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+}
+
+func (m *ListEntitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesRequest.Unmarshal(m, b)
+}
+func (m *ListEntitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesRequest.Merge(m, src)
+}
+func (m *ListEntitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesRequest.Size(m)
+}
+func (m *ListEntitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesRequest proto.InternalMessageInfo
+
+type ListEntitiesDoneResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEntitiesDoneResponse) Reset()         { *m = ListEntitiesDoneResponse{} }
+func (m *ListEntitiesDoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesDoneResponse) ProtoMessage()    {}
+func (*ListEntitiesDoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+}
+
+func (m *ListEntitiesDoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesDoneResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesDoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesDoneResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesDoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesDoneResponse.Merge(m, src)
+}
+func (m *ListEntitiesDoneResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesDoneResponse.Size(m)
+}
+func (m *ListEntitiesDoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesDoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesDoneResponse proto.InternalMessageInfo
+
+type SubscribeStatesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeStatesRequest) Reset()         { *m = SubscribeStatesRequest{} }
+func (m *SubscribeStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeStatesRequest) ProtoMessage()    {}
+func (*SubscribeStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+
+func (m *SubscribeStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeStatesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeStatesRequest.Merge(m, src)
+}
+func (m *SubscribeStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeStatesRequest.Size(m)
+}
+func (m *SubscribeStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeStatesRequest proto.InternalMessageInfo
+
+// ==================== BINARY SENSOR ====================
+type ListEntitiesBinarySensorResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,5,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	IsStatusBinarySensor bool           `protobuf:"varint,6,opt,name=is_status_binary_sensor,json=isStatusBinarySensor,proto3" json:"is_status_binary_sensor,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,7,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Icon                 string         `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,9,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesBinarySensorResponse) Reset()         { *m = ListEntitiesBinarySensorResponse{} }
+func (m *ListEntitiesBinarySensorResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesBinarySensorResponse) ProtoMessage()    {}
+func (*ListEntitiesBinarySensorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+}
+
+func (m *ListEntitiesBinarySensorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesBinarySensorResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesBinarySensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesBinarySensorResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesBinarySensorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesBinarySensorResponse.Merge(m, src)
+}
+func (m *ListEntitiesBinarySensorResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesBinarySensorResponse.Size(m)
+}
+func (m *ListEntitiesBinarySensorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesBinarySensorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesBinarySensorResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesBinarySensorResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetIsStatusBinarySensor() bool {
+	if m != nil {
+		return m.IsStatusBinarySensor
+	}
+	return false
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesBinarySensorResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+type BinarySensorStateResponse struct {
+	Key   uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State bool   `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	// If the binary sensor does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BinarySensorStateResponse) Reset()         { *m = BinarySensorStateResponse{} }
+func (m *BinarySensorStateResponse) String() string { return proto.CompactTextString(m) }
+func (*BinarySensorStateResponse) ProtoMessage()    {}
+func (*BinarySensorStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+}
+
+func (m *BinarySensorStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BinarySensorStateResponse.Unmarshal(m, b)
+}
+func (m *BinarySensorStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BinarySensorStateResponse.Marshal(b, m, deterministic)
+}
+func (m *BinarySensorStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinarySensorStateResponse.Merge(m, src)
+}
+func (m *BinarySensorStateResponse) XXX_Size() int {
+	return xxx_messageInfo_BinarySensorStateResponse.Size(m)
+}
+func (m *BinarySensorStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinarySensorStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinarySensorStateResponse proto.InternalMessageInfo
+
+func (m *BinarySensorStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *BinarySensorStateResponse) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+func (m *BinarySensorStateResponse) GetMissingState() bool {
+	if m != nil {
+		return m.MissingState
+	}
+	return false
+}
+
+// ==================== COVER ====================
+type ListEntitiesCoverResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	AssumedState         bool           `protobuf:"varint,5,opt,name=assumed_state,json=assumedState,proto3" json:"assumed_state,omitempty"`
+	SupportsPosition     bool           `protobuf:"varint,6,opt,name=supports_position,json=supportsPosition,proto3" json:"supports_position,omitempty"`
+	SupportsTilt         bool           `protobuf:"varint,7,opt,name=supports_tilt,json=supportsTilt,proto3" json:"supports_tilt,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,9,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Icon                 string         `protobuf:"bytes,10,opt,name=icon,proto3" json:"icon,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,11,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	SupportsStop         bool           `protobuf:"varint,12,opt,name=supports_stop,json=supportsStop,proto3" json:"supports_stop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesCoverResponse) Reset()         { *m = ListEntitiesCoverResponse{} }
+func (m *ListEntitiesCoverResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesCoverResponse) ProtoMessage()    {}
+func (*ListEntitiesCoverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+}
+
+func (m *ListEntitiesCoverResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesCoverResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesCoverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesCoverResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesCoverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesCoverResponse.Merge(m, src)
+}
+func (m *ListEntitiesCoverResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesCoverResponse.Size(m)
+}
+func (m *ListEntitiesCoverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesCoverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesCoverResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesCoverResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesCoverResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesCoverResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesCoverResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesCoverResponse) GetAssumedState() bool {
+	if m != nil {
+		return m.AssumedState
+	}
+	return false
+}
+
+func (m *ListEntitiesCoverResponse) GetSupportsPosition() bool {
+	if m != nil {
+		return m.SupportsPosition
+	}
+	return false
+}
+
+func (m *ListEntitiesCoverResponse) GetSupportsTilt() bool {
+	if m != nil {
+		return m.SupportsTilt
+	}
+	return false
+}
+
+func (m *ListEntitiesCoverResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+func (m *ListEntitiesCoverResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesCoverResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesCoverResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesCoverResponse) GetSupportsStop() bool {
+	if m != nil {
+		return m.SupportsStop
+	}
+	return false
+}
+
+type CoverStateResponse struct {
+	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	// legacy: state has been removed in 1.13
+	// clients/servers must still send/accept it until the next protocol change
+	LegacyState          LegacyCoverState `protobuf:"varint,2,opt,name=legacy_state,json=legacyState,proto3,enum=LegacyCoverState" json:"legacy_state,omitempty"`
+	Position             float32          `protobuf:"fixed32,3,opt,name=position,proto3" json:"position,omitempty"`
+	Tilt                 float32          `protobuf:"fixed32,4,opt,name=tilt,proto3" json:"tilt,omitempty"`
+	CurrentOperation     CoverOperation   `protobuf:"varint,5,opt,name=current_operation,json=currentOperation,proto3,enum=CoverOperation" json:"current_operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CoverStateResponse) Reset()         { *m = CoverStateResponse{} }
+func (m *CoverStateResponse) String() string { return proto.CompactTextString(m) }
+func (*CoverStateResponse) ProtoMessage()    {}
+func (*CoverStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+}
+
+func (m *CoverStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoverStateResponse.Unmarshal(m, b)
+}
+func (m *CoverStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoverStateResponse.Marshal(b, m, deterministic)
+}
+func (m *CoverStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverStateResponse.Merge(m, src)
+}
+func (m *CoverStateResponse) XXX_Size() int {
+	return xxx_messageInfo_CoverStateResponse.Size(m)
+}
+func (m *CoverStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverStateResponse proto.InternalMessageInfo
+
+func (m *CoverStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *CoverStateResponse) GetLegacyState() LegacyCoverState {
+	if m != nil {
+		return m.LegacyState
+	}
+	return LegacyCoverState_LEGACY_COVER_STATE_OPEN
+}
+
+func (m *CoverStateResponse) GetPosition() float32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *CoverStateResponse) GetTilt() float32 {
+	if m != nil {
+		return m.Tilt
+	}
+	return 0
+}
+
+func (m *CoverStateResponse) GetCurrentOperation() CoverOperation {
+	if m != nil {
+		return m.CurrentOperation
+	}
+	return CoverOperation_COVER_OPERATION_IDLE
+}
+
+type CoverCommandRequest struct {
+	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	// legacy: command has been removed in 1.13
+	// clients/servers must still send/accept it until the next protocol change
+	HasLegacyCommand     bool               `protobuf:"varint,2,opt,name=has_legacy_command,json=hasLegacyCommand,proto3" json:"has_legacy_command,omitempty"`
+	LegacyCommand        LegacyCoverCommand `protobuf:"varint,3,opt,name=legacy_command,json=legacyCommand,proto3,enum=LegacyCoverCommand" json:"legacy_command,omitempty"`
+	HasPosition          bool               `protobuf:"varint,4,opt,name=has_position,json=hasPosition,proto3" json:"has_position,omitempty"`
+	Position             float32            `protobuf:"fixed32,5,opt,name=position,proto3" json:"position,omitempty"`
+	HasTilt              bool               `protobuf:"varint,6,opt,name=has_tilt,json=hasTilt,proto3" json:"has_tilt,omitempty"`
+	Tilt                 float32            `protobuf:"fixed32,7,opt,name=tilt,proto3" json:"tilt,omitempty"`
+	Stop                 bool               `protobuf:"varint,8,opt,name=stop,proto3" json:"stop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CoverCommandRequest) Reset()         { *m = CoverCommandRequest{} }
+func (m *CoverCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*CoverCommandRequest) ProtoMessage()    {}
+func (*CoverCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
+
+func (m *CoverCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoverCommandRequest.Unmarshal(m, b)
+}
+func (m *CoverCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoverCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *CoverCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverCommandRequest.Merge(m, src)
+}
+func (m *CoverCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_CoverCommandRequest.Size(m)
+}
+func (m *CoverCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverCommandRequest proto.InternalMessageInfo
+
+func (m *CoverCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *CoverCommandRequest) GetHasLegacyCommand() bool {
+	if m != nil {
+		return m.HasLegacyCommand
+	}
+	return false
+}
+
+func (m *CoverCommandRequest) GetLegacyCommand() LegacyCoverCommand {
+	if m != nil {
+		return m.LegacyCommand
+	}
+	return LegacyCoverCommand_LEGACY_COVER_COMMAND_OPEN
+}
+
+func (m *CoverCommandRequest) GetHasPosition() bool {
+	if m != nil {
+		return m.HasPosition
+	}
+	return false
+}
+
+func (m *CoverCommandRequest) GetPosition() float32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *CoverCommandRequest) GetHasTilt() bool {
+	if m != nil {
+		return m.HasTilt
+	}
+	return false
+}
+
+func (m *CoverCommandRequest) GetTilt() float32 {
+	if m != nil {
+		return m.Tilt
+	}
+	return 0
+}
+
+func (m *CoverCommandRequest) GetStop() bool {
+	if m != nil {
+		return m.Stop
+	}
+	return false
+}
+
+// ==================== FAN ====================
+type ListEntitiesFanResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	SupportsOscillation  bool           `protobuf:"varint,5,opt,name=supports_oscillation,json=supportsOscillation,proto3" json:"supports_oscillation,omitempty"`
+	SupportsSpeed        bool           `protobuf:"varint,6,opt,name=supports_speed,json=supportsSpeed,proto3" json:"supports_speed,omitempty"`
+	SupportsDirection    bool           `protobuf:"varint,7,opt,name=supports_direction,json=supportsDirection,proto3" json:"supports_direction,omitempty"`
+	SupportedSpeedCount  int32          `protobuf:"varint,8,opt,name=supported_speed_count,json=supportedSpeedCount,proto3" json:"supported_speed_count,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,9,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Icon                 string         `protobuf:"bytes,10,opt,name=icon,proto3" json:"icon,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,11,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	SupportedPresetModes []string       `protobuf:"bytes,12,rep,name=supported_preset_modes,json=supportedPresetModes,proto3" json:"supported_preset_modes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesFanResponse) Reset()         { *m = ListEntitiesFanResponse{} }
+func (m *ListEntitiesFanResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesFanResponse) ProtoMessage()    {}
+func (*ListEntitiesFanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+}
+
+func (m *ListEntitiesFanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesFanResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesFanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesFanResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesFanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesFanResponse.Merge(m, src)
+}
+func (m *ListEntitiesFanResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesFanResponse.Size(m)
+}
+func (m *ListEntitiesFanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesFanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesFanResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesFanResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesFanResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesFanResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesFanResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesFanResponse) GetSupportsOscillation() bool {
+	if m != nil {
+		return m.SupportsOscillation
+	}
+	return false
+}
+
+func (m *ListEntitiesFanResponse) GetSupportsSpeed() bool {
+	if m != nil {
+		return m.SupportsSpeed
+	}
+	return false
+}
+
+func (m *ListEntitiesFanResponse) GetSupportsDirection() bool {
+	if m != nil {
+		return m.SupportsDirection
+	}
+	return false
+}
+
+func (m *ListEntitiesFanResponse) GetSupportedSpeedCount() int32 {
+	if m != nil {
+		return m.SupportedSpeedCount
+	}
+	return 0
+}
+
+func (m *ListEntitiesFanResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesFanResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesFanResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesFanResponse) GetSupportedPresetModes() []string {
+	if m != nil {
+		return m.SupportedPresetModes
+	}
+	return nil
+}
+
+type FanStateResponse struct {
+	Key         uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State       bool   `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	Oscillating bool   `protobuf:"varint,3,opt,name=oscillating,proto3" json:"oscillating,omitempty"`
+	// legacy: speed has been replaced by speed_level in API 1.4
+	Speed                FanSpeed     `protobuf:"varint,4,opt,name=speed,proto3,enum=FanSpeed" json:"speed,omitempty"` // Deprecated: Do not use.
+	Direction            FanDirection `protobuf:"varint,5,opt,name=direction,proto3,enum=FanDirection" json:"direction,omitempty"`
+	SpeedLevel           int32        `protobuf:"varint,6,opt,name=speed_level,json=speedLevel,proto3" json:"speed_level,omitempty"`
+	PresetMode           string       `protobuf:"bytes,7,opt,name=preset_mode,json=presetMode,proto3" json:"preset_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FanStateResponse) Reset()         { *m = FanStateResponse{} }
+func (m *FanStateResponse) String() string { return proto.CompactTextString(m) }
+func (*FanStateResponse) ProtoMessage()    {}
+func (*FanStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+}
+
+func (m *FanStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FanStateResponse.Unmarshal(m, b)
+}
+func (m *FanStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FanStateResponse.Marshal(b, m, deterministic)
+}
+func (m *FanStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FanStateResponse.Merge(m, src)
+}
+func (m *FanStateResponse) XXX_Size() int {
+	return xxx_messageInfo_FanStateResponse.Size(m)
+}
+func (m *FanStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FanStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FanStateResponse proto.InternalMessageInfo
+
+func (m *FanStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *FanStateResponse) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+func (m *FanStateResponse) GetOscillating() bool {
+	if m != nil {
+		return m.Oscillating
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *FanStateResponse) GetSpeed() FanSpeed {
+	if m != nil {
+		return m.Speed
+	}
+	return FanSpeed_FAN_SPEED_LOW
+}
+
+func (m *FanStateResponse) GetDirection() FanDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return FanDirection_FAN_DIRECTION_FORWARD
+}
+
+func (m *FanStateResponse) GetSpeedLevel() int32 {
+	if m != nil {
+		return m.SpeedLevel
+	}
+	return 0
+}
+
+func (m *FanStateResponse) GetPresetMode() string {
+	if m != nil {
+		return m.PresetMode
+	}
+	return ""
+}
+
+type FanCommandRequest struct {
+	Key      uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	HasState bool   `protobuf:"varint,2,opt,name=has_state,json=hasState,proto3" json:"has_state,omitempty"`
+	State    bool   `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	// legacy: speed has been replaced by speed_level in API 1.4
+	HasSpeed             bool         `protobuf:"varint,4,opt,name=has_speed,json=hasSpeed,proto3" json:"has_speed,omitempty"` // Deprecated: Do not use.
+	Speed                FanSpeed     `protobuf:"varint,5,opt,name=speed,proto3,enum=FanSpeed" json:"speed,omitempty"`         // Deprecated: Do not use.
+	HasOscillating       bool         `protobuf:"varint,6,opt,name=has_oscillating,json=hasOscillating,proto3" json:"has_oscillating,omitempty"`
+	Oscillating          bool         `protobuf:"varint,7,opt,name=oscillating,proto3" json:"oscillating,omitempty"`
+	HasDirection         bool         `protobuf:"varint,8,opt,name=has_direction,json=hasDirection,proto3" json:"has_direction,omitempty"`
+	Direction            FanDirection `protobuf:"varint,9,opt,name=direction,proto3,enum=FanDirection" json:"direction,omitempty"`
+	HasSpeedLevel        bool         `protobuf:"varint,10,opt,name=has_speed_level,json=hasSpeedLevel,proto3" json:"has_speed_level,omitempty"`
+	SpeedLevel           int32        `protobuf:"varint,11,opt,name=speed_level,json=speedLevel,proto3" json:"speed_level,omitempty"`
+	HasPresetMode        bool         `protobuf:"varint,12,opt,name=has_preset_mode,json=hasPresetMode,proto3" json:"has_preset_mode,omitempty"`
+	PresetMode           string       `protobuf:"bytes,13,opt,name=preset_mode,json=presetMode,proto3" json:"preset_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FanCommandRequest) Reset()         { *m = FanCommandRequest{} }
+func (m *FanCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*FanCommandRequest) ProtoMessage()    {}
+func (*FanCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+
+func (m *FanCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FanCommandRequest.Unmarshal(m, b)
+}
+func (m *FanCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FanCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *FanCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FanCommandRequest.Merge(m, src)
+}
+func (m *FanCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_FanCommandRequest.Size(m)
+}
+func (m *FanCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FanCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FanCommandRequest proto.InternalMessageInfo
+
+func (m *FanCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *FanCommandRequest) GetHasState() bool {
+	if m != nil {
+		return m.HasState
+	}
+	return false
+}
+
+func (m *FanCommandRequest) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *FanCommandRequest) GetHasSpeed() bool {
+	if m != nil {
+		return m.HasSpeed
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *FanCommandRequest) GetSpeed() FanSpeed {
+	if m != nil {
+		return m.Speed
+	}
+	return FanSpeed_FAN_SPEED_LOW
+}
+
+func (m *FanCommandRequest) GetHasOscillating() bool {
+	if m != nil {
+		return m.HasOscillating
+	}
+	return false
+}
+
+func (m *FanCommandRequest) GetOscillating() bool {
+	if m != nil {
+		return m.Oscillating
+	}
+	return false
+}
+
+func (m *FanCommandRequest) GetHasDirection() bool {
+	if m != nil {
+		return m.HasDirection
+	}
+	return false
+}
+
+func (m *FanCommandRequest) GetDirection() FanDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return FanDirection_FAN_DIRECTION_FORWARD
+}
+
+func (m *FanCommandRequest) GetHasSpeedLevel() bool {
+	if m != nil {
+		return m.HasSpeedLevel
+	}
+	return false
+}
+
+func (m *FanCommandRequest) GetSpeedLevel() int32 {
+	if m != nil {
+		return m.SpeedLevel
+	}
+	return 0
+}
+
+func (m *FanCommandRequest) GetHasPresetMode() bool {
+	if m != nil {
+		return m.HasPresetMode
+	}
+	return false
+}
+
+func (m *FanCommandRequest) GetPresetMode() string {
+	if m != nil {
+		return m.PresetMode
+	}
+	return ""
+}
+
+type ListEntitiesLightResponse struct {
+	ObjectId            string      `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                 uint32      `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId            string      `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	SupportedColorModes []ColorMode `protobuf:"varint,12,rep,packed,name=supported_color_modes,json=supportedColorModes,proto3,enum=ColorMode" json:"supported_color_modes,omitempty"`
+	// legacy: the supports_* capabilities have been replaced by supported_color_modes in API 1.6
+	LegacySupportsBrightness       bool           `protobuf:"varint,5,opt,name=legacy_supports_brightness,json=legacySupportsBrightness,proto3" json:"legacy_supports_brightness,omitempty"`                     // Deprecated: Do not use.
+	LegacySupportsRgb              bool           `protobuf:"varint,6,opt,name=legacy_supports_rgb,json=legacySupportsRgb,proto3" json:"legacy_supports_rgb,omitempty"`                                          // Deprecated: Do not use.
+	LegacySupportsWhiteValue       bool           `protobuf:"varint,7,opt,name=legacy_supports_white_value,json=legacySupportsWhiteValue,proto3" json:"legacy_supports_white_value,omitempty"`                   // Deprecated: Do not use.
+	LegacySupportsColorTemperature bool           `protobuf:"varint,8,opt,name=legacy_supports_color_temperature,json=legacySupportsColorTemperature,proto3" json:"legacy_supports_color_temperature,omitempty"` // Deprecated: Do not use.
+	MinMireds                      float32        `protobuf:"fixed32,9,opt,name=min_mireds,json=minMireds,proto3" json:"min_mireds,omitempty"`
+	MaxMireds                      float32        `protobuf:"fixed32,10,opt,name=max_mireds,json=maxMireds,proto3" json:"max_mireds,omitempty"`
+	Effects                        []string       `protobuf:"bytes,11,rep,name=effects,proto3" json:"effects,omitempty"`
+	DisabledByDefault              bool           `protobuf:"varint,13,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Icon                           string         `protobuf:"bytes,14,opt,name=icon,proto3" json:"icon,omitempty"`
+	EntityCategory                 EntityCategory `protobuf:"varint,15,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}       `json:"-"`
+	XXX_unrecognized               []byte         `json:"-"`
+	XXX_sizecache                  int32          `json:"-"`
+}
+
+func (m *ListEntitiesLightResponse) Reset()         { *m = ListEntitiesLightResponse{} }
+func (m *ListEntitiesLightResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesLightResponse) ProtoMessage()    {}
+func (*ListEntitiesLightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+}
+
+func (m *ListEntitiesLightResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesLightResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesLightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesLightResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesLightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesLightResponse.Merge(m, src)
+}
+func (m *ListEntitiesLightResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesLightResponse.Size(m)
+}
+func (m *ListEntitiesLightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesLightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesLightResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesLightResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesLightResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesLightResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesLightResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesLightResponse) GetSupportedColorModes() []ColorMode {
+	if m != nil {
+		return m.SupportedColorModes
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *ListEntitiesLightResponse) GetLegacySupportsBrightness() bool {
+	if m != nil {
+		return m.LegacySupportsBrightness
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *ListEntitiesLightResponse) GetLegacySupportsRgb() bool {
+	if m != nil {
+		return m.LegacySupportsRgb
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *ListEntitiesLightResponse) GetLegacySupportsWhiteValue() bool {
+	if m != nil {
+		return m.LegacySupportsWhiteValue
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *ListEntitiesLightResponse) GetLegacySupportsColorTemperature() bool {
+	if m != nil {
+		return m.LegacySupportsColorTemperature
+	}
+	return false
+}
+
+func (m *ListEntitiesLightResponse) GetMinMireds() float32 {
+	if m != nil {
+		return m.MinMireds
+	}
+	return 0
+}
+
+func (m *ListEntitiesLightResponse) GetMaxMireds() float32 {
+	if m != nil {
+		return m.MaxMireds
+	}
+	return 0
+}
+
+func (m *ListEntitiesLightResponse) GetEffects() []string {
+	if m != nil {
+		return m.Effects
+	}
+	return nil
+}
+
+func (m *ListEntitiesLightResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesLightResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesLightResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+type LightStateResponse struct {
+	Key                  uint32    `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                bool      `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	Brightness           float32   `protobuf:"fixed32,3,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	ColorMode            ColorMode `protobuf:"varint,11,opt,name=color_mode,json=colorMode,proto3,enum=ColorMode" json:"color_mode,omitempty"`
+	ColorBrightness      float32   `protobuf:"fixed32,10,opt,name=color_brightness,json=colorBrightness,proto3" json:"color_brightness,omitempty"`
+	Red                  float32   `protobuf:"fixed32,4,opt,name=red,proto3" json:"red,omitempty"`
+	Green                float32   `protobuf:"fixed32,5,opt,name=green,proto3" json:"green,omitempty"`
+	Blue                 float32   `protobuf:"fixed32,6,opt,name=blue,proto3" json:"blue,omitempty"`
+	White                float32   `protobuf:"fixed32,7,opt,name=white,proto3" json:"white,omitempty"`
+	ColorTemperature     float32   `protobuf:"fixed32,8,opt,name=color_temperature,json=colorTemperature,proto3" json:"color_temperature,omitempty"`
+	ColdWhite            float32   `protobuf:"fixed32,12,opt,name=cold_white,json=coldWhite,proto3" json:"cold_white,omitempty"`
+	WarmWhite            float32   `protobuf:"fixed32,13,opt,name=warm_white,json=warmWhite,proto3" json:"warm_white,omitempty"`
+	Effect               string    `protobuf:"bytes,9,opt,name=effect,proto3" json:"effect,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LightStateResponse) Reset()         { *m = LightStateResponse{} }
+func (m *LightStateResponse) String() string { return proto.CompactTextString(m) }
+func (*LightStateResponse) ProtoMessage()    {}
+func (*LightStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+func (m *LightStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightStateResponse.Unmarshal(m, b)
+}
+func (m *LightStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightStateResponse.Marshal(b, m, deterministic)
+}
+func (m *LightStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightStateResponse.Merge(m, src)
+}
+func (m *LightStateResponse) XXX_Size() int {
+	return xxx_messageInfo_LightStateResponse.Size(m)
+}
+func (m *LightStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightStateResponse proto.InternalMessageInfo
+
+func (m *LightStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+func (m *LightStateResponse) GetBrightness() float32 {
+	if m != nil {
+		return m.Brightness
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetColorMode() ColorMode {
+	if m != nil {
+		return m.ColorMode
+	}
+	return ColorMode_COLOR_MODE_UNKNOWN
+}
+
+func (m *LightStateResponse) GetColorBrightness() float32 {
+	if m != nil {
+		return m.ColorBrightness
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetRed() float32 {
+	if m != nil {
+		return m.Red
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetGreen() float32 {
+	if m != nil {
+		return m.Green
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetBlue() float32 {
+	if m != nil {
+		return m.Blue
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetWhite() float32 {
+	if m != nil {
+		return m.White
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetColorTemperature() float32 {
+	if m != nil {
+		return m.ColorTemperature
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetColdWhite() float32 {
+	if m != nil {
+		return m.ColdWhite
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetWarmWhite() float32 {
+	if m != nil {
+		return m.WarmWhite
+	}
+	return 0
+}
+
+func (m *LightStateResponse) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+type LightCommandRequest struct {
+	Key                  uint32    `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	HasState             bool      `protobuf:"varint,2,opt,name=has_state,json=hasState,proto3" json:"has_state,omitempty"`
+	State                bool      `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	HasBrightness        bool      `protobuf:"varint,4,opt,name=has_brightness,json=hasBrightness,proto3" json:"has_brightness,omitempty"`
+	Brightness           float32   `protobuf:"fixed32,5,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	HasColorMode         bool      `protobuf:"varint,22,opt,name=has_color_mode,json=hasColorMode,proto3" json:"has_color_mode,omitempty"`
+	ColorMode            ColorMode `protobuf:"varint,23,opt,name=color_mode,json=colorMode,proto3,enum=ColorMode" json:"color_mode,omitempty"`
+	HasColorBrightness   bool      `protobuf:"varint,20,opt,name=has_color_brightness,json=hasColorBrightness,proto3" json:"has_color_brightness,omitempty"`
+	ColorBrightness      float32   `protobuf:"fixed32,21,opt,name=color_brightness,json=colorBrightness,proto3" json:"color_brightness,omitempty"`
+	HasRgb               bool      `protobuf:"varint,6,opt,name=has_rgb,json=hasRgb,proto3" json:"has_rgb,omitempty"`
+	Red                  float32   `protobuf:"fixed32,7,opt,name=red,proto3" json:"red,omitempty"`
+	Green                float32   `protobuf:"fixed32,8,opt,name=green,proto3" json:"green,omitempty"`
+	Blue                 float32   `protobuf:"fixed32,9,opt,name=blue,proto3" json:"blue,omitempty"`
+	HasWhite             bool      `protobuf:"varint,10,opt,name=has_white,json=hasWhite,proto3" json:"has_white,omitempty"`
+	White                float32   `protobuf:"fixed32,11,opt,name=white,proto3" json:"white,omitempty"`
+	HasColorTemperature  bool      `protobuf:"varint,12,opt,name=has_color_temperature,json=hasColorTemperature,proto3" json:"has_color_temperature,omitempty"`
+	ColorTemperature     float32   `protobuf:"fixed32,13,opt,name=color_temperature,json=colorTemperature,proto3" json:"color_temperature,omitempty"`
+	HasColdWhite         bool      `protobuf:"varint,24,opt,name=has_cold_white,json=hasColdWhite,proto3" json:"has_cold_white,omitempty"`
+	ColdWhite            float32   `protobuf:"fixed32,25,opt,name=cold_white,json=coldWhite,proto3" json:"cold_white,omitempty"`
+	HasWarmWhite         bool      `protobuf:"varint,26,opt,name=has_warm_white,json=hasWarmWhite,proto3" json:"has_warm_white,omitempty"`
+	WarmWhite            float32   `protobuf:"fixed32,27,opt,name=warm_white,json=warmWhite,proto3" json:"warm_white,omitempty"`
+	HasTransitionLength  bool      `protobuf:"varint,14,opt,name=has_transition_length,json=hasTransitionLength,proto3" json:"has_transition_length,omitempty"`
+	TransitionLength     uint32    `protobuf:"varint,15,opt,name=transition_length,json=transitionLength,proto3" json:"transition_length,omitempty"`
+	HasFlashLength       bool      `protobuf:"varint,16,opt,name=has_flash_length,json=hasFlashLength,proto3" json:"has_flash_length,omitempty"`
+	FlashLength          uint32    `protobuf:"varint,17,opt,name=flash_length,json=flashLength,proto3" json:"flash_length,omitempty"`
+	HasEffect            bool      `protobuf:"varint,18,opt,name=has_effect,json=hasEffect,proto3" json:"has_effect,omitempty"`
+	Effect               string    `protobuf:"bytes,19,opt,name=effect,proto3" json:"effect,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LightCommandRequest) Reset()         { *m = LightCommandRequest{} }
+func (m *LightCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*LightCommandRequest) ProtoMessage()    {}
+func (*LightCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+}
+
+func (m *LightCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightCommandRequest.Unmarshal(m, b)
+}
+func (m *LightCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *LightCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightCommandRequest.Merge(m, src)
+}
+func (m *LightCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_LightCommandRequest.Size(m)
+}
+func (m *LightCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightCommandRequest proto.InternalMessageInfo
+
+func (m *LightCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasState() bool {
+	if m != nil {
+		return m.HasState
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetHasBrightness() bool {
+	if m != nil {
+		return m.HasBrightness
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetBrightness() float32 {
+	if m != nil {
+		return m.Brightness
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasColorMode() bool {
+	if m != nil {
+		return m.HasColorMode
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetColorMode() ColorMode {
+	if m != nil {
+		return m.ColorMode
+	}
+	return ColorMode_COLOR_MODE_UNKNOWN
+}
+
+func (m *LightCommandRequest) GetHasColorBrightness() bool {
+	if m != nil {
+		return m.HasColorBrightness
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetColorBrightness() float32 {
+	if m != nil {
+		return m.ColorBrightness
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasRgb() bool {
+	if m != nil {
+		return m.HasRgb
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetRed() float32 {
+	if m != nil {
+		return m.Red
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetGreen() float32 {
+	if m != nil {
+		return m.Green
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetBlue() float32 {
+	if m != nil {
+		return m.Blue
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasWhite() bool {
+	if m != nil {
+		return m.HasWhite
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetWhite() float32 {
+	if m != nil {
+		return m.White
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasColorTemperature() bool {
+	if m != nil {
+		return m.HasColorTemperature
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetColorTemperature() float32 {
+	if m != nil {
+		return m.ColorTemperature
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasColdWhite() bool {
+	if m != nil {
+		return m.HasColdWhite
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetColdWhite() float32 {
+	if m != nil {
+		return m.ColdWhite
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasWarmWhite() bool {
+	if m != nil {
+		return m.HasWarmWhite
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetWarmWhite() float32 {
+	if m != nil {
+		return m.WarmWhite
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasTransitionLength() bool {
+	if m != nil {
+		return m.HasTransitionLength
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetTransitionLength() uint32 {
+	if m != nil {
+		return m.TransitionLength
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasFlashLength() bool {
+	if m != nil {
+		return m.HasFlashLength
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetFlashLength() uint32 {
+	if m != nil {
+		return m.FlashLength
+	}
+	return 0
+}
+
+func (m *LightCommandRequest) GetHasEffect() bool {
+	if m != nil {
+		return m.HasEffect
+	}
+	return false
+}
+
+func (m *LightCommandRequest) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+type ListEntitiesSensorResponse struct {
+	ObjectId          string           `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key               uint32           `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name              string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId          string           `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon              string           `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	UnitOfMeasurement string           `protobuf:"bytes,6,opt,name=unit_of_measurement,json=unitOfMeasurement,proto3" json:"unit_of_measurement,omitempty"`
+	AccuracyDecimals  int32            `protobuf:"varint,7,opt,name=accuracy_decimals,json=accuracyDecimals,proto3" json:"accuracy_decimals,omitempty"`
+	ForceUpdate       bool             `protobuf:"varint,8,opt,name=force_update,json=forceUpdate,proto3" json:"force_update,omitempty"`
+	DeviceClass       string           `protobuf:"bytes,9,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	StateClass        SensorStateClass `protobuf:"varint,10,opt,name=state_class,json=stateClass,proto3,enum=SensorStateClass" json:"state_class,omitempty"`
+	// Last reset type removed in 2021.9.0
+	LegacyLastResetType  SensorLastResetType `protobuf:"varint,11,opt,name=legacy_last_reset_type,json=legacyLastResetType,proto3,enum=SensorLastResetType" json:"legacy_last_reset_type,omitempty"`
+	DisabledByDefault    bool                `protobuf:"varint,12,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory      `protobuf:"varint,13,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListEntitiesSensorResponse) Reset()         { *m = ListEntitiesSensorResponse{} }
+func (m *ListEntitiesSensorResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesSensorResponse) ProtoMessage()    {}
+func (*ListEntitiesSensorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+
+func (m *ListEntitiesSensorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesSensorResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesSensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesSensorResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesSensorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesSensorResponse.Merge(m, src)
+}
+func (m *ListEntitiesSensorResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesSensorResponse.Size(m)
+}
+func (m *ListEntitiesSensorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesSensorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesSensorResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesSensorResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSensorResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesSensorResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesSensorResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSensorResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesSensorResponse) GetUnitOfMeasurement() string {
+	if m != nil {
+		return m.UnitOfMeasurement
+	}
+	return ""
+}
+
+func (m *ListEntitiesSensorResponse) GetAccuracyDecimals() int32 {
+	if m != nil {
+		return m.AccuracyDecimals
+	}
+	return 0
+}
+
+func (m *ListEntitiesSensorResponse) GetForceUpdate() bool {
+	if m != nil {
+		return m.ForceUpdate
+	}
+	return false
+}
+
+func (m *ListEntitiesSensorResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+func (m *ListEntitiesSensorResponse) GetStateClass() SensorStateClass {
+	if m != nil {
+		return m.StateClass
+	}
+	return SensorStateClass_STATE_CLASS_NONE
+}
+
+func (m *ListEntitiesSensorResponse) GetLegacyLastResetType() SensorLastResetType {
+	if m != nil {
+		return m.LegacyLastResetType
+	}
+	return SensorLastResetType_LAST_RESET_NONE
+}
+
+func (m *ListEntitiesSensorResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesSensorResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+type SensorStateResponse struct {
+	Key   uint32  `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State float32 `protobuf:"fixed32,2,opt,name=state,proto3" json:"state,omitempty"`
+	// If the sensor does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SensorStateResponse) Reset()         { *m = SensorStateResponse{} }
+func (m *SensorStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SensorStateResponse) ProtoMessage()    {}
+func (*SensorStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+}
+
+func (m *SensorStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SensorStateResponse.Unmarshal(m, b)
+}
+func (m *SensorStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SensorStateResponse.Marshal(b, m, deterministic)
+}
+func (m *SensorStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SensorStateResponse.Merge(m, src)
+}
+func (m *SensorStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SensorStateResponse.Size(m)
+}
+func (m *SensorStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SensorStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SensorStateResponse proto.InternalMessageInfo
+
+func (m *SensorStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SensorStateResponse) GetState() float32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *SensorStateResponse) GetMissingState() bool {
+	if m != nil {
+		return m.MissingState
+	}
+	return false
+}
+
+// ==================== SWITCH ====================
+type ListEntitiesSwitchResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	AssumedState         bool           `protobuf:"varint,6,opt,name=assumed_state,json=assumedState,proto3" json:"assumed_state,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,7,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,8,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,9,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesSwitchResponse) Reset()         { *m = ListEntitiesSwitchResponse{} }
+func (m *ListEntitiesSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesSwitchResponse) ProtoMessage()    {}
+func (*ListEntitiesSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+func (m *ListEntitiesSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesSwitchResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesSwitchResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesSwitchResponse.Merge(m, src)
+}
+func (m *ListEntitiesSwitchResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesSwitchResponse.Size(m)
+}
+func (m *ListEntitiesSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesSwitchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesSwitchResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesSwitchResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSwitchResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesSwitchResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesSwitchResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSwitchResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesSwitchResponse) GetAssumedState() bool {
+	if m != nil {
+		return m.AssumedState
+	}
+	return false
+}
+
+func (m *ListEntitiesSwitchResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesSwitchResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesSwitchResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+type SwitchStateResponse struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchStateResponse) Reset()         { *m = SwitchStateResponse{} }
+func (m *SwitchStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SwitchStateResponse) ProtoMessage()    {}
+func (*SwitchStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *SwitchStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchStateResponse.Unmarshal(m, b)
+}
+func (m *SwitchStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchStateResponse.Marshal(b, m, deterministic)
+}
+func (m *SwitchStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchStateResponse.Merge(m, src)
+}
+func (m *SwitchStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SwitchStateResponse.Size(m)
+}
+func (m *SwitchStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchStateResponse proto.InternalMessageInfo
+
+func (m *SwitchStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SwitchStateResponse) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+type SwitchCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchCommandRequest) Reset()         { *m = SwitchCommandRequest{} }
+func (m *SwitchCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*SwitchCommandRequest) ProtoMessage()    {}
+func (*SwitchCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+}
+
+func (m *SwitchCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchCommandRequest.Unmarshal(m, b)
+}
+func (m *SwitchCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *SwitchCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchCommandRequest.Merge(m, src)
+}
+func (m *SwitchCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_SwitchCommandRequest.Size(m)
+}
+func (m *SwitchCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchCommandRequest proto.InternalMessageInfo
+
+func (m *SwitchCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SwitchCommandRequest) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+// ==================== TEXT SENSOR ====================
+type ListEntitiesTextSensorResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesTextSensorResponse) Reset()         { *m = ListEntitiesTextSensorResponse{} }
+func (m *ListEntitiesTextSensorResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesTextSensorResponse) ProtoMessage()    {}
+func (*ListEntitiesTextSensorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+}
+
+func (m *ListEntitiesTextSensorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesTextSensorResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesTextSensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesTextSensorResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesTextSensorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesTextSensorResponse.Merge(m, src)
+}
+func (m *ListEntitiesTextSensorResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesTextSensorResponse.Size(m)
+}
+func (m *ListEntitiesTextSensorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesTextSensorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesTextSensorResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesTextSensorResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesTextSensorResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesTextSensorResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesTextSensorResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesTextSensorResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesTextSensorResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesTextSensorResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesTextSensorResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+type TextSensorStateResponse struct {
+	Key   uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// If the text sensor does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TextSensorStateResponse) Reset()         { *m = TextSensorStateResponse{} }
+func (m *TextSensorStateResponse) String() string { return proto.CompactTextString(m) }
+func (*TextSensorStateResponse) ProtoMessage()    {}
+func (*TextSensorStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+}
+
+func (m *TextSensorStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TextSensorStateResponse.Unmarshal(m, b)
+}
+func (m *TextSensorStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TextSensorStateResponse.Marshal(b, m, deterministic)
+}
+func (m *TextSensorStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextSensorStateResponse.Merge(m, src)
+}
+func (m *TextSensorStateResponse) XXX_Size() int {
+	return xxx_messageInfo_TextSensorStateResponse.Size(m)
+}
+func (m *TextSensorStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextSensorStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextSensorStateResponse proto.InternalMessageInfo
+
+func (m *TextSensorStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *TextSensorStateResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *TextSensorStateResponse) GetMissingState() bool {
+	if m != nil {
+		return m.MissingState
+	}
+	return false
+}
+
+type SubscribeLogsRequest struct {
+	Level                LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=LogLevel" json:"level,omitempty"`
+	DumpConfig           bool     `protobuf:"varint,2,opt,name=dump_config,json=dumpConfig,proto3" json:"dump_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeLogsRequest) Reset()         { *m = SubscribeLogsRequest{} }
+func (m *SubscribeLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeLogsRequest) ProtoMessage()    {}
+func (*SubscribeLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+}
+
+func (m *SubscribeLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeLogsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeLogsRequest.Merge(m, src)
+}
+func (m *SubscribeLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeLogsRequest.Size(m)
+}
+func (m *SubscribeLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeLogsRequest proto.InternalMessageInfo
+
+func (m *SubscribeLogsRequest) GetLevel() LogLevel {
+	if m != nil {
+		return m.Level
+	}
+	return LogLevel_LOG_LEVEL_NONE
+}
+
+func (m *SubscribeLogsRequest) GetDumpConfig() bool {
+	if m != nil {
+		return m.DumpConfig
+	}
+	return false
+}
+
+type SubscribeLogsResponse struct {
+	Level LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=LogLevel" json:"level,omitempty"`
+	// legacy: the tag is part of the message since ESPHome 1.16, older nodes still send it
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SendFailed           bool     `protobuf:"varint,4,opt,name=send_failed,json=sendFailed,proto3" json:"send_failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeLogsResponse) Reset()         { *m = SubscribeLogsResponse{} }
+func (m *SubscribeLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeLogsResponse) ProtoMessage()    {}
+func (*SubscribeLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+}
+
+func (m *SubscribeLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeLogsResponse.Unmarshal(m, b)
+}
+func (m *SubscribeLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeLogsResponse.Merge(m, src)
+}
+func (m *SubscribeLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeLogsResponse.Size(m)
+}
+func (m *SubscribeLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeLogsResponse proto.InternalMessageInfo
+
+func (m *SubscribeLogsResponse) GetLevel() LogLevel {
+	if m != nil {
+		return m.Level
+	}
+	return LogLevel_LOG_LEVEL_NONE
+}
+
+func (m *SubscribeLogsResponse) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *SubscribeLogsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SubscribeLogsResponse) GetSendFailed() bool {
+	if m != nil {
+		return m.SendFailed
+	}
+	return false
+}
+
+// ==================== HOMEASSISTANT.SERVICE ====================
+type SubscribeHomeassistantServicesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeHomeassistantServicesRequest) Reset()         { *m = SubscribeHomeassistantServicesRequest{} }
+func (m *SubscribeHomeassistantServicesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHomeassistantServicesRequest) ProtoMessage()    {}
+func (*SubscribeHomeassistantServicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+}
+
+func (m *SubscribeHomeassistantServicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHomeassistantServicesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeHomeassistantServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHomeassistantServicesRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeHomeassistantServicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHomeassistantServicesRequest.Merge(m, src)
+}
+func (m *SubscribeHomeassistantServicesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHomeassistantServicesRequest.Size(m)
+}
+func (m *SubscribeHomeassistantServicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHomeassistantServicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHomeassistantServicesRequest proto.InternalMessageInfo
+
+type HomeassistantServiceMap struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HomeassistantServiceMap) Reset()         { *m = HomeassistantServiceMap{} }
+func (m *HomeassistantServiceMap) String() string { return proto.CompactTextString(m) }
+func (*HomeassistantServiceMap) ProtoMessage()    {}
+func (*HomeassistantServiceMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+}
+
+func (m *HomeassistantServiceMap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HomeassistantServiceMap.Unmarshal(m, b)
+}
+func (m *HomeassistantServiceMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HomeassistantServiceMap.Marshal(b, m, deterministic)
+}
+func (m *HomeassistantServiceMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HomeassistantServiceMap.Merge(m, src)
+}
+func (m *HomeassistantServiceMap) XXX_Size() int {
+	return xxx_messageInfo_HomeassistantServiceMap.Size(m)
+}
+func (m *HomeassistantServiceMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_HomeassistantServiceMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HomeassistantServiceMap proto.InternalMessageInfo
+
+func (m *HomeassistantServiceMap) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HomeassistantServiceMap) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type HomeassistantServiceResponse struct {
+	Service              string                     `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Data                 []*HomeassistantServiceMap `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	DataTemplate         []*HomeassistantServiceMap `protobuf:"bytes,3,rep,name=data_template,json=dataTemplate,proto3" json:"data_template,omitempty"`
+	Variables            []*HomeassistantServiceMap `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	IsEvent              bool                       `protobuf:"varint,5,opt,name=is_event,json=isEvent,proto3" json:"is_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *HomeassistantServiceResponse) Reset()         { *m = HomeassistantServiceResponse{} }
+func (m *HomeassistantServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*HomeassistantServiceResponse) ProtoMessage()    {}
+func (*HomeassistantServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+}
+
+func (m *HomeassistantServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HomeassistantServiceResponse.Unmarshal(m, b)
+}
+func (m *HomeassistantServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HomeassistantServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *HomeassistantServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HomeassistantServiceResponse.Merge(m, src)
+}
+func (m *HomeassistantServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_HomeassistantServiceResponse.Size(m)
+}
+func (m *HomeassistantServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HomeassistantServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HomeassistantServiceResponse proto.InternalMessageInfo
+
+func (m *HomeassistantServiceResponse) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *HomeassistantServiceResponse) GetData() []*HomeassistantServiceMap {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *HomeassistantServiceResponse) GetDataTemplate() []*HomeassistantServiceMap {
+	if m != nil {
+		return m.DataTemplate
+	}
+	return nil
+}
+
+func (m *HomeassistantServiceResponse) GetVariables() []*HomeassistantServiceMap {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *HomeassistantServiceResponse) GetIsEvent() bool {
+	if m != nil {
+		return m.IsEvent
+	}
+	return false
+}
+
+// ==================== IMPORT HOME ASSISTANT STATES ====================
+// 1. Client sends SubscribeHomeAssistantStatesRequest
+// 2. Server responds with zero or more SubscribeHomeAssistantStateResponse (async)
+// 3. Client sends HomeAssistantStateResponse for state changes.
+type SubscribeHomeAssistantStatesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeHomeAssistantStatesRequest) Reset()         { *m = SubscribeHomeAssistantStatesRequest{} }
+func (m *SubscribeHomeAssistantStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHomeAssistantStatesRequest) ProtoMessage()    {}
+func (*SubscribeHomeAssistantStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+}
+
+func (m *SubscribeHomeAssistantStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeHomeAssistantStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeHomeAssistantStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Merge(m, src)
+}
+func (m *SubscribeHomeAssistantStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Size(m)
+}
+func (m *SubscribeHomeAssistantStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHomeAssistantStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHomeAssistantStatesRequest proto.InternalMessageInfo
+
+type SubscribeHomeAssistantStateResponse struct {
+	EntityId             string   `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Attribute            string   `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Once                 bool     `protobuf:"varint,3,opt,name=once,proto3" json:"once,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeHomeAssistantStateResponse) Reset()         { *m = SubscribeHomeAssistantStateResponse{} }
+func (m *SubscribeHomeAssistantStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHomeAssistantStateResponse) ProtoMessage()    {}
+func (*SubscribeHomeAssistantStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+}
+
+func (m *SubscribeHomeAssistantStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHomeAssistantStateResponse.Unmarshal(m, b)
+}
+func (m *SubscribeHomeAssistantStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHomeAssistantStateResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeHomeAssistantStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHomeAssistantStateResponse.Merge(m, src)
+}
+func (m *SubscribeHomeAssistantStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHomeAssistantStateResponse.Size(m)
+}
+func (m *SubscribeHomeAssistantStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHomeAssistantStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHomeAssistantStateResponse proto.InternalMessageInfo
+
+func (m *SubscribeHomeAssistantStateResponse) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *SubscribeHomeAssistantStateResponse) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *SubscribeHomeAssistantStateResponse) GetOnce() bool {
+	if m != nil {
+		return m.Once
+	}
+	return false
+}
+
+type HomeAssistantStateResponse struct {
+	EntityId             string   `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Attribute            string   `protobuf:"bytes,3,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HomeAssistantStateResponse) Reset()         { *m = HomeAssistantStateResponse{} }
+func (m *HomeAssistantStateResponse) String() string { return proto.CompactTextString(m) }
+func (*HomeAssistantStateResponse) ProtoMessage()    {}
+func (*HomeAssistantStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+}
+
+func (m *HomeAssistantStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HomeAssistantStateResponse.Unmarshal(m, b)
+}
+func (m *HomeAssistantStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HomeAssistantStateResponse.Marshal(b, m, deterministic)
+}
+func (m *HomeAssistantStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HomeAssistantStateResponse.Merge(m, src)
+}
+func (m *HomeAssistantStateResponse) XXX_Size() int {
+	return xxx_messageInfo_HomeAssistantStateResponse.Size(m)
+}
+func (m *HomeAssistantStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HomeAssistantStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HomeAssistantStateResponse proto.InternalMessageInfo
+
+func (m *HomeAssistantStateResponse) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *HomeAssistantStateResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *HomeAssistantStateResponse) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+// ==================== IMPORT TIME ====================
+type GetTimeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTimeRequest) Reset()         { *m = GetTimeRequest{} }
+func (m *GetTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTimeRequest) ProtoMessage()    {}
+func (*GetTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+}
+
+func (m *GetTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTimeRequest.Unmarshal(m, b)
+}
+func (m *GetTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimeRequest.Merge(m, src)
+}
+func (m *GetTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTimeRequest.Size(m)
+}
+func (m *GetTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimeRequest proto.InternalMessageInfo
+
+type GetTimeResponse struct {
+	EpochSeconds         uint32   `protobuf:"fixed32,1,opt,name=epoch_seconds,json=epochSeconds,proto3" json:"epoch_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTimeResponse) Reset()         { *m = GetTimeResponse{} }
+func (m *GetTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTimeResponse) ProtoMessage()    {}
+func (*GetTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+}
+
+func (m *GetTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTimeResponse.Unmarshal(m, b)
+}
+func (m *GetTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTimeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimeResponse.Merge(m, src)
+}
+func (m *GetTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTimeResponse.Size(m)
+}
+func (m *GetTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimeResponse proto.InternalMessageInfo
+
+func (m *GetTimeResponse) GetEpochSeconds() uint32 {
+	if m != nil {
+		return m.EpochSeconds
+	}
+	return 0
+}
+
+type ListEntitiesServicesArgument struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 ServiceArgType `protobuf:"varint,2,opt,name=type,proto3,enum=ServiceArgType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesServicesArgument) Reset()         { *m = ListEntitiesServicesArgument{} }
+func (m *ListEntitiesServicesArgument) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesServicesArgument) ProtoMessage()    {}
+func (*ListEntitiesServicesArgument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
+
+func (m *ListEntitiesServicesArgument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesServicesArgument.Unmarshal(m, b)
+}
+func (m *ListEntitiesServicesArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesServicesArgument.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesServicesArgument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesServicesArgument.Merge(m, src)
+}
+func (m *ListEntitiesServicesArgument) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesServicesArgument.Size(m)
+}
+func (m *ListEntitiesServicesArgument) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesServicesArgument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesServicesArgument proto.InternalMessageInfo
+
+func (m *ListEntitiesServicesArgument) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesServicesArgument) GetType() ServiceArgType {
+	if m != nil {
+		return m.Type
+	}
+	return ServiceArgType_SERVICE_ARG_TYPE_BOOL
+}
+
+type ListEntitiesServicesResponse struct {
+	Name                 string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  uint32                          `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Args                 []*ListEntitiesServicesArgument `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ListEntitiesServicesResponse) Reset()         { *m = ListEntitiesServicesResponse{} }
+func (m *ListEntitiesServicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesServicesResponse) ProtoMessage()    {}
+func (*ListEntitiesServicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *ListEntitiesServicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesServicesResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesServicesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesServicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesServicesResponse.Merge(m, src)
+}
+func (m *ListEntitiesServicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesServicesResponse.Size(m)
+}
+func (m *ListEntitiesServicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesServicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesServicesResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesServicesResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesServicesResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesServicesResponse) GetArgs() []*ListEntitiesServicesArgument {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type ExecuteServiceArgument struct {
+	Bool_     bool    `protobuf:"varint,1,opt,name=bool_,json=bool,proto3" json:"bool_,omitempty"`
+	LegacyInt int32   `protobuf:"varint,2,opt,name=legacy_int,json=legacyInt,proto3" json:"legacy_int,omitempty"`
+	Float_    float32 `protobuf:"fixed32,3,opt,name=float_,json=float,proto3" json:"float_,omitempty"`
+	String_   string  `protobuf:"bytes,4,opt,name=string_,json=string,proto3" json:"string_,omitempty"`
+	// ESPHome 1.14 (api v1.3) make int a signed value
+	Int_                 int32     `protobuf:"zigzag32,5,opt,name=int_,json=int,proto3" json:"int_,omitempty"`
+	BoolArray            []bool    `protobuf:"varint,6,rep,name=bool_array,json=boolArray,proto3" json:"bool_array,omitempty"`
+	IntArray             []int32   `protobuf:"zigzag32,7,rep,name=int_array,json=intArray,proto3" json:"int_array,omitempty"`
+	FloatArray           []float32 `protobuf:"fixed32,8,rep,name=float_array,json=floatArray,proto3" json:"float_array,omitempty"`
+	StringArray          []string  `protobuf:"bytes,9,rep,name=string_array,json=stringArray,proto3" json:"string_array,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ExecuteServiceArgument) Reset()         { *m = ExecuteServiceArgument{} }
+func (m *ExecuteServiceArgument) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceArgument) ProtoMessage()    {}
+func (*ExecuteServiceArgument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+
+func (m *ExecuteServiceArgument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceArgument.Unmarshal(m, b)
+}
+func (m *ExecuteServiceArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceArgument.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceArgument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceArgument.Merge(m, src)
+}
+func (m *ExecuteServiceArgument) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceArgument.Size(m)
+}
+func (m *ExecuteServiceArgument) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceArgument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceArgument proto.InternalMessageInfo
+
+func (m *ExecuteServiceArgument) GetBool_() bool {
+	if m != nil {
+		return m.Bool_
+	}
+	return false
+}
+
+func (m *ExecuteServiceArgument) GetLegacyInt() int32 {
+	if m != nil {
+		return m.LegacyInt
+	}
+	return 0
+}
+
+func (m *ExecuteServiceArgument) GetFloat_() float32 {
+	if m != nil {
+		return m.Float_
+	}
+	return 0
+}
+
+func (m *ExecuteServiceArgument) GetString_() string {
+	if m != nil {
+		return m.String_
+	}
+	return ""
+}
+
+func (m *ExecuteServiceArgument) GetInt_() int32 {
+	if m != nil {
+		return m.Int_
+	}
+	return 0
+}
+
+func (m *ExecuteServiceArgument) GetBoolArray() []bool {
+	if m != nil {
+		return m.BoolArray
+	}
+	return nil
+}
+
+func (m *ExecuteServiceArgument) GetIntArray() []int32 {
+	if m != nil {
+		return m.IntArray
+	}
+	return nil
+}
+
+func (m *ExecuteServiceArgument) GetFloatArray() []float32 {
+	if m != nil {
+		return m.FloatArray
+	}
+	return nil
+}
+
+func (m *ExecuteServiceArgument) GetStringArray() []string {
+	if m != nil {
+		return m.StringArray
+	}
+	return nil
+}
+
+type ExecuteServiceRequest struct {
+	Key                  uint32                    `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Args                 []*ExecuteServiceArgument `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ExecuteServiceRequest) Reset()         { *m = ExecuteServiceRequest{} }
+func (m *ExecuteServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceRequest) ProtoMessage()    {}
+func (*ExecuteServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+
+func (m *ExecuteServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceRequest.Unmarshal(m, b)
+}
+func (m *ExecuteServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceRequest.Merge(m, src)
+}
+func (m *ExecuteServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceRequest.Size(m)
+}
+func (m *ExecuteServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceRequest proto.InternalMessageInfo
+
+func (m *ExecuteServiceRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ExecuteServiceRequest) GetArgs() []*ExecuteServiceArgument {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+// ==================== CAMERA ====================
+type ListEntitiesCameraResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,5,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Icon                 string         `protobuf:"bytes,6,opt,name=icon,proto3" json:"icon,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesCameraResponse) Reset()         { *m = ListEntitiesCameraResponse{} }
+func (m *ListEntitiesCameraResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesCameraResponse) ProtoMessage()    {}
+func (*ListEntitiesCameraResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *ListEntitiesCameraResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesCameraResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesCameraResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesCameraResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesCameraResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesCameraResponse.Merge(m, src)
+}
+func (m *ListEntitiesCameraResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesCameraResponse.Size(m)
+}
+func (m *ListEntitiesCameraResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesCameraResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesCameraResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesCameraResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesCameraResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesCameraResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesCameraResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesCameraResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesCameraResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesCameraResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+type CameraImageResponse struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Done                 bool     `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CameraImageResponse) Reset()         { *m = CameraImageResponse{} }
+func (m *CameraImageResponse) String() string { return proto.CompactTextString(m) }
+func (*CameraImageResponse) ProtoMessage()    {}
+func (*CameraImageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *CameraImageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CameraImageResponse.Unmarshal(m, b)
+}
+func (m *CameraImageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CameraImageResponse.Marshal(b, m, deterministic)
+}
+func (m *CameraImageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CameraImageResponse.Merge(m, src)
+}
+func (m *CameraImageResponse) XXX_Size() int {
+	return xxx_messageInfo_CameraImageResponse.Size(m)
+}
+func (m *CameraImageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CameraImageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CameraImageResponse proto.InternalMessageInfo
+
+func (m *CameraImageResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *CameraImageResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CameraImageResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type CameraImageRequest struct {
+	Single               bool     `protobuf:"varint,1,opt,name=single,proto3" json:"single,omitempty"`
+	Stream               bool     `protobuf:"varint,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CameraImageRequest) Reset()         { *m = CameraImageRequest{} }
+func (m *CameraImageRequest) String() string { return proto.CompactTextString(m) }
+func (*CameraImageRequest) ProtoMessage()    {}
+func (*CameraImageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *CameraImageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CameraImageRequest.Unmarshal(m, b)
+}
+func (m *CameraImageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CameraImageRequest.Marshal(b, m, deterministic)
+}
+func (m *CameraImageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CameraImageRequest.Merge(m, src)
+}
+func (m *CameraImageRequest) XXX_Size() int {
+	return xxx_messageInfo_CameraImageRequest.Size(m)
+}
+func (m *CameraImageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CameraImageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CameraImageRequest proto.InternalMessageInfo
+
+func (m *CameraImageRequest) GetSingle() bool {
+	if m != nil {
+		return m.Single
+	}
+	return false
+}
+
+func (m *CameraImageRequest) GetStream() bool {
+	if m != nil {
+		return m.Stream
+	}
+	return false
+}
+
+type ListEntitiesClimateResponse struct {
+	ObjectId                          string        `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                               uint32        `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                              string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId                          string        `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	SupportsCurrentTemperature        bool          `protobuf:"varint,5,opt,name=supports_current_temperature,json=supportsCurrentTemperature,proto3" json:"supports_current_temperature,omitempty"`
+	SupportsTwoPointTargetTemperature bool          `protobuf:"varint,6,opt,name=supports_two_point_target_temperature,json=supportsTwoPointTargetTemperature,proto3" json:"supports_two_point_target_temperature,omitempty"`
+	SupportedModes                    []ClimateMode `protobuf:"varint,7,rep,packed,name=supported_modes,json=supportedModes,proto3,enum=ClimateMode" json:"supported_modes,omitempty"`
+	VisualMinTemperature              float32       `protobuf:"fixed32,8,opt,name=visual_min_temperature,json=visualMinTemperature,proto3" json:"visual_min_temperature,omitempty"`
+	VisualMaxTemperature              float32       `protobuf:"fixed32,9,opt,name=visual_max_temperature,json=visualMaxTemperature,proto3" json:"visual_max_temperature,omitempty"`
+	VisualTargetTemperatureStep       float32       `protobuf:"fixed32,10,opt,name=visual_target_temperature_step,json=visualTargetTemperatureStep,proto3" json:"visual_target_temperature_step,omitempty"`
+	// legacy: away has been replaced by presets in API 1.5
+	LegacySupportsAway           bool               `protobuf:"varint,11,opt,name=legacy_supports_away,json=legacySupportsAway,proto3" json:"legacy_supports_away,omitempty"`
+	SupportsAction               bool               `protobuf:"varint,12,opt,name=supports_action,json=supportsAction,proto3" json:"supports_action,omitempty"`
+	SupportedFanModes            []ClimateFanMode   `protobuf:"varint,13,rep,packed,name=supported_fan_modes,json=supportedFanModes,proto3,enum=ClimateFanMode" json:"supported_fan_modes,omitempty"`
+	SupportedSwingModes          []ClimateSwingMode `protobuf:"varint,14,rep,packed,name=supported_swing_modes,json=supportedSwingModes,proto3,enum=ClimateSwingMode" json:"supported_swing_modes,omitempty"`
+	SupportedCustomFanModes      []string           `protobuf:"bytes,15,rep,name=supported_custom_fan_modes,json=supportedCustomFanModes,proto3" json:"supported_custom_fan_modes,omitempty"`
+	SupportedPresets             []ClimatePreset    `protobuf:"varint,16,rep,packed,name=supported_presets,json=supportedPresets,proto3,enum=ClimatePreset" json:"supported_presets,omitempty"`
+	SupportedCustomPresets       []string           `protobuf:"bytes,17,rep,name=supported_custom_presets,json=supportedCustomPresets,proto3" json:"supported_custom_presets,omitempty"`
+	DisabledByDefault            bool               `protobuf:"varint,18,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Icon                         string             `protobuf:"bytes,19,opt,name=icon,proto3" json:"icon,omitempty"`
+	EntityCategory               EntityCategory     `protobuf:"varint,20,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	VisualCurrentTemperatureStep float32            `protobuf:"fixed32,21,opt,name=visual_current_temperature_step,json=visualCurrentTemperatureStep,proto3" json:"visual_current_temperature_step,omitempty"`
+	SupportsCurrentHumidity      bool               `protobuf:"varint,22,opt,name=supports_current_humidity,json=supportsCurrentHumidity,proto3" json:"supports_current_humidity,omitempty"`
+	SupportsTargetHumidity       bool               `protobuf:"varint,23,opt,name=supports_target_humidity,json=supportsTargetHumidity,proto3" json:"supports_target_humidity,omitempty"`
+	VisualMinHumidity            float32            `protobuf:"fixed32,24,opt,name=visual_min_humidity,json=visualMinHumidity,proto3" json:"visual_min_humidity,omitempty"`
+	VisualMaxHumidity            float32            `protobuf:"fixed32,25,opt,name=visual_max_humidity,json=visualMaxHumidity,proto3" json:"visual_max_humidity,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}           `json:"-"`
+	XXX_unrecognized             []byte             `json:"-"`
+	XXX_sizecache                int32              `json:"-"`
+}
+
+func (m *ListEntitiesClimateResponse) Reset()         { *m = ListEntitiesClimateResponse{} }
+func (m *ListEntitiesClimateResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesClimateResponse) ProtoMessage()    {}
+func (*ListEntitiesClimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *ListEntitiesClimateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesClimateResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesClimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesClimateResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesClimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesClimateResponse.Merge(m, src)
+}
+func (m *ListEntitiesClimateResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesClimateResponse.Size(m)
+}
+func (m *ListEntitiesClimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesClimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesClimateResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesClimateResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesClimateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesClimateResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesClimateResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportsCurrentTemperature() bool {
+	if m != nil {
+		return m.SupportsCurrentTemperature
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportsTwoPointTargetTemperature() bool {
+	if m != nil {
+		return m.SupportsTwoPointTargetTemperature
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportedModes() []ClimateMode {
+	if m != nil {
+		return m.SupportedModes
+	}
+	return nil
+}
+
+func (m *ListEntitiesClimateResponse) GetVisualMinTemperature() float32 {
+	if m != nil {
+		return m.VisualMinTemperature
+	}
+	return 0
+}
+
+func (m *ListEntitiesClimateResponse) GetVisualMaxTemperature() float32 {
+	if m != nil {
+		return m.VisualMaxTemperature
+	}
+	return 0
+}
+
+func (m *ListEntitiesClimateResponse) GetVisualTargetTemperatureStep() float32 {
+	if m != nil {
+		return m.VisualTargetTemperatureStep
+	}
+	return 0
+}
+
+func (m *ListEntitiesClimateResponse) GetLegacySupportsAway() bool {
+	if m != nil {
+		return m.LegacySupportsAway
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportsAction() bool {
+	if m != nil {
+		return m.SupportsAction
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportedFanModes() []ClimateFanMode {
+	if m != nil {
+		return m.SupportedFanModes
+	}
+	return nil
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportedSwingModes() []ClimateSwingMode {
+	if m != nil {
+		return m.SupportedSwingModes
+	}
+	return nil
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportedCustomFanModes() []string {
+	if m != nil {
+		return m.SupportedCustomFanModes
+	}
+	return nil
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportedPresets() []ClimatePreset {
+	if m != nil {
+		return m.SupportedPresets
+	}
+	return nil
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportedCustomPresets() []string {
+	if m != nil {
+		return m.SupportedCustomPresets
+	}
+	return nil
+}
+
+func (m *ListEntitiesClimateResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesClimateResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesClimateResponse) GetVisualCurrentTemperatureStep() float32 {
+	if m != nil {
+		return m.VisualCurrentTemperatureStep
+	}
+	return 0
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportsCurrentHumidity() bool {
+	if m != nil {
+		return m.SupportsCurrentHumidity
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetSupportsTargetHumidity() bool {
+	if m != nil {
+		return m.SupportsTargetHumidity
+	}
+	return false
+}
+
+func (m *ListEntitiesClimateResponse) GetVisualMinHumidity() float32 {
+	if m != nil {
+		return m.VisualMinHumidity
+	}
+	return 0
+}
+
+func (m *ListEntitiesClimateResponse) GetVisualMaxHumidity() float32 {
+	if m != nil {
+		return m.VisualMaxHumidity
+	}
+	return 0
+}
+
+type ClimateStateResponse struct {
+	Key                   uint32      `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Mode                  ClimateMode `protobuf:"varint,2,opt,name=mode,proto3,enum=ClimateMode" json:"mode,omitempty"`
+	CurrentTemperature    float32     `protobuf:"fixed32,3,opt,name=current_temperature,json=currentTemperature,proto3" json:"current_temperature,omitempty"`
+	TargetTemperature     float32     `protobuf:"fixed32,4,opt,name=target_temperature,json=targetTemperature,proto3" json:"target_temperature,omitempty"`
+	TargetTemperatureLow  float32     `protobuf:"fixed32,5,opt,name=target_temperature_low,json=targetTemperatureLow,proto3" json:"target_temperature_low,omitempty"`
+	TargetTemperatureHigh float32     `protobuf:"fixed32,6,opt,name=target_temperature_high,json=targetTemperatureHigh,proto3" json:"target_temperature_high,omitempty"`
+	// legacy: away has been replaced by presets in API 1.5
+	LegacyAway           bool             `protobuf:"varint,7,opt,name=legacy_away,json=legacyAway,proto3" json:"legacy_away,omitempty"`
+	Action               ClimateAction    `protobuf:"varint,8,opt,name=action,proto3,enum=ClimateAction" json:"action,omitempty"`
+	FanMode              ClimateFanMode   `protobuf:"varint,9,opt,name=fan_mode,json=fanMode,proto3,enum=ClimateFanMode" json:"fan_mode,omitempty"`
+	SwingMode            ClimateSwingMode `protobuf:"varint,10,opt,name=swing_mode,json=swingMode,proto3,enum=ClimateSwingMode" json:"swing_mode,omitempty"`
+	CustomFanMode        string           `protobuf:"bytes,11,opt,name=custom_fan_mode,json=customFanMode,proto3" json:"custom_fan_mode,omitempty"`
+	Preset               ClimatePreset    `protobuf:"varint,12,opt,name=preset,proto3,enum=ClimatePreset" json:"preset,omitempty"`
+	CustomPreset         string           `protobuf:"bytes,13,opt,name=custom_preset,json=customPreset,proto3" json:"custom_preset,omitempty"`
+	CurrentHumidity      float32          `protobuf:"fixed32,14,opt,name=current_humidity,json=currentHumidity,proto3" json:"current_humidity,omitempty"`
+	TargetHumidity       float32          `protobuf:"fixed32,15,opt,name=target_humidity,json=targetHumidity,proto3" json:"target_humidity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ClimateStateResponse) Reset()         { *m = ClimateStateResponse{} }
+func (m *ClimateStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ClimateStateResponse) ProtoMessage()    {}
+func (*ClimateStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *ClimateStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClimateStateResponse.Unmarshal(m, b)
+}
+func (m *ClimateStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClimateStateResponse.Marshal(b, m, deterministic)
+}
+func (m *ClimateStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClimateStateResponse.Merge(m, src)
+}
+func (m *ClimateStateResponse) XXX_Size() int {
+	return xxx_messageInfo_ClimateStateResponse.Size(m)
+}
+func (m *ClimateStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClimateStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClimateStateResponse proto.InternalMessageInfo
+
+func (m *ClimateStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ClimateStateResponse) GetMode() ClimateMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ClimateMode_CLIMATE_MODE_OFF
+}
+
+func (m *ClimateStateResponse) GetCurrentTemperature() float32 {
+	if m != nil {
+		return m.CurrentTemperature
+	}
+	return 0
+}
+
+func (m *ClimateStateResponse) GetTargetTemperature() float32 {
+	if m != nil {
+		return m.TargetTemperature
+	}
+	return 0
+}
+
+func (m *ClimateStateResponse) GetTargetTemperatureLow() float32 {
+	if m != nil {
+		return m.TargetTemperatureLow
+	}
+	return 0
+}
+
+func (m *ClimateStateResponse) GetTargetTemperatureHigh() float32 {
+	if m != nil {
+		return m.TargetTemperatureHigh
+	}
+	return 0
+}
+
+func (m *ClimateStateResponse) GetLegacyAway() bool {
+	if m != nil {
+		return m.LegacyAway
+	}
+	return false
+}
+
+func (m *ClimateStateResponse) GetAction() ClimateAction {
+	if m != nil {
+		return m.Action
+	}
+	return ClimateAction_CLIMATE_ACTION_OFF
+}
+
+func (m *ClimateStateResponse) GetFanMode() ClimateFanMode {
+	if m != nil {
+		return m.FanMode
+	}
+	return ClimateFanMode_CLIMATE_FAN_ON
+}
+
+func (m *ClimateStateResponse) GetSwingMode() ClimateSwingMode {
+	if m != nil {
+		return m.SwingMode
+	}
+	return ClimateSwingMode_CLIMATE_SWING_OFF
+}
+
+func (m *ClimateStateResponse) GetCustomFanMode() string {
+	if m != nil {
+		return m.CustomFanMode
+	}
+	return ""
+}
+
+func (m *ClimateStateResponse) GetPreset() ClimatePreset {
+	if m != nil {
+		return m.Preset
+	}
+	return ClimatePreset_CLIMATE_PRESET_NONE
+}
+
+func (m *ClimateStateResponse) GetCustomPreset() string {
+	if m != nil {
+		return m.CustomPreset
+	}
+	return ""
+}
+
+func (m *ClimateStateResponse) GetCurrentHumidity() float32 {
+	if m != nil {
+		return m.CurrentHumidity
+	}
+	return 0
+}
+
+func (m *ClimateStateResponse) GetTargetHumidity() float32 {
+	if m != nil {
+		return m.TargetHumidity
+	}
+	return 0
+}
+
+type ClimateCommandRequest struct {
+	Key                      uint32      `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	HasMode                  bool        `protobuf:"varint,2,opt,name=has_mode,json=hasMode,proto3" json:"has_mode,omitempty"`
+	Mode                     ClimateMode `protobuf:"varint,3,opt,name=mode,proto3,enum=ClimateMode" json:"mode,omitempty"`
+	HasTargetTemperature     bool        `protobuf:"varint,4,opt,name=has_target_temperature,json=hasTargetTemperature,proto3" json:"has_target_temperature,omitempty"`
+	TargetTemperature        float32     `protobuf:"fixed32,5,opt,name=target_temperature,json=targetTemperature,proto3" json:"target_temperature,omitempty"`
+	HasTargetTemperatureLow  bool        `protobuf:"varint,6,opt,name=has_target_temperature_low,json=hasTargetTemperatureLow,proto3" json:"has_target_temperature_low,omitempty"`
+	TargetTemperatureLow     float32     `protobuf:"fixed32,7,opt,name=target_temperature_low,json=targetTemperatureLow,proto3" json:"target_temperature_low,omitempty"`
+	HasTargetTemperatureHigh bool        `protobuf:"varint,8,opt,name=has_target_temperature_high,json=hasTargetTemperatureHigh,proto3" json:"has_target_temperature_high,omitempty"`
+	TargetTemperatureHigh    float32     `protobuf:"fixed32,9,opt,name=target_temperature_high,json=targetTemperatureHigh,proto3" json:"target_temperature_high,omitempty"`
+	// legacy: away has been replaced by presets in API 1.5
+	HasLegacyAway        bool             `protobuf:"varint,10,opt,name=has_legacy_away,json=hasLegacyAway,proto3" json:"has_legacy_away,omitempty"`
+	LegacyAway           bool             `protobuf:"varint,11,opt,name=legacy_away,json=legacyAway,proto3" json:"legacy_away,omitempty"`
+	HasFanMode           bool             `protobuf:"varint,12,opt,name=has_fan_mode,json=hasFanMode,proto3" json:"has_fan_mode,omitempty"`
+	FanMode              ClimateFanMode   `protobuf:"varint,13,opt,name=fan_mode,json=fanMode,proto3,enum=ClimateFanMode" json:"fan_mode,omitempty"`
+	HasSwingMode         bool             `protobuf:"varint,14,opt,name=has_swing_mode,json=hasSwingMode,proto3" json:"has_swing_mode,omitempty"`
+	SwingMode            ClimateSwingMode `protobuf:"varint,15,opt,name=swing_mode,json=swingMode,proto3,enum=ClimateSwingMode" json:"swing_mode,omitempty"`
+	HasCustomFanMode     bool             `protobuf:"varint,16,opt,name=has_custom_fan_mode,json=hasCustomFanMode,proto3" json:"has_custom_fan_mode,omitempty"`
+	CustomFanMode        string           `protobuf:"bytes,17,opt,name=custom_fan_mode,json=customFanMode,proto3" json:"custom_fan_mode,omitempty"`
+	HasPreset            bool             `protobuf:"varint,18,opt,name=has_preset,json=hasPreset,proto3" json:"has_preset,omitempty"`
+	Preset               ClimatePreset    `protobuf:"varint,19,opt,name=preset,proto3,enum=ClimatePreset" json:"preset,omitempty"`
+	HasCustomPreset      bool             `protobuf:"varint,20,opt,name=has_custom_preset,json=hasCustomPreset,proto3" json:"has_custom_preset,omitempty"`
+	CustomPreset         string           `protobuf:"bytes,21,opt,name=custom_preset,json=customPreset,proto3" json:"custom_preset,omitempty"`
+	HasTargetHumidity    bool             `protobuf:"varint,22,opt,name=has_target_humidity,json=hasTargetHumidity,proto3" json:"has_target_humidity,omitempty"`
+	TargetHumidity       float32          `protobuf:"fixed32,23,opt,name=target_humidity,json=targetHumidity,proto3" json:"target_humidity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ClimateCommandRequest) Reset()         { *m = ClimateCommandRequest{} }
+func (m *ClimateCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*ClimateCommandRequest) ProtoMessage()    {}
+func (*ClimateCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *ClimateCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClimateCommandRequest.Unmarshal(m, b)
+}
+func (m *ClimateCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClimateCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *ClimateCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClimateCommandRequest.Merge(m, src)
+}
+func (m *ClimateCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_ClimateCommandRequest.Size(m)
+}
+func (m *ClimateCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClimateCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClimateCommandRequest proto.InternalMessageInfo
+
+func (m *ClimateCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ClimateCommandRequest) GetHasMode() bool {
+	if m != nil {
+		return m.HasMode
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetMode() ClimateMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ClimateMode_CLIMATE_MODE_OFF
+}
+
+func (m *ClimateCommandRequest) GetHasTargetTemperature() bool {
+	if m != nil {
+		return m.HasTargetTemperature
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetTargetTemperature() float32 {
+	if m != nil {
+		return m.TargetTemperature
+	}
+	return 0
+}
+
+func (m *ClimateCommandRequest) GetHasTargetTemperatureLow() bool {
+	if m != nil {
+		return m.HasTargetTemperatureLow
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetTargetTemperatureLow() float32 {
+	if m != nil {
+		return m.TargetTemperatureLow
+	}
+	return 0
+}
+
+func (m *ClimateCommandRequest) GetHasTargetTemperatureHigh() bool {
+	if m != nil {
+		return m.HasTargetTemperatureHigh
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetTargetTemperatureHigh() float32 {
+	if m != nil {
+		return m.TargetTemperatureHigh
+	}
+	return 0
+}
+
+func (m *ClimateCommandRequest) GetHasLegacyAway() bool {
+	if m != nil {
+		return m.HasLegacyAway
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetLegacyAway() bool {
+	if m != nil {
+		return m.LegacyAway
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetHasFanMode() bool {
+	if m != nil {
+		return m.HasFanMode
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetFanMode() ClimateFanMode {
+	if m != nil {
+		return m.FanMode
+	}
+	return ClimateFanMode_CLIMATE_FAN_ON
+}
+
+func (m *ClimateCommandRequest) GetHasSwingMode() bool {
+	if m != nil {
+		return m.HasSwingMode
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetSwingMode() ClimateSwingMode {
+	if m != nil {
+		return m.SwingMode
+	}
+	return ClimateSwingMode_CLIMATE_SWING_OFF
+}
+
+func (m *ClimateCommandRequest) GetHasCustomFanMode() bool {
+	if m != nil {
+		return m.HasCustomFanMode
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetCustomFanMode() string {
+	if m != nil {
+		return m.CustomFanMode
+	}
+	return ""
+}
+
+func (m *ClimateCommandRequest) GetHasPreset() bool {
+	if m != nil {
+		return m.HasPreset
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetPreset() ClimatePreset {
+	if m != nil {
+		return m.Preset
+	}
+	return ClimatePreset_CLIMATE_PRESET_NONE
+}
+
+func (m *ClimateCommandRequest) GetHasCustomPreset() bool {
+	if m != nil {
+		return m.HasCustomPreset
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetCustomPreset() string {
+	if m != nil {
+		return m.CustomPreset
+	}
+	return ""
+}
+
+func (m *ClimateCommandRequest) GetHasTargetHumidity() bool {
+	if m != nil {
+		return m.HasTargetHumidity
+	}
+	return false
+}
+
+func (m *ClimateCommandRequest) GetTargetHumidity() float32 {
+	if m != nil {
+		return m.TargetHumidity
+	}
+	return 0
+}
+
+type ListEntitiesNumberResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	MinValue             float32        `protobuf:"fixed32,6,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue             float32        `protobuf:"fixed32,7,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Step                 float32        `protobuf:"fixed32,8,opt,name=step,proto3" json:"step,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,9,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,10,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	UnitOfMeasurement    string         `protobuf:"bytes,11,opt,name=unit_of_measurement,json=unitOfMeasurement,proto3" json:"unit_of_measurement,omitempty"`
+	Mode                 NumberMode     `protobuf:"varint,12,opt,name=mode,proto3,enum=NumberMode" json:"mode,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,13,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesNumberResponse) Reset()         { *m = ListEntitiesNumberResponse{} }
+func (m *ListEntitiesNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesNumberResponse) ProtoMessage()    {}
+func (*ListEntitiesNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *ListEntitiesNumberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesNumberResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesNumberResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesNumberResponse.Merge(m, src)
+}
+func (m *ListEntitiesNumberResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesNumberResponse.Size(m)
+}
+func (m *ListEntitiesNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesNumberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesNumberResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesNumberResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesNumberResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesNumberResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesNumberResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesNumberResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesNumberResponse) GetMinValue() float32 {
+	if m != nil {
+		return m.MinValue
+	}
+	return 0
+}
+
+func (m *ListEntitiesNumberResponse) GetMaxValue() float32 {
+	if m != nil {
+		return m.MaxValue
+	}
+	return 0
+}
+
+func (m *ListEntitiesNumberResponse) GetStep() float32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *ListEntitiesNumberResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesNumberResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesNumberResponse) GetUnitOfMeasurement() string {
+	if m != nil {
+		return m.UnitOfMeasurement
+	}
+	return ""
+}
+
+func (m *ListEntitiesNumberResponse) GetMode() NumberMode {
+	if m != nil {
+		return m.Mode
+	}
+	return NumberMode_NUMBER_MODE_AUTO
+}
+
+func (m *ListEntitiesNumberResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+type NumberStateResponse struct {
+	Key   uint32  `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State float32 `protobuf:"fixed32,2,opt,name=state,proto3" json:"state,omitempty"`
+	// If the number does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NumberStateResponse) Reset()         { *m = NumberStateResponse{} }
+func (m *NumberStateResponse) String() string { return proto.CompactTextString(m) }
+func (*NumberStateResponse) ProtoMessage()    {}
+func (*NumberStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *NumberStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NumberStateResponse.Unmarshal(m, b)
+}
+func (m *NumberStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NumberStateResponse.Marshal(b, m, deterministic)
+}
+func (m *NumberStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumberStateResponse.Merge(m, src)
+}
+func (m *NumberStateResponse) XXX_Size() int {
+	return xxx_messageInfo_NumberStateResponse.Size(m)
+}
+func (m *NumberStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumberStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumberStateResponse proto.InternalMessageInfo
+
+func (m *NumberStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *NumberStateResponse) GetState() float32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *NumberStateResponse) GetMissingState() bool {
+	if m != nil {
+		return m.MissingState
+	}
+	return false
+}
+
+type NumberCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                float32  `protobuf:"fixed32,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NumberCommandRequest) Reset()         { *m = NumberCommandRequest{} }
+func (m *NumberCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*NumberCommandRequest) ProtoMessage()    {}
+func (*NumberCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *NumberCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NumberCommandRequest.Unmarshal(m, b)
+}
+func (m *NumberCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NumberCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *NumberCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumberCommandRequest.Merge(m, src)
+}
+func (m *NumberCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_NumberCommandRequest.Size(m)
+}
+func (m *NumberCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumberCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumberCommandRequest proto.InternalMessageInfo
+
+func (m *NumberCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *NumberCommandRequest) GetState() float32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+// ==================== SELECT ====================
+type ListEntitiesSelectResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Options              []string       `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,7,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,8,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesSelectResponse) Reset()         { *m = ListEntitiesSelectResponse{} }
+func (m *ListEntitiesSelectResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesSelectResponse) ProtoMessage()    {}
+func (*ListEntitiesSelectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *ListEntitiesSelectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesSelectResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesSelectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesSelectResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesSelectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesSelectResponse.Merge(m, src)
+}
+func (m *ListEntitiesSelectResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesSelectResponse.Size(m)
+}
+func (m *ListEntitiesSelectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesSelectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesSelectResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesSelectResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSelectResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesSelectResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesSelectResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSelectResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesSelectResponse) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ListEntitiesSelectResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesSelectResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+type SelectStateResponse struct {
+	Key   uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// If the select does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectStateResponse) Reset()         { *m = SelectStateResponse{} }
+func (m *SelectStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectStateResponse) ProtoMessage()    {}
+func (*SelectStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *SelectStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectStateResponse.Unmarshal(m, b)
+}
+func (m *SelectStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectStateResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectStateResponse.Merge(m, src)
+}
+func (m *SelectStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectStateResponse.Size(m)
+}
+func (m *SelectStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectStateResponse proto.InternalMessageInfo
+
+func (m *SelectStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SelectStateResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *SelectStateResponse) GetMissingState() bool {
+	if m != nil {
+		return m.MissingState
+	}
+	return false
+}
+
+type SelectCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectCommandRequest) Reset()         { *m = SelectCommandRequest{} }
+func (m *SelectCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectCommandRequest) ProtoMessage()    {}
+func (*SelectCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *SelectCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectCommandRequest.Unmarshal(m, b)
+}
+func (m *SelectCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectCommandRequest.Merge(m, src)
+}
+func (m *SelectCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectCommandRequest.Size(m)
+}
+func (m *SelectCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectCommandRequest proto.InternalMessageInfo
+
+func (m *SelectCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SelectCommandRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+// ==================== SIREN ====================
+type ListEntitiesSirenResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Tones                []string       `protobuf:"bytes,7,rep,name=tones,proto3" json:"tones,omitempty"`
+	SupportsDuration     bool           `protobuf:"varint,8,opt,name=supports_duration,json=supportsDuration,proto3" json:"supports_duration,omitempty"`
+	SupportsVolume       bool           `protobuf:"varint,9,opt,name=supports_volume,json=supportsVolume,proto3" json:"supports_volume,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,10,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesSirenResponse) Reset()         { *m = ListEntitiesSirenResponse{} }
+func (m *ListEntitiesSirenResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesSirenResponse) ProtoMessage()    {}
+func (*ListEntitiesSirenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *ListEntitiesSirenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesSirenResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesSirenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesSirenResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesSirenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesSirenResponse.Merge(m, src)
+}
+func (m *ListEntitiesSirenResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesSirenResponse.Size(m)
+}
+func (m *ListEntitiesSirenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesSirenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesSirenResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesSirenResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSirenResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesSirenResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesSirenResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesSirenResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesSirenResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesSirenResponse) GetTones() []string {
+	if m != nil {
+		return m.Tones
+	}
+	return nil
+}
+
+func (m *ListEntitiesSirenResponse) GetSupportsDuration() bool {
+	if m != nil {
+		return m.SupportsDuration
+	}
+	return false
+}
+
+func (m *ListEntitiesSirenResponse) GetSupportsVolume() bool {
+	if m != nil {
+		return m.SupportsVolume
+	}
+	return false
+}
+
+func (m *ListEntitiesSirenResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+type SirenStateResponse struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SirenStateResponse) Reset()         { *m = SirenStateResponse{} }
+func (m *SirenStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SirenStateResponse) ProtoMessage()    {}
+func (*SirenStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *SirenStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SirenStateResponse.Unmarshal(m, b)
+}
+func (m *SirenStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SirenStateResponse.Marshal(b, m, deterministic)
+}
+func (m *SirenStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SirenStateResponse.Merge(m, src)
+}
+func (m *SirenStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SirenStateResponse.Size(m)
+}
+func (m *SirenStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SirenStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SirenStateResponse proto.InternalMessageInfo
+
+func (m *SirenStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SirenStateResponse) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+type SirenCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	HasState             bool     `protobuf:"varint,2,opt,name=has_state,json=hasState,proto3" json:"has_state,omitempty"`
+	State                bool     `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	HasTone              bool     `protobuf:"varint,4,opt,name=has_tone,json=hasTone,proto3" json:"has_tone,omitempty"`
+	Tone                 string   `protobuf:"bytes,5,opt,name=tone,proto3" json:"tone,omitempty"`
+	HasDuration          bool     `protobuf:"varint,6,opt,name=has_duration,json=hasDuration,proto3" json:"has_duration,omitempty"`
+	Duration             uint32   `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	HasVolume            bool     `protobuf:"varint,8,opt,name=has_volume,json=hasVolume,proto3" json:"has_volume,omitempty"`
+	Volume               float32  `protobuf:"fixed32,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SirenCommandRequest) Reset()         { *m = SirenCommandRequest{} }
+func (m *SirenCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*SirenCommandRequest) ProtoMessage()    {}
+func (*SirenCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *SirenCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SirenCommandRequest.Unmarshal(m, b)
+}
+func (m *SirenCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SirenCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *SirenCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SirenCommandRequest.Merge(m, src)
+}
+func (m *SirenCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_SirenCommandRequest.Size(m)
+}
+func (m *SirenCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SirenCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SirenCommandRequest proto.InternalMessageInfo
+
+func (m *SirenCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *SirenCommandRequest) GetHasState() bool {
+	if m != nil {
+		return m.HasState
+	}
+	return false
+}
+
+func (m *SirenCommandRequest) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+func (m *SirenCommandRequest) GetHasTone() bool {
+	if m != nil {
+		return m.HasTone
+	}
+	return false
+}
+
+func (m *SirenCommandRequest) GetTone() string {
+	if m != nil {
+		return m.Tone
+	}
+	return ""
+}
+
+func (m *SirenCommandRequest) GetHasDuration() bool {
+	if m != nil {
+		return m.HasDuration
+	}
+	return false
+}
+
+func (m *SirenCommandRequest) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *SirenCommandRequest) GetHasVolume() bool {
+	if m != nil {
+		return m.HasVolume
+	}
+	return false
+}
+
+func (m *SirenCommandRequest) GetVolume() float32 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+type ListEntitiesLockResponse struct {
+	ObjectId          string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key               uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name              string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId          string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon              string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory    EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	AssumedState      bool           `protobuf:"varint,8,opt,name=assumed_state,json=assumedState,proto3" json:"assumed_state,omitempty"`
+	SupportsOpen      bool           `protobuf:"varint,9,opt,name=supports_open,json=supportsOpen,proto3" json:"supports_open,omitempty"`
+	RequiresCode      bool           `protobuf:"varint,10,opt,name=requires_code,json=requiresCode,proto3" json:"requires_code,omitempty"`
+	// Not yet implemented:
+	CodeFormat           string   `protobuf:"bytes,11,opt,name=code_format,json=codeFormat,proto3" json:"code_format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEntitiesLockResponse) Reset()         { *m = ListEntitiesLockResponse{} }
+func (m *ListEntitiesLockResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesLockResponse) ProtoMessage()    {}
+func (*ListEntitiesLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *ListEntitiesLockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesLockResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesLockResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesLockResponse.Merge(m, src)
+}
+func (m *ListEntitiesLockResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesLockResponse.Size(m)
+}
+func (m *ListEntitiesLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesLockResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesLockResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesLockResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesLockResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesLockResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesLockResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesLockResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesLockResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesLockResponse) GetAssumedState() bool {
+	if m != nil {
+		return m.AssumedState
+	}
+	return false
+}
+
+func (m *ListEntitiesLockResponse) GetSupportsOpen() bool {
+	if m != nil {
+		return m.SupportsOpen
+	}
+	return false
+}
+
+func (m *ListEntitiesLockResponse) GetRequiresCode() bool {
+	if m != nil {
+		return m.RequiresCode
+	}
+	return false
+}
+
+func (m *ListEntitiesLockResponse) GetCodeFormat() string {
+	if m != nil {
+		return m.CodeFormat
+	}
+	return ""
+}
+
+type LockStateResponse struct {
+	Key                  uint32    `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                LockState `protobuf:"varint,2,opt,name=state,proto3,enum=LockState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LockStateResponse) Reset()         { *m = LockStateResponse{} }
+func (m *LockStateResponse) String() string { return proto.CompactTextString(m) }
+func (*LockStateResponse) ProtoMessage()    {}
+func (*LockStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *LockStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockStateResponse.Unmarshal(m, b)
+}
+func (m *LockStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockStateResponse.Marshal(b, m, deterministic)
+}
+func (m *LockStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockStateResponse.Merge(m, src)
+}
+func (m *LockStateResponse) XXX_Size() int {
+	return xxx_messageInfo_LockStateResponse.Size(m)
+}
+func (m *LockStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockStateResponse proto.InternalMessageInfo
+
+func (m *LockStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *LockStateResponse) GetState() LockState {
+	if m != nil {
+		return m.State
+	}
+	return LockState_LOCK_STATE_NONE
+}
+
+type LockCommandRequest struct {
+	Key     uint32      `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Command LockCommand `protobuf:"varint,2,opt,name=command,proto3,enum=LockCommand" json:"command,omitempty"`
+	// Not yet implemented:
+	HasCode              bool     `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	Code                 string   `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockCommandRequest) Reset()         { *m = LockCommandRequest{} }
+func (m *LockCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*LockCommandRequest) ProtoMessage()    {}
+func (*LockCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *LockCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockCommandRequest.Unmarshal(m, b)
+}
+func (m *LockCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *LockCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockCommandRequest.Merge(m, src)
+}
+func (m *LockCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_LockCommandRequest.Size(m)
+}
+func (m *LockCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockCommandRequest proto.InternalMessageInfo
+
+func (m *LockCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *LockCommandRequest) GetCommand() LockCommand {
+	if m != nil {
+		return m.Command
+	}
+	return LockCommand_LOCK_UNLOCK
+}
+
+func (m *LockCommandRequest) GetHasCode() bool {
+	if m != nil {
+		return m.HasCode
+	}
+	return false
+}
+
+func (m *LockCommandRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+// ==================== BUTTON ====================
+type ListEntitiesButtonResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesButtonResponse) Reset()         { *m = ListEntitiesButtonResponse{} }
+func (m *ListEntitiesButtonResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesButtonResponse) ProtoMessage()    {}
+func (*ListEntitiesButtonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *ListEntitiesButtonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesButtonResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesButtonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesButtonResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesButtonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesButtonResponse.Merge(m, src)
+}
+func (m *ListEntitiesButtonResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesButtonResponse.Size(m)
+}
+func (m *ListEntitiesButtonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesButtonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesButtonResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesButtonResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesButtonResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesButtonResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesButtonResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesButtonResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesButtonResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesButtonResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesButtonResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
+}
+
+type ButtonCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ButtonCommandRequest) Reset()         { *m = ButtonCommandRequest{} }
+func (m *ButtonCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*ButtonCommandRequest) ProtoMessage()    {}
+func (*ButtonCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *ButtonCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ButtonCommandRequest.Unmarshal(m, b)
+}
+func (m *ButtonCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ButtonCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *ButtonCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ButtonCommandRequest.Merge(m, src)
+}
+func (m *ButtonCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_ButtonCommandRequest.Size(m)
+}
+func (m *ButtonCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ButtonCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ButtonCommandRequest proto.InternalMessageInfo
+
+func (m *ButtonCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+type MediaPlayerSupportedFormat struct {
+	Format               string                   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	SampleRate           uint32                   `protobuf:"varint,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	NumChannels          uint32                   `protobuf:"varint,3,opt,name=num_channels,json=numChannels,proto3" json:"num_channels,omitempty"`
+	Purpose              MediaPlayerFormatPurpose `protobuf:"varint,4,opt,name=purpose,proto3,enum=MediaPlayerFormatPurpose" json:"purpose,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MediaPlayerSupportedFormat) Reset()         { *m = MediaPlayerSupportedFormat{} }
+func (m *MediaPlayerSupportedFormat) String() string { return proto.CompactTextString(m) }
+func (*MediaPlayerSupportedFormat) ProtoMessage()    {}
+func (*MediaPlayerSupportedFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *MediaPlayerSupportedFormat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MediaPlayerSupportedFormat.Unmarshal(m, b)
+}
+func (m *MediaPlayerSupportedFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MediaPlayerSupportedFormat.Marshal(b, m, deterministic)
+}
+func (m *MediaPlayerSupportedFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MediaPlayerSupportedFormat.Merge(m, src)
+}
+func (m *MediaPlayerSupportedFormat) XXX_Size() int {
+	return xxx_messageInfo_MediaPlayerSupportedFormat.Size(m)
+}
+func (m *MediaPlayerSupportedFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MediaPlayerSupportedFormat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MediaPlayerSupportedFormat proto.InternalMessageInfo
+
+func (m *MediaPlayerSupportedFormat) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *MediaPlayerSupportedFormat) GetSampleRate() uint32 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *MediaPlayerSupportedFormat) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *MediaPlayerSupportedFormat) GetPurpose() MediaPlayerFormatPurpose {
+	if m != nil {
+		return m.Purpose
+	}
+	return MediaPlayerFormatPurpose_MEDIA_PLAYER_FORMAT_PURPOSE_DEFAULT
+}
+
+type ListEntitiesMediaPlayerResponse struct {
+	ObjectId             string                        `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32                        `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string                        `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string                        `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool                          `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory                `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	SupportsPause        bool                          `protobuf:"varint,8,opt,name=supports_pause,json=supportsPause,proto3" json:"supports_pause,omitempty"`
+	SupportedFormats     []*MediaPlayerSupportedFormat `protobuf:"bytes,9,rep,name=supported_formats,json=supportedFormats,proto3" json:"supported_formats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListEntitiesMediaPlayerResponse) Reset()         { *m = ListEntitiesMediaPlayerResponse{} }
+func (m *ListEntitiesMediaPlayerResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesMediaPlayerResponse) ProtoMessage()    {}
+func (*ListEntitiesMediaPlayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *ListEntitiesMediaPlayerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesMediaPlayerResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesMediaPlayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesMediaPlayerResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesMediaPlayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesMediaPlayerResponse.Merge(m, src)
+}
+func (m *ListEntitiesMediaPlayerResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesMediaPlayerResponse.Size(m)
+}
+func (m *ListEntitiesMediaPlayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesMediaPlayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesMediaPlayerResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesMediaPlayerResponse) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetSupportsPause() bool {
+	if m != nil {
+		return m.SupportsPause
+	}
+	return false
+}
+
+func (m *ListEntitiesMediaPlayerResponse) GetSupportedFormats() []*MediaPlayerSupportedFormat {
+	if m != nil {
+		return m.SupportedFormats
+	}
+	return nil
+}
+
+type MediaPlayerStateResponse struct {
+	Key                  uint32           `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                MediaPlayerState `protobuf:"varint,2,opt,name=state,proto3,enum=MediaPlayerState" json:"state,omitempty"`
+	Volume               float32          `protobuf:"fixed32,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Muted                bool             `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MediaPlayerStateResponse) Reset()         { *m = MediaPlayerStateResponse{} }
+func (m *MediaPlayerStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MediaPlayerStateResponse) ProtoMessage()    {}
+func (*MediaPlayerStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *MediaPlayerStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MediaPlayerStateResponse.Unmarshal(m, b)
+}
+func (m *MediaPlayerStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MediaPlayerStateResponse.Marshal(b, m, deterministic)
+}
+func (m *MediaPlayerStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MediaPlayerStateResponse.Merge(m, src)
+}
+func (m *MediaPlayerStateResponse) XXX_Size() int {
+	return xxx_messageInfo_MediaPlayerStateResponse.Size(m)
+}
+func (m *MediaPlayerStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MediaPlayerStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MediaPlayerStateResponse proto.InternalMessageInfo
+
+func (m *MediaPlayerStateResponse) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *MediaPlayerStateResponse) GetState() MediaPlayerState {
+	if m != nil {
+		return m.State
+	}
+	return MediaPlayerState_MEDIA_PLAYER_STATE_NONE
+}
+
+func (m *MediaPlayerStateResponse) GetVolume() float32 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *MediaPlayerStateResponse) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
 }
-func (m *ConnectResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectResponse.Merge(m, src)
+
+type MediaPlayerCommandRequest struct {
+	Key                  uint32             `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	HasCommand           bool               `protobuf:"varint,2,opt,name=has_command,json=hasCommand,proto3" json:"has_command,omitempty"`
+	Command              MediaPlayerCommand `protobuf:"varint,3,opt,name=command,proto3,enum=MediaPlayerCommand" json:"command,omitempty"`
+	HasVolume            bool               `protobuf:"varint,4,opt,name=has_volume,json=hasVolume,proto3" json:"has_volume,omitempty"`
+	Volume               float32            `protobuf:"fixed32,5,opt,name=volume,proto3" json:"volume,omitempty"`
+	HasMediaUrl          bool               `protobuf:"varint,6,opt,name=has_media_url,json=hasMediaUrl,proto3" json:"has_media_url,omitempty"`
+	MediaUrl             string             `protobuf:"bytes,7,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	HasAnnouncement      bool               `protobuf:"varint,8,opt,name=has_announcement,json=hasAnnouncement,proto3" json:"has_announcement,omitempty"`
+	Announcement         bool               `protobuf:"varint,9,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *ConnectResponse) XXX_Size() int {
-	return xxx_messageInfo_ConnectResponse.Size(m)
+
+func (m *MediaPlayerCommandRequest) Reset()         { *m = MediaPlayerCommandRequest{} }
+func (m *MediaPlayerCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*MediaPlayerCommandRequest) ProtoMessage()    {}
+func (*MediaPlayerCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
 }
-func (m *ConnectResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectResponse.DiscardUnknown(m)
+
+func (m *MediaPlayerCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MediaPlayerCommandRequest.Unmarshal(m, b)
+}
+func (m *MediaPlayerCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MediaPlayerCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *MediaPlayerCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MediaPlayerCommandRequest.Merge(m, src)
+}
+func (m *MediaPlayerCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_MediaPlayerCommandRequest.Size(m)
+}
+func (m *MediaPlayerCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MediaPlayerCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConnectResponse proto.InternalMessageInfo
+var xxx_messageInfo_MediaPlayerCommandRequest proto.InternalMessageInfo
 
-func (m *ConnectResponse) GetInvalidPassword() bool {
+func (m *MediaPlayerCommandRequest) GetKey() uint32 {
 	if m != nil {
-		return m.InvalidPassword
+		return m.Key
 	}
-	return false
+	return 0
 }
 
-// Request to close the connection.
-// Can be sent by both the client and server
-type DisconnectRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MediaPlayerCommandRequest) GetHasCommand() bool {
+	if m != nil {
+		return m.HasCommand
+	}
+	return false
 }
 
-func (m *DisconnectRequest) Reset()         { *m = DisconnectRequest{} }
-func (m *DisconnectRequest) String() string { return proto.CompactTextString(m) }
-func (*DisconnectRequest) ProtoMessage()    {}
-func (*DisconnectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+func (m *MediaPlayerCommandRequest) GetCommand() MediaPlayerCommand {
+	if m != nil {
+		return m.Command
+	}
+	return MediaPlayerCommand_MEDIA_PLAYER_COMMAND_PLAY
 }
 
-func (m *DisconnectRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisconnectRequest.Unmarshal(m, b)
+func (m *MediaPlayerCommandRequest) GetHasVolume() bool {
+	if m != nil {
+		return m.HasVolume
+	}
+	return false
 }
-func (m *DisconnectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisconnectRequest.Marshal(b, m, deterministic)
+
+func (m *MediaPlayerCommandRequest) GetVolume() float32 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
 }
-func (m *DisconnectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisconnectRequest.Merge(m, src)
+
+func (m *MediaPlayerCommandRequest) GetHasMediaUrl() bool {
+	if m != nil {
+		return m.HasMediaUrl
+	}
+	return false
 }
-func (m *DisconnectRequest) XXX_Size() int {
-	return xxx_messageInfo_DisconnectRequest.Size(m)
+
+func (m *MediaPlayerCommandRequest) GetMediaUrl() string {
+	if m != nil {
+		return m.MediaUrl
+	}
+	return ""
 }
-func (m *DisconnectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisconnectRequest.DiscardUnknown(m)
+
+func (m *MediaPlayerCommandRequest) GetHasAnnouncement() bool {
+	if m != nil {
+		return m.HasAnnouncement
+	}
+	return false
 }
 
-var xxx_messageInfo_DisconnectRequest proto.InternalMessageInfo
+func (m *MediaPlayerCommandRequest) GetAnnouncement() bool {
+	if m != nil {
+		return m.Announcement
+	}
+	return false
+}
 
-type DisconnectResponse struct {
+// ==================== BLUETOOTH ====================
+type SubscribeBluetoothLEAdvertisementsRequest struct {
+	Flags                uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DisconnectResponse) Reset()         { *m = DisconnectResponse{} }
-func (m *DisconnectResponse) String() string { return proto.CompactTextString(m) }
-func (*DisconnectResponse) ProtoMessage()    {}
-func (*DisconnectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+func (m *SubscribeBluetoothLEAdvertisementsRequest) Reset() {
+	*m = SubscribeBluetoothLEAdvertisementsRequest{}
+}
+func (m *SubscribeBluetoothLEAdvertisementsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SubscribeBluetoothLEAdvertisementsRequest) ProtoMessage() {}
+func (*SubscribeBluetoothLEAdvertisementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
 }
 
-func (m *DisconnectResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisconnectResponse.Unmarshal(m, b)
+func (m *SubscribeBluetoothLEAdvertisementsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeBluetoothLEAdvertisementsRequest.Unmarshal(m, b)
 }
-func (m *DisconnectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisconnectResponse.Marshal(b, m, deterministic)
+func (m *SubscribeBluetoothLEAdvertisementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeBluetoothLEAdvertisementsRequest.Marshal(b, m, deterministic)
 }
-func (m *DisconnectResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisconnectResponse.Merge(m, src)
+func (m *SubscribeBluetoothLEAdvertisementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBluetoothLEAdvertisementsRequest.Merge(m, src)
 }
-func (m *DisconnectResponse) XXX_Size() int {
-	return xxx_messageInfo_DisconnectResponse.Size(m)
+func (m *SubscribeBluetoothLEAdvertisementsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeBluetoothLEAdvertisementsRequest.Size(m)
 }
-func (m *DisconnectResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisconnectResponse.DiscardUnknown(m)
+func (m *SubscribeBluetoothLEAdvertisementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBluetoothLEAdvertisementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DisconnectResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeBluetoothLEAdvertisementsRequest proto.InternalMessageInfo
 
-type PingRequest struct {
+func (m *SubscribeBluetoothLEAdvertisementsRequest) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+type BluetoothServiceData struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	LegacyData           []uint32 `protobuf:"varint,2,rep,packed,name=legacy_data,json=legacyData,proto3" json:"legacy_data,omitempty"` // Deprecated: Do not use.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingRequest) Reset()         { *m = PingRequest{} }
-func (m *PingRequest) String() string { return proto.CompactTextString(m) }
-func (*PingRequest) ProtoMessage()    {}
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+func (m *BluetoothServiceData) Reset()         { *m = BluetoothServiceData{} }
+func (m *BluetoothServiceData) String() string { return proto.CompactTextString(m) }
+func (*BluetoothServiceData) ProtoMessage()    {}
+func (*BluetoothServiceData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
 }
 
-func (m *PingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+func (m *BluetoothServiceData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothServiceData.Unmarshal(m, b)
 }
-func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+func (m *BluetoothServiceData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothServiceData.Marshal(b, m, deterministic)
 }
-func (m *PingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingRequest.Merge(m, src)
+func (m *BluetoothServiceData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothServiceData.Merge(m, src)
 }
-func (m *PingRequest) XXX_Size() int {
-	return xxx_messageInfo_PingRequest.Size(m)
+func (m *BluetoothServiceData) XXX_Size() int {
+	return xxx_messageInfo_BluetoothServiceData.Size(m)
 }
-func (m *PingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+func (m *BluetoothServiceData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothServiceData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothServiceData proto.InternalMessageInfo
 
-type PingResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BluetoothServiceData) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
 }
 
-func (m *PingResponse) Reset()         { *m = PingResponse{} }
-func (m *PingResponse) String() string { return proto.CompactTextString(m) }
-func (*PingResponse) ProtoMessage()    {}
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+// Deprecated: Do not use.
+func (m *BluetoothServiceData) GetLegacyData() []uint32 {
+	if m != nil {
+		return m.LegacyData
+	}
+	return nil
 }
 
-func (m *PingResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+func (m *BluetoothServiceData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
-func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+
+type BluetoothLEAdvertisementResponse struct {
+	Address              uint64                  `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name                 []byte                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rssi                 int32                   `protobuf:"zigzag32,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	ServiceUuids         []string                `protobuf:"bytes,4,rep,name=service_uuids,json=serviceUuids,proto3" json:"service_uuids,omitempty"`
+	ServiceData          []*BluetoothServiceData `protobuf:"bytes,5,rep,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
+	ManufacturerData     []*BluetoothServiceData `protobuf:"bytes,6,rep,name=manufacturer_data,json=manufacturerData,proto3" json:"manufacturer_data,omitempty"`
+	AddressType          uint32                  `protobuf:"varint,7,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
-func (m *PingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingResponse.Merge(m, src)
+
+func (m *BluetoothLEAdvertisementResponse) Reset()         { *m = BluetoothLEAdvertisementResponse{} }
+func (m *BluetoothLEAdvertisementResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothLEAdvertisementResponse) ProtoMessage()    {}
+func (*BluetoothLEAdvertisementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
 }
-func (m *PingResponse) XXX_Size() int {
-	return xxx_messageInfo_PingResponse.Size(m)
+
+func (m *BluetoothLEAdvertisementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothLEAdvertisementResponse.Unmarshal(m, b)
 }
-func (m *PingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+func (m *BluetoothLEAdvertisementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothLEAdvertisementResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothLEAdvertisementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothLEAdvertisementResponse.Merge(m, src)
+}
+func (m *BluetoothLEAdvertisementResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothLEAdvertisementResponse.Size(m)
+}
+func (m *BluetoothLEAdvertisementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothLEAdvertisementResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothLEAdvertisementResponse proto.InternalMessageInfo
 
-type DeviceInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BluetoothLEAdvertisementResponse) GetAddress() uint64 {
+	if m != nil {
+		return m.Address
+	}
+	return 0
 }
 
-func (m *DeviceInfoRequest) Reset()         { *m = DeviceInfoRequest{} }
-func (m *DeviceInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*DeviceInfoRequest) ProtoMessage()    {}
-func (*DeviceInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+func (m *BluetoothLEAdvertisementResponse) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
 }
 
-func (m *DeviceInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeviceInfoRequest.Unmarshal(m, b)
-}
-func (m *DeviceInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeviceInfoRequest.Marshal(b, m, deterministic)
+func (m *BluetoothLEAdvertisementResponse) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
+	}
+	return 0
 }
-func (m *DeviceInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeviceInfoRequest.Merge(m, src)
+
+func (m *BluetoothLEAdvertisementResponse) GetServiceUuids() []string {
+	if m != nil {
+		return m.ServiceUuids
+	}
+	return nil
 }
-func (m *DeviceInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_DeviceInfoRequest.Size(m)
+
+func (m *BluetoothLEAdvertisementResponse) GetServiceData() []*BluetoothServiceData {
+	if m != nil {
+		return m.ServiceData
+	}
+	return nil
 }
-func (m *DeviceInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeviceInfoRequest.DiscardUnknown(m)
+
+func (m *BluetoothLEAdvertisementResponse) GetManufacturerData() []*BluetoothServiceData {
+	if m != nil {
+		return m.ManufacturerData
+	}
+	return nil
 }
 
-var xxx_messageInfo_DeviceInfoRequest proto.InternalMessageInfo
+func (m *BluetoothLEAdvertisementResponse) GetAddressType() uint32 {
+	if m != nil {
+		return m.AddressType
+	}
+	return 0
+}
 
-type DeviceInfoResponse struct {
-	UsesPassword bool `protobuf:"varint,1,opt,name=uses_password,json=usesPassword,proto3" json:"uses_password,omitempty"`
-	// The name of the node, given by "App.set_name()"
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The mac address of the device. For example "AC:BC:32:89:0E:A9"
-	MacAddress string `protobuf:"bytes,3,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	// A string describing the ESPHome version. For example "1.10.0"
-	EsphomeVersion string `protobuf:"bytes,4,opt,name=esphome_version,json=esphomeVersion,proto3" json:"esphome_version,omitempty"`
-	// A string describing the date of compilation, this is generated by the compiler
-	// and therefore may not be in the same format all the time.
-	// If the user isn't using ESPHome, this will also not be set.
-	CompilationTime string `protobuf:"bytes,5,opt,name=compilation_time,json=compilationTime,proto3" json:"compilation_time,omitempty"`
-	// The model of the board. For example NodeMCU
-	Model                string   `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
-	HasDeepSleep         bool     `protobuf:"varint,7,opt,name=has_deep_sleep,json=hasDeepSleep,proto3" json:"has_deep_sleep,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BluetoothDeviceRequest struct {
+	Address              uint64                     `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	RequestType          BluetoothDeviceRequestType `protobuf:"varint,2,opt,name=request_type,json=requestType,proto3,enum=BluetoothDeviceRequestType" json:"request_type,omitempty"`
+	HasAddressType       bool                       `protobuf:"varint,3,opt,name=has_address_type,json=hasAddressType,proto3" json:"has_address_type,omitempty"`
+	AddressType          uint32                     `protobuf:"varint,4,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *DeviceInfoResponse) Reset()         { *m = DeviceInfoResponse{} }
-func (m *DeviceInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*DeviceInfoResponse) ProtoMessage()    {}
-func (*DeviceInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+func (m *BluetoothDeviceRequest) Reset()         { *m = BluetoothDeviceRequest{} }
+func (m *BluetoothDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothDeviceRequest) ProtoMessage()    {}
+func (*BluetoothDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
 }
 
-func (m *DeviceInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeviceInfoResponse.Unmarshal(m, b)
+func (m *BluetoothDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothDeviceRequest.Unmarshal(m, b)
 }
-func (m *DeviceInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeviceInfoResponse.Marshal(b, m, deterministic)
+func (m *BluetoothDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothDeviceRequest.Marshal(b, m, deterministic)
 }
-func (m *DeviceInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeviceInfoResponse.Merge(m, src)
+func (m *BluetoothDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothDeviceRequest.Merge(m, src)
 }
-func (m *DeviceInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_DeviceInfoResponse.Size(m)
+func (m *BluetoothDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothDeviceRequest.Size(m)
 }
-func (m *DeviceInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeviceInfoResponse.DiscardUnknown(m)
+func (m *BluetoothDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeviceInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothDeviceRequest proto.InternalMessageInfo
 
-func (m *DeviceInfoResponse) GetUsesPassword() bool {
+func (m *BluetoothDeviceRequest) GetAddress() uint64 {
 	if m != nil {
-		return m.UsesPassword
+		return m.Address
 	}
-	return false
+	return 0
 }
 
-func (m *DeviceInfoResponse) GetName() string {
+func (m *BluetoothDeviceRequest) GetRequestType() BluetoothDeviceRequestType {
 	if m != nil {
-		return m.Name
+		return m.RequestType
 	}
-	return ""
+	return BluetoothDeviceRequestType_BLUETOOTH_DEVICE_REQUEST_TYPE_CONNECT
 }
 
-func (m *DeviceInfoResponse) GetMacAddress() string {
+func (m *BluetoothDeviceRequest) GetHasAddressType() bool {
 	if m != nil {
-		return m.MacAddress
+		return m.HasAddressType
 	}
-	return ""
+	return false
+}
+
+func (m *BluetoothDeviceRequest) GetAddressType() uint32 {
+	if m != nil {
+		return m.AddressType
+	}
+	return 0
+}
+
+type BluetoothDeviceConnectionResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Connected            bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	Mtu                  uint32   `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Error                int32    `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BluetoothDeviceConnectionResponse) Reset()         { *m = BluetoothDeviceConnectionResponse{} }
+func (m *BluetoothDeviceConnectionResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothDeviceConnectionResponse) ProtoMessage()    {}
+func (*BluetoothDeviceConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *BluetoothDeviceConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothDeviceConnectionResponse.Unmarshal(m, b)
+}
+func (m *BluetoothDeviceConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothDeviceConnectionResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothDeviceConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothDeviceConnectionResponse.Merge(m, src)
+}
+func (m *BluetoothDeviceConnectionResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothDeviceConnectionResponse.Size(m)
+}
+func (m *BluetoothDeviceConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothDeviceConnectionResponse.DiscardUnknown(m)
 }
 
-func (m *DeviceInfoResponse) GetEsphomeVersion() string {
+var xxx_messageInfo_BluetoothDeviceConnectionResponse proto.InternalMessageInfo
+
+func (m *BluetoothDeviceConnectionResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.EsphomeVersion
+		return m.Address
 	}
-	return ""
+	return 0
 }
 
-func (m *DeviceInfoResponse) GetCompilationTime() string {
+func (m *BluetoothDeviceConnectionResponse) GetConnected() bool {
 	if m != nil {
-		return m.CompilationTime
+		return m.Connected
 	}
-	return ""
+	return false
 }
 
-func (m *DeviceInfoResponse) GetModel() string {
+func (m *BluetoothDeviceConnectionResponse) GetMtu() uint32 {
 	if m != nil {
-		return m.Model
+		return m.Mtu
 	}
-	return ""
+	return 0
 }
 
-func (m *DeviceInfoResponse) GetHasDeepSleep() bool {
+func (m *BluetoothDeviceConnectionResponse) GetError() int32 {
 	if m != nil {
-		return m.HasDeepSleep
+		return m.Error
 	}
-	return false
+	return 0
 }
 
-type ListEntitiesRequest struct {
+type BluetoothGATTGetServicesRequest struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesRequest) Reset()         { *m = ListEntitiesRequest{} }
-func (m *ListEntitiesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesRequest) ProtoMessage()    {}
-func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+func (m *BluetoothGATTGetServicesRequest) Reset()         { *m = BluetoothGATTGetServicesRequest{} }
+func (m *BluetoothGATTGetServicesRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTGetServicesRequest) ProtoMessage()    {}
+func (*BluetoothGATTGetServicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
 }
 
-func (m *ListEntitiesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesRequest.Unmarshal(m, b)
+func (m *BluetoothGATTGetServicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTGetServicesRequest.Unmarshal(m, b)
 }
-func (m *ListEntitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesRequest.Marshal(b, m, deterministic)
+func (m *BluetoothGATTGetServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTGetServicesRequest.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesRequest.Merge(m, src)
+func (m *BluetoothGATTGetServicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTGetServicesRequest.Merge(m, src)
 }
-func (m *ListEntitiesRequest) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesRequest.Size(m)
+func (m *BluetoothGATTGetServicesRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTGetServicesRequest.Size(m)
 }
-func (m *ListEntitiesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesRequest.DiscardUnknown(m)
+func (m *BluetoothGATTGetServicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTGetServicesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesRequest proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTGetServicesRequest proto.InternalMessageInfo
 
-type ListEntitiesDoneResponse struct {
+func (m *BluetoothGATTGetServicesRequest) GetAddress() uint64 {
+	if m != nil {
+		return m.Address
+	}
+	return 0
+}
+
+type BluetoothGATTDescriptor struct {
+	Uuid                 []uint64 `protobuf:"varint,1,rep,packed,name=uuid,proto3" json:"uuid,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesDoneResponse) Reset()         { *m = ListEntitiesDoneResponse{} }
-func (m *ListEntitiesDoneResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesDoneResponse) ProtoMessage()    {}
-func (*ListEntitiesDoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+func (m *BluetoothGATTDescriptor) Reset()         { *m = BluetoothGATTDescriptor{} }
+func (m *BluetoothGATTDescriptor) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTDescriptor) ProtoMessage()    {}
+func (*BluetoothGATTDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
 }
 
-func (m *ListEntitiesDoneResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesDoneResponse.Unmarshal(m, b)
+func (m *BluetoothGATTDescriptor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTDescriptor.Unmarshal(m, b)
 }
-func (m *ListEntitiesDoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesDoneResponse.Marshal(b, m, deterministic)
+func (m *BluetoothGATTDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTDescriptor.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesDoneResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesDoneResponse.Merge(m, src)
+func (m *BluetoothGATTDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTDescriptor.Merge(m, src)
 }
-func (m *ListEntitiesDoneResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesDoneResponse.Size(m)
+func (m *BluetoothGATTDescriptor) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTDescriptor.Size(m)
 }
-func (m *ListEntitiesDoneResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesDoneResponse.DiscardUnknown(m)
+func (m *BluetoothGATTDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTDescriptor.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesDoneResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTDescriptor proto.InternalMessageInfo
 
-type SubscribeStatesRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BluetoothGATTDescriptor) GetUuid() []uint64 {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
 }
 
-func (m *SubscribeStatesRequest) Reset()         { *m = SubscribeStatesRequest{} }
-func (m *SubscribeStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeStatesRequest) ProtoMessage()    {}
-func (*SubscribeStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+func (m *BluetoothGATTDescriptor) GetHandle() uint32 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
 }
 
-func (m *SubscribeStatesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeStatesRequest.Unmarshal(m, b)
+type BluetoothGATTCharacteristic struct {
+	Uuid                 []uint64                   `protobuf:"varint,1,rep,packed,name=uuid,proto3" json:"uuid,omitempty"`
+	Handle               uint32                     `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Properties           uint32                     `protobuf:"varint,3,opt,name=properties,proto3" json:"properties,omitempty"`
+	Descriptors          []*BluetoothGATTDescriptor `protobuf:"bytes,4,rep,name=descriptors,proto3" json:"descriptors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
-func (m *SubscribeStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeStatesRequest.Marshal(b, m, deterministic)
+
+func (m *BluetoothGATTCharacteristic) Reset()         { *m = BluetoothGATTCharacteristic{} }
+func (m *BluetoothGATTCharacteristic) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTCharacteristic) ProtoMessage()    {}
+func (*BluetoothGATTCharacteristic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
 }
-func (m *SubscribeStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeStatesRequest.Merge(m, src)
+
+func (m *BluetoothGATTCharacteristic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTCharacteristic.Unmarshal(m, b)
 }
-func (m *SubscribeStatesRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeStatesRequest.Size(m)
+func (m *BluetoothGATTCharacteristic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTCharacteristic.Marshal(b, m, deterministic)
 }
-func (m *SubscribeStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeStatesRequest.DiscardUnknown(m)
+func (m *BluetoothGATTCharacteristic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTCharacteristic.Merge(m, src)
+}
+func (m *BluetoothGATTCharacteristic) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTCharacteristic.Size(m)
+}
+func (m *BluetoothGATTCharacteristic) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTCharacteristic.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTCharacteristic proto.InternalMessageInfo
 
-// ==================== BINARY SENSOR ====================
-type ListEntitiesBinarySensorResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	DeviceClass          string   `protobuf:"bytes,5,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
-	IsStatusBinarySensor bool     `protobuf:"varint,6,opt,name=is_status_binary_sensor,json=isStatusBinarySensor,proto3" json:"is_status_binary_sensor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BluetoothGATTCharacteristic) GetUuid() []uint64 {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
 }
 
-func (m *ListEntitiesBinarySensorResponse) Reset()         { *m = ListEntitiesBinarySensorResponse{} }
-func (m *ListEntitiesBinarySensorResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesBinarySensorResponse) ProtoMessage()    {}
-func (*ListEntitiesBinarySensorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+func (m *BluetoothGATTCharacteristic) GetHandle() uint32 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
 }
 
-func (m *ListEntitiesBinarySensorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesBinarySensorResponse.Unmarshal(m, b)
+func (m *BluetoothGATTCharacteristic) GetProperties() uint32 {
+	if m != nil {
+		return m.Properties
+	}
+	return 0
 }
-func (m *ListEntitiesBinarySensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesBinarySensorResponse.Marshal(b, m, deterministic)
+
+func (m *BluetoothGATTCharacteristic) GetDescriptors() []*BluetoothGATTDescriptor {
+	if m != nil {
+		return m.Descriptors
+	}
+	return nil
 }
-func (m *ListEntitiesBinarySensorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesBinarySensorResponse.Merge(m, src)
+
+type BluetoothGATTService struct {
+	Uuid                 []uint64                       `protobuf:"varint,1,rep,packed,name=uuid,proto3" json:"uuid,omitempty"`
+	Handle               uint32                         `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Characteristics      []*BluetoothGATTCharacteristic `protobuf:"bytes,3,rep,name=characteristics,proto3" json:"characteristics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
-func (m *ListEntitiesBinarySensorResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesBinarySensorResponse.Size(m)
+
+func (m *BluetoothGATTService) Reset()         { *m = BluetoothGATTService{} }
+func (m *BluetoothGATTService) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTService) ProtoMessage()    {}
+func (*BluetoothGATTService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
 }
-func (m *ListEntitiesBinarySensorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesBinarySensorResponse.DiscardUnknown(m)
+
+func (m *BluetoothGATTService) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTService.Unmarshal(m, b)
+}
+func (m *BluetoothGATTService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTService.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTService.Merge(m, src)
+}
+func (m *BluetoothGATTService) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTService.Size(m)
+}
+func (m *BluetoothGATTService) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTService.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesBinarySensorResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTService proto.InternalMessageInfo
 
-func (m *ListEntitiesBinarySensorResponse) GetObjectId() string {
+func (m *BluetoothGATTService) GetUuid() []uint64 {
 	if m != nil {
-		return m.ObjectId
+		return m.Uuid
 	}
-	return ""
+	return nil
 }
 
-func (m *ListEntitiesBinarySensorResponse) GetKey() uint32 {
+func (m *BluetoothGATTService) GetHandle() uint32 {
 	if m != nil {
-		return m.Key
+		return m.Handle
 	}
 	return 0
 }
 
-func (m *ListEntitiesBinarySensorResponse) GetName() string {
+func (m *BluetoothGATTService) GetCharacteristics() []*BluetoothGATTCharacteristic {
 	if m != nil {
-		return m.Name
+		return m.Characteristics
 	}
-	return ""
+	return nil
 }
 
-func (m *ListEntitiesBinarySensorResponse) GetUniqueId() string {
-	if m != nil {
-		return m.UniqueId
-	}
-	return ""
+type BluetoothGATTGetServicesResponse struct {
+	Address              uint64                  `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Services             []*BluetoothGATTService `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *ListEntitiesBinarySensorResponse) GetDeviceClass() string {
+func (m *BluetoothGATTGetServicesResponse) Reset()         { *m = BluetoothGATTGetServicesResponse{} }
+func (m *BluetoothGATTGetServicesResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTGetServicesResponse) ProtoMessage()    {}
+func (*BluetoothGATTGetServicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *BluetoothGATTGetServicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTGetServicesResponse.Unmarshal(m, b)
+}
+func (m *BluetoothGATTGetServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTGetServicesResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTGetServicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTGetServicesResponse.Merge(m, src)
+}
+func (m *BluetoothGATTGetServicesResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTGetServicesResponse.Size(m)
+}
+func (m *BluetoothGATTGetServicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTGetServicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BluetoothGATTGetServicesResponse proto.InternalMessageInfo
+
+func (m *BluetoothGATTGetServicesResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.DeviceClass
+		return m.Address
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesBinarySensorResponse) GetIsStatusBinarySensor() bool {
+func (m *BluetoothGATTGetServicesResponse) GetServices() []*BluetoothGATTService {
 	if m != nil {
-		return m.IsStatusBinarySensor
+		return m.Services
 	}
-	return false
+	return nil
 }
 
-type BinarySensorStateResponse struct {
-	Key   uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	State bool   `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
-	// If the binary sensor does not have a valid state yet.
-	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
-	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+type BluetoothGATTGetServicesDoneResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BinarySensorStateResponse) Reset()         { *m = BinarySensorStateResponse{} }
-func (m *BinarySensorStateResponse) String() string { return proto.CompactTextString(m) }
-func (*BinarySensorStateResponse) ProtoMessage()    {}
-func (*BinarySensorStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+func (m *BluetoothGATTGetServicesDoneResponse) Reset()         { *m = BluetoothGATTGetServicesDoneResponse{} }
+func (m *BluetoothGATTGetServicesDoneResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTGetServicesDoneResponse) ProtoMessage()    {}
+func (*BluetoothGATTGetServicesDoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
 }
 
-func (m *BinarySensorStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BinarySensorStateResponse.Unmarshal(m, b)
+func (m *BluetoothGATTGetServicesDoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTGetServicesDoneResponse.Unmarshal(m, b)
 }
-func (m *BinarySensorStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BinarySensorStateResponse.Marshal(b, m, deterministic)
+func (m *BluetoothGATTGetServicesDoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTGetServicesDoneResponse.Marshal(b, m, deterministic)
 }
-func (m *BinarySensorStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BinarySensorStateResponse.Merge(m, src)
+func (m *BluetoothGATTGetServicesDoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTGetServicesDoneResponse.Merge(m, src)
 }
-func (m *BinarySensorStateResponse) XXX_Size() int {
-	return xxx_messageInfo_BinarySensorStateResponse.Size(m)
+func (m *BluetoothGATTGetServicesDoneResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTGetServicesDoneResponse.Size(m)
 }
-func (m *BinarySensorStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BinarySensorStateResponse.DiscardUnknown(m)
+func (m *BluetoothGATTGetServicesDoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTGetServicesDoneResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BinarySensorStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTGetServicesDoneResponse proto.InternalMessageInfo
 
-func (m *BinarySensorStateResponse) GetKey() uint32 {
+func (m *BluetoothGATTGetServicesDoneResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return 0
 }
 
-func (m *BinarySensorStateResponse) GetState() bool {
+type BluetoothGATTReadRequest struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BluetoothGATTReadRequest) Reset()         { *m = BluetoothGATTReadRequest{} }
+func (m *BluetoothGATTReadRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTReadRequest) ProtoMessage()    {}
+func (*BluetoothGATTReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *BluetoothGATTReadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTReadRequest.Unmarshal(m, b)
+}
+func (m *BluetoothGATTReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTReadRequest.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTReadRequest.Merge(m, src)
+}
+func (m *BluetoothGATTReadRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTReadRequest.Size(m)
+}
+func (m *BluetoothGATTReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTReadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BluetoothGATTReadRequest proto.InternalMessageInfo
+
+func (m *BluetoothGATTReadRequest) GetAddress() uint64 {
 	if m != nil {
-		return m.State
+		return m.Address
 	}
-	return false
+	return 0
 }
 
-func (m *BinarySensorStateResponse) GetMissingState() bool {
+func (m *BluetoothGATTReadRequest) GetHandle() uint32 {
 	if m != nil {
-		return m.MissingState
+		return m.Handle
 	}
-	return false
+	return 0
 }
 
-// ==================== COVER ====================
-type ListEntitiesCoverResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	AssumedState         bool     `protobuf:"varint,5,opt,name=assumed_state,json=assumedState,proto3" json:"assumed_state,omitempty"`
-	SupportsPosition     bool     `protobuf:"varint,6,opt,name=supports_position,json=supportsPosition,proto3" json:"supports_position,omitempty"`
-	SupportsTilt         bool     `protobuf:"varint,7,opt,name=supports_tilt,json=supportsTilt,proto3" json:"supports_tilt,omitempty"`
-	DeviceClass          string   `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+type BluetoothGATTReadResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesCoverResponse) Reset()         { *m = ListEntitiesCoverResponse{} }
-func (m *ListEntitiesCoverResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesCoverResponse) ProtoMessage()    {}
-func (*ListEntitiesCoverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+func (m *BluetoothGATTReadResponse) Reset()         { *m = BluetoothGATTReadResponse{} }
+func (m *BluetoothGATTReadResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTReadResponse) ProtoMessage()    {}
+func (*BluetoothGATTReadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
 }
 
-func (m *ListEntitiesCoverResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesCoverResponse.Unmarshal(m, b)
+func (m *BluetoothGATTReadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTReadResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesCoverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesCoverResponse.Marshal(b, m, deterministic)
+func (m *BluetoothGATTReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTReadResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesCoverResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesCoverResponse.Merge(m, src)
+func (m *BluetoothGATTReadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTReadResponse.Merge(m, src)
 }
-func (m *ListEntitiesCoverResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesCoverResponse.Size(m)
+func (m *BluetoothGATTReadResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTReadResponse.Size(m)
 }
-func (m *ListEntitiesCoverResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesCoverResponse.DiscardUnknown(m)
+func (m *BluetoothGATTReadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTReadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesCoverResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTReadResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesCoverResponse) GetObjectId() string {
+func (m *BluetoothGATTReadResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.ObjectId
+		return m.Address
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesCoverResponse) GetKey() uint32 {
+func (m *BluetoothGATTReadResponse) GetHandle() uint32 {
 	if m != nil {
-		return m.Key
+		return m.Handle
 	}
 	return 0
 }
 
-func (m *ListEntitiesCoverResponse) GetName() string {
+func (m *BluetoothGATTReadResponse) GetData() []byte {
 	if m != nil {
-		return m.Name
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (m *ListEntitiesCoverResponse) GetUniqueId() string {
-	if m != nil {
-		return m.UniqueId
-	}
-	return ""
+type BluetoothGATTWriteRequest struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Response             bool     `protobuf:"varint,3,opt,name=response,proto3" json:"response,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BluetoothGATTWriteRequest) Reset()         { *m = BluetoothGATTWriteRequest{} }
+func (m *BluetoothGATTWriteRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTWriteRequest) ProtoMessage()    {}
+func (*BluetoothGATTWriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *BluetoothGATTWriteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTWriteRequest.Unmarshal(m, b)
+}
+func (m *BluetoothGATTWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTWriteRequest.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTWriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTWriteRequest.Merge(m, src)
+}
+func (m *BluetoothGATTWriteRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTWriteRequest.Size(m)
+}
+func (m *BluetoothGATTWriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTWriteRequest.DiscardUnknown(m)
 }
 
-func (m *ListEntitiesCoverResponse) GetAssumedState() bool {
+var xxx_messageInfo_BluetoothGATTWriteRequest proto.InternalMessageInfo
+
+func (m *BluetoothGATTWriteRequest) GetAddress() uint64 {
 	if m != nil {
-		return m.AssumedState
+		return m.Address
 	}
-	return false
+	return 0
 }
 
-func (m *ListEntitiesCoverResponse) GetSupportsPosition() bool {
+func (m *BluetoothGATTWriteRequest) GetHandle() uint32 {
 	if m != nil {
-		return m.SupportsPosition
+		return m.Handle
 	}
-	return false
+	return 0
 }
 
-func (m *ListEntitiesCoverResponse) GetSupportsTilt() bool {
+func (m *BluetoothGATTWriteRequest) GetResponse() bool {
 	if m != nil {
-		return m.SupportsTilt
+		return m.Response
 	}
 	return false
 }
 
-func (m *ListEntitiesCoverResponse) GetDeviceClass() string {
+func (m *BluetoothGATTWriteRequest) GetData() []byte {
 	if m != nil {
-		return m.DeviceClass
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-type CoverStateResponse struct {
-	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	// legacy: state has been removed in 1.13
-	// clients/servers must still send/accept it until the next protocol change
-	LegacyState          LegacyCoverState `protobuf:"varint,2,opt,name=legacy_state,json=legacyState,proto3,enum=LegacyCoverState" json:"legacy_state,omitempty"`
-	Position             float32          `protobuf:"fixed32,3,opt,name=position,proto3" json:"position,omitempty"`
-	Tilt                 float32          `protobuf:"fixed32,4,opt,name=tilt,proto3" json:"tilt,omitempty"`
-	CurrentOperation     CoverOperation   `protobuf:"varint,5,opt,name=current_operation,json=currentOperation,proto3,enum=CoverOperation" json:"current_operation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type BluetoothGATTReadDescriptorRequest struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CoverStateResponse) Reset()         { *m = CoverStateResponse{} }
-func (m *CoverStateResponse) String() string { return proto.CompactTextString(m) }
-func (*CoverStateResponse) ProtoMessage()    {}
-func (*CoverStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+func (m *BluetoothGATTReadDescriptorRequest) Reset()         { *m = BluetoothGATTReadDescriptorRequest{} }
+func (m *BluetoothGATTReadDescriptorRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTReadDescriptorRequest) ProtoMessage()    {}
+func (*BluetoothGATTReadDescriptorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
 }
 
-func (m *CoverStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CoverStateResponse.Unmarshal(m, b)
+func (m *BluetoothGATTReadDescriptorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTReadDescriptorRequest.Unmarshal(m, b)
 }
-func (m *CoverStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CoverStateResponse.Marshal(b, m, deterministic)
+func (m *BluetoothGATTReadDescriptorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTReadDescriptorRequest.Marshal(b, m, deterministic)
 }
-func (m *CoverStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CoverStateResponse.Merge(m, src)
+func (m *BluetoothGATTReadDescriptorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTReadDescriptorRequest.Merge(m, src)
 }
-func (m *CoverStateResponse) XXX_Size() int {
-	return xxx_messageInfo_CoverStateResponse.Size(m)
+func (m *BluetoothGATTReadDescriptorRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTReadDescriptorRequest.Size(m)
 }
-func (m *CoverStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CoverStateResponse.DiscardUnknown(m)
+func (m *BluetoothGATTReadDescriptorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTReadDescriptorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CoverStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTReadDescriptorRequest proto.InternalMessageInfo
 
-func (m *CoverStateResponse) GetKey() uint32 {
+func (m *BluetoothGATTReadDescriptorRequest) GetAddress() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return 0
 }
 
-func (m *CoverStateResponse) GetLegacyState() LegacyCoverState {
+func (m *BluetoothGATTReadDescriptorRequest) GetHandle() uint32 {
 	if m != nil {
-		return m.LegacyState
+		return m.Handle
 	}
-	return LegacyCoverState_LEGACY_COVER_STATE_OPEN
+	return 0
 }
 
-func (m *CoverStateResponse) GetPosition() float32 {
+type BluetoothGATTWriteDescriptorRequest struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BluetoothGATTWriteDescriptorRequest) Reset()         { *m = BluetoothGATTWriteDescriptorRequest{} }
+func (m *BluetoothGATTWriteDescriptorRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTWriteDescriptorRequest) ProtoMessage()    {}
+func (*BluetoothGATTWriteDescriptorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *BluetoothGATTWriteDescriptorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTWriteDescriptorRequest.Unmarshal(m, b)
+}
+func (m *BluetoothGATTWriteDescriptorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTWriteDescriptorRequest.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTWriteDescriptorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTWriteDescriptorRequest.Merge(m, src)
+}
+func (m *BluetoothGATTWriteDescriptorRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTWriteDescriptorRequest.Size(m)
+}
+func (m *BluetoothGATTWriteDescriptorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTWriteDescriptorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BluetoothGATTWriteDescriptorRequest proto.InternalMessageInfo
+
+func (m *BluetoothGATTWriteDescriptorRequest) GetAddress() uint64 {
 	if m != nil {
-		return m.Position
+		return m.Address
 	}
 	return 0
 }
 
-func (m *CoverStateResponse) GetTilt() float32 {
+func (m *BluetoothGATTWriteDescriptorRequest) GetHandle() uint32 {
 	if m != nil {
-		return m.Tilt
+		return m.Handle
 	}
 	return 0
 }
 
-func (m *CoverStateResponse) GetCurrentOperation() CoverOperation {
+func (m *BluetoothGATTWriteDescriptorRequest) GetData() []byte {
 	if m != nil {
-		return m.CurrentOperation
+		return m.Data
 	}
-	return CoverOperation_COVER_OPERATION_IDLE
+	return nil
 }
 
-type CoverCommandRequest struct {
-	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	// legacy: command has been removed in 1.13
-	// clients/servers must still send/accept it until the next protocol change
-	HasLegacyCommand     bool               `protobuf:"varint,2,opt,name=has_legacy_command,json=hasLegacyCommand,proto3" json:"has_legacy_command,omitempty"`
-	LegacyCommand        LegacyCoverCommand `protobuf:"varint,3,opt,name=legacy_command,json=legacyCommand,proto3,enum=LegacyCoverCommand" json:"legacy_command,omitempty"`
-	HasPosition          bool               `protobuf:"varint,4,opt,name=has_position,json=hasPosition,proto3" json:"has_position,omitempty"`
-	Position             float32            `protobuf:"fixed32,5,opt,name=position,proto3" json:"position,omitempty"`
-	HasTilt              bool               `protobuf:"varint,6,opt,name=has_tilt,json=hasTilt,proto3" json:"has_tilt,omitempty"`
-	Tilt                 float32            `protobuf:"fixed32,7,opt,name=tilt,proto3" json:"tilt,omitempty"`
-	Stop                 bool               `protobuf:"varint,8,opt,name=stop,proto3" json:"stop,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type BluetoothGATTNotifyRequest struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Enable               bool     `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CoverCommandRequest) Reset()         { *m = CoverCommandRequest{} }
-func (m *CoverCommandRequest) String() string { return proto.CompactTextString(m) }
-func (*CoverCommandRequest) ProtoMessage()    {}
-func (*CoverCommandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+func (m *BluetoothGATTNotifyRequest) Reset()         { *m = BluetoothGATTNotifyRequest{} }
+func (m *BluetoothGATTNotifyRequest) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTNotifyRequest) ProtoMessage()    {}
+func (*BluetoothGATTNotifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
 }
 
-func (m *CoverCommandRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CoverCommandRequest.Unmarshal(m, b)
+func (m *BluetoothGATTNotifyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTNotifyRequest.Unmarshal(m, b)
 }
-func (m *CoverCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CoverCommandRequest.Marshal(b, m, deterministic)
+func (m *BluetoothGATTNotifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTNotifyRequest.Marshal(b, m, deterministic)
 }
-func (m *CoverCommandRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CoverCommandRequest.Merge(m, src)
+func (m *BluetoothGATTNotifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTNotifyRequest.Merge(m, src)
 }
-func (m *CoverCommandRequest) XXX_Size() int {
-	return xxx_messageInfo_CoverCommandRequest.Size(m)
+func (m *BluetoothGATTNotifyRequest) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTNotifyRequest.Size(m)
 }
-func (m *CoverCommandRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CoverCommandRequest.DiscardUnknown(m)
+func (m *BluetoothGATTNotifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTNotifyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CoverCommandRequest proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTNotifyRequest proto.InternalMessageInfo
 
-func (m *CoverCommandRequest) GetKey() uint32 {
+func (m *BluetoothGATTNotifyRequest) GetAddress() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return 0
 }
 
-func (m *CoverCommandRequest) GetHasLegacyCommand() bool {
+func (m *BluetoothGATTNotifyRequest) GetHandle() uint32 {
 	if m != nil {
-		return m.HasLegacyCommand
+		return m.Handle
 	}
-	return false
+	return 0
 }
 
-func (m *CoverCommandRequest) GetLegacyCommand() LegacyCoverCommand {
+func (m *BluetoothGATTNotifyRequest) GetEnable() bool {
 	if m != nil {
-		return m.LegacyCommand
+		return m.Enable
 	}
-	return LegacyCoverCommand_LEGACY_COVER_COMMAND_OPEN
+	return false
 }
 
-func (m *CoverCommandRequest) GetHasPosition() bool {
-	if m != nil {
-		return m.HasPosition
-	}
-	return false
+type BluetoothGATTNotifyDataResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CoverCommandRequest) GetPosition() float32 {
-	if m != nil {
-		return m.Position
-	}
-	return 0
+func (m *BluetoothGATTNotifyDataResponse) Reset()         { *m = BluetoothGATTNotifyDataResponse{} }
+func (m *BluetoothGATTNotifyDataResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTNotifyDataResponse) ProtoMessage()    {}
+func (*BluetoothGATTNotifyDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
 }
 
-func (m *CoverCommandRequest) GetHasTilt() bool {
+func (m *BluetoothGATTNotifyDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTNotifyDataResponse.Unmarshal(m, b)
+}
+func (m *BluetoothGATTNotifyDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTNotifyDataResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTNotifyDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTNotifyDataResponse.Merge(m, src)
+}
+func (m *BluetoothGATTNotifyDataResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTNotifyDataResponse.Size(m)
+}
+func (m *BluetoothGATTNotifyDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTNotifyDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BluetoothGATTNotifyDataResponse proto.InternalMessageInfo
+
+func (m *BluetoothGATTNotifyDataResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.HasTilt
+		return m.Address
 	}
-	return false
+	return 0
 }
 
-func (m *CoverCommandRequest) GetTilt() float32 {
+func (m *BluetoothGATTNotifyDataResponse) GetHandle() uint32 {
 	if m != nil {
-		return m.Tilt
+		return m.Handle
 	}
 	return 0
 }
 
-func (m *CoverCommandRequest) GetStop() bool {
+func (m *BluetoothGATTNotifyDataResponse) GetData() []byte {
 	if m != nil {
-		return m.Stop
+		return m.Data
 	}
-	return false
+	return nil
 }
 
-// ==================== FAN ====================
-type ListEntitiesFanResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	SupportsOscillation  bool     `protobuf:"varint,5,opt,name=supports_oscillation,json=supportsOscillation,proto3" json:"supports_oscillation,omitempty"`
-	SupportsSpeed        bool     `protobuf:"varint,6,opt,name=supports_speed,json=supportsSpeed,proto3" json:"supports_speed,omitempty"`
+type SubscribeBluetoothConnectionsFreeRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesFanResponse) Reset()         { *m = ListEntitiesFanResponse{} }
-func (m *ListEntitiesFanResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesFanResponse) ProtoMessage()    {}
-func (*ListEntitiesFanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+func (m *SubscribeBluetoothConnectionsFreeRequest) Reset() {
+	*m = SubscribeBluetoothConnectionsFreeRequest{}
+}
+func (m *SubscribeBluetoothConnectionsFreeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBluetoothConnectionsFreeRequest) ProtoMessage()    {}
+func (*SubscribeBluetoothConnectionsFreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
 }
 
-func (m *ListEntitiesFanResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesFanResponse.Unmarshal(m, b)
+func (m *SubscribeBluetoothConnectionsFreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeBluetoothConnectionsFreeRequest.Unmarshal(m, b)
 }
-func (m *ListEntitiesFanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesFanResponse.Marshal(b, m, deterministic)
+func (m *SubscribeBluetoothConnectionsFreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeBluetoothConnectionsFreeRequest.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesFanResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesFanResponse.Merge(m, src)
+func (m *SubscribeBluetoothConnectionsFreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBluetoothConnectionsFreeRequest.Merge(m, src)
 }
-func (m *ListEntitiesFanResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesFanResponse.Size(m)
+func (m *SubscribeBluetoothConnectionsFreeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeBluetoothConnectionsFreeRequest.Size(m)
 }
-func (m *ListEntitiesFanResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesFanResponse.DiscardUnknown(m)
+func (m *SubscribeBluetoothConnectionsFreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBluetoothConnectionsFreeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesFanResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeBluetoothConnectionsFreeRequest proto.InternalMessageInfo
 
-func (m *ListEntitiesFanResponse) GetObjectId() string {
-	if m != nil {
-		return m.ObjectId
-	}
-	return ""
+type BluetoothConnectionsFreeResponse struct {
+	Free                 uint32   `protobuf:"varint,1,opt,name=free,proto3" json:"free,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Allocated            []uint64 `protobuf:"varint,3,rep,packed,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesFanResponse) GetKey() uint32 {
-	if m != nil {
-		return m.Key
-	}
-	return 0
+func (m *BluetoothConnectionsFreeResponse) Reset()         { *m = BluetoothConnectionsFreeResponse{} }
+func (m *BluetoothConnectionsFreeResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothConnectionsFreeResponse) ProtoMessage()    {}
+func (*BluetoothConnectionsFreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
 }
 
-func (m *ListEntitiesFanResponse) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *BluetoothConnectionsFreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothConnectionsFreeResponse.Unmarshal(m, b)
+}
+func (m *BluetoothConnectionsFreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothConnectionsFreeResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothConnectionsFreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothConnectionsFreeResponse.Merge(m, src)
+}
+func (m *BluetoothConnectionsFreeResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothConnectionsFreeResponse.Size(m)
+}
+func (m *BluetoothConnectionsFreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothConnectionsFreeResponse.DiscardUnknown(m)
 }
 
-func (m *ListEntitiesFanResponse) GetUniqueId() string {
+var xxx_messageInfo_BluetoothConnectionsFreeResponse proto.InternalMessageInfo
+
+func (m *BluetoothConnectionsFreeResponse) GetFree() uint32 {
 	if m != nil {
-		return m.UniqueId
+		return m.Free
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesFanResponse) GetSupportsOscillation() bool {
+func (m *BluetoothConnectionsFreeResponse) GetLimit() uint32 {
 	if m != nil {
-		return m.SupportsOscillation
+		return m.Limit
 	}
-	return false
+	return 0
 }
 
-func (m *ListEntitiesFanResponse) GetSupportsSpeed() bool {
+func (m *BluetoothConnectionsFreeResponse) GetAllocated() []uint64 {
 	if m != nil {
-		return m.SupportsSpeed
+		return m.Allocated
 	}
-	return false
+	return nil
 }
 
-type FanStateResponse struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
-	Oscillating          bool     `protobuf:"varint,3,opt,name=oscillating,proto3" json:"oscillating,omitempty"`
-	Speed                FanSpeed `protobuf:"varint,4,opt,name=speed,proto3,enum=FanSpeed" json:"speed,omitempty"`
+type BluetoothGATTErrorResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Error                int32    `protobuf:"varint,3,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FanStateResponse) Reset()         { *m = FanStateResponse{} }
-func (m *FanStateResponse) String() string { return proto.CompactTextString(m) }
-func (*FanStateResponse) ProtoMessage()    {}
-func (*FanStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *BluetoothGATTErrorResponse) Reset()         { *m = BluetoothGATTErrorResponse{} }
+func (m *BluetoothGATTErrorResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTErrorResponse) ProtoMessage()    {}
+func (*BluetoothGATTErrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
 }
 
-func (m *FanStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FanStateResponse.Unmarshal(m, b)
+func (m *BluetoothGATTErrorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTErrorResponse.Unmarshal(m, b)
 }
-func (m *FanStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FanStateResponse.Marshal(b, m, deterministic)
+func (m *BluetoothGATTErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTErrorResponse.Marshal(b, m, deterministic)
 }
-func (m *FanStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FanStateResponse.Merge(m, src)
+func (m *BluetoothGATTErrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTErrorResponse.Merge(m, src)
 }
-func (m *FanStateResponse) XXX_Size() int {
-	return xxx_messageInfo_FanStateResponse.Size(m)
+func (m *BluetoothGATTErrorResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTErrorResponse.Size(m)
 }
-func (m *FanStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FanStateResponse.DiscardUnknown(m)
+func (m *BluetoothGATTErrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTErrorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FanStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTErrorResponse proto.InternalMessageInfo
 
-func (m *FanStateResponse) GetKey() uint32 {
+func (m *BluetoothGATTErrorResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return 0
 }
 
-func (m *FanStateResponse) GetState() bool {
-	if m != nil {
-		return m.State
-	}
-	return false
-}
-
-func (m *FanStateResponse) GetOscillating() bool {
+func (m *BluetoothGATTErrorResponse) GetHandle() uint32 {
 	if m != nil {
-		return m.Oscillating
+		return m.Handle
 	}
-	return false
+	return 0
 }
 
-func (m *FanStateResponse) GetSpeed() FanSpeed {
+func (m *BluetoothGATTErrorResponse) GetError() int32 {
 	if m != nil {
-		return m.Speed
+		return m.Error
 	}
-	return FanSpeed_FAN_SPEED_LOW
+	return 0
 }
 
-type FanCommandRequest struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	HasState             bool     `protobuf:"varint,2,opt,name=has_state,json=hasState,proto3" json:"has_state,omitempty"`
-	State                bool     `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
-	HasSpeed             bool     `protobuf:"varint,4,opt,name=has_speed,json=hasSpeed,proto3" json:"has_speed,omitempty"`
-	Speed                FanSpeed `protobuf:"varint,5,opt,name=speed,proto3,enum=FanSpeed" json:"speed,omitempty"`
-	HasOscillating       bool     `protobuf:"varint,6,opt,name=has_oscillating,json=hasOscillating,proto3" json:"has_oscillating,omitempty"`
-	Oscillating          bool     `protobuf:"varint,7,opt,name=oscillating,proto3" json:"oscillating,omitempty"`
+type BluetoothGATTWriteResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FanCommandRequest) Reset()         { *m = FanCommandRequest{} }
-func (m *FanCommandRequest) String() string { return proto.CompactTextString(m) }
-func (*FanCommandRequest) ProtoMessage()    {}
-func (*FanCommandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *BluetoothGATTWriteResponse) Reset()         { *m = BluetoothGATTWriteResponse{} }
+func (m *BluetoothGATTWriteResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTWriteResponse) ProtoMessage()    {}
+func (*BluetoothGATTWriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
 }
 
-func (m *FanCommandRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FanCommandRequest.Unmarshal(m, b)
-}
-func (m *FanCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FanCommandRequest.Marshal(b, m, deterministic)
+func (m *BluetoothGATTWriteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTWriteResponse.Unmarshal(m, b)
 }
-func (m *FanCommandRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FanCommandRequest.Merge(m, src)
+func (m *BluetoothGATTWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTWriteResponse.Marshal(b, m, deterministic)
 }
-func (m *FanCommandRequest) XXX_Size() int {
-	return xxx_messageInfo_FanCommandRequest.Size(m)
+func (m *BluetoothGATTWriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTWriteResponse.Merge(m, src)
 }
-func (m *FanCommandRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FanCommandRequest.DiscardUnknown(m)
+func (m *BluetoothGATTWriteResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTWriteResponse.Size(m)
+}
+func (m *BluetoothGATTWriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTWriteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FanCommandRequest proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothGATTWriteResponse proto.InternalMessageInfo
 
-func (m *FanCommandRequest) GetKey() uint32 {
+func (m *BluetoothGATTWriteResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return 0
 }
 
-func (m *FanCommandRequest) GetHasState() bool {
+func (m *BluetoothGATTWriteResponse) GetHandle() uint32 {
 	if m != nil {
-		return m.HasState
+		return m.Handle
 	}
-	return false
+	return 0
 }
 
-func (m *FanCommandRequest) GetState() bool {
-	if m != nil {
-		return m.State
-	}
-	return false
+type BluetoothGATTNotifyResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Handle               uint32   `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FanCommandRequest) GetHasSpeed() bool {
-	if m != nil {
-		return m.HasSpeed
-	}
-	return false
+func (m *BluetoothGATTNotifyResponse) Reset()         { *m = BluetoothGATTNotifyResponse{} }
+func (m *BluetoothGATTNotifyResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothGATTNotifyResponse) ProtoMessage()    {}
+func (*BluetoothGATTNotifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
 }
 
-func (m *FanCommandRequest) GetSpeed() FanSpeed {
-	if m != nil {
-		return m.Speed
-	}
-	return FanSpeed_FAN_SPEED_LOW
+func (m *BluetoothGATTNotifyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothGATTNotifyResponse.Unmarshal(m, b)
+}
+func (m *BluetoothGATTNotifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothGATTNotifyResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothGATTNotifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothGATTNotifyResponse.Merge(m, src)
+}
+func (m *BluetoothGATTNotifyResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothGATTNotifyResponse.Size(m)
+}
+func (m *BluetoothGATTNotifyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothGATTNotifyResponse.DiscardUnknown(m)
 }
 
-func (m *FanCommandRequest) GetHasOscillating() bool {
+var xxx_messageInfo_BluetoothGATTNotifyResponse proto.InternalMessageInfo
+
+func (m *BluetoothGATTNotifyResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.HasOscillating
+		return m.Address
 	}
-	return false
+	return 0
 }
 
-func (m *FanCommandRequest) GetOscillating() bool {
+func (m *BluetoothGATTNotifyResponse) GetHandle() uint32 {
 	if m != nil {
-		return m.Oscillating
+		return m.Handle
 	}
-	return false
+	return 0
 }
 
-// ==================== LIGHT ====================
-type ListEntitiesLightResponse struct {
-	ObjectId                 string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                      uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                     string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId                 string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	SupportsBrightness       bool     `protobuf:"varint,5,opt,name=supports_brightness,json=supportsBrightness,proto3" json:"supports_brightness,omitempty"`
-	SupportsRgb              bool     `protobuf:"varint,6,opt,name=supports_rgb,json=supportsRgb,proto3" json:"supports_rgb,omitempty"`
-	SupportsWhiteValue       bool     `protobuf:"varint,7,opt,name=supports_white_value,json=supportsWhiteValue,proto3" json:"supports_white_value,omitempty"`
-	SupportsColorTemperature bool     `protobuf:"varint,8,opt,name=supports_color_temperature,json=supportsColorTemperature,proto3" json:"supports_color_temperature,omitempty"`
-	MinMireds                float32  `protobuf:"fixed32,9,opt,name=min_mireds,json=minMireds,proto3" json:"min_mireds,omitempty"`
-	MaxMireds                float32  `protobuf:"fixed32,10,opt,name=max_mireds,json=maxMireds,proto3" json:"max_mireds,omitempty"`
-	Effects                  []string `protobuf:"bytes,11,rep,name=effects,proto3" json:"effects,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+type BluetoothDevicePairingResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Paired               bool     `protobuf:"varint,2,opt,name=paired,proto3" json:"paired,omitempty"`
+	Error                int32    `protobuf:"varint,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesLightResponse) Reset()         { *m = ListEntitiesLightResponse{} }
-func (m *ListEntitiesLightResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesLightResponse) ProtoMessage()    {}
-func (*ListEntitiesLightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *BluetoothDevicePairingResponse) Reset()         { *m = BluetoothDevicePairingResponse{} }
+func (m *BluetoothDevicePairingResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothDevicePairingResponse) ProtoMessage()    {}
+func (*BluetoothDevicePairingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
 }
 
-func (m *ListEntitiesLightResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesLightResponse.Unmarshal(m, b)
+func (m *BluetoothDevicePairingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothDevicePairingResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesLightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesLightResponse.Marshal(b, m, deterministic)
+func (m *BluetoothDevicePairingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothDevicePairingResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesLightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesLightResponse.Merge(m, src)
+func (m *BluetoothDevicePairingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothDevicePairingResponse.Merge(m, src)
 }
-func (m *ListEntitiesLightResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesLightResponse.Size(m)
+func (m *BluetoothDevicePairingResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothDevicePairingResponse.Size(m)
 }
-func (m *ListEntitiesLightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesLightResponse.DiscardUnknown(m)
+func (m *BluetoothDevicePairingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothDevicePairingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesLightResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothDevicePairingResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesLightResponse) GetObjectId() string {
+func (m *BluetoothDevicePairingResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.ObjectId
+		return m.Address
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesLightResponse) GetKey() uint32 {
+func (m *BluetoothDevicePairingResponse) GetPaired() bool {
 	if m != nil {
-		return m.Key
+		return m.Paired
 	}
-	return 0
+	return false
 }
 
-func (m *ListEntitiesLightResponse) GetName() string {
+func (m *BluetoothDevicePairingResponse) GetError() int32 {
 	if m != nil {
-		return m.Name
+		return m.Error
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesLightResponse) GetUniqueId() string {
-	if m != nil {
-		return m.UniqueId
-	}
-	return ""
+type BluetoothDeviceUnpairingResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error                int32    `protobuf:"varint,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesLightResponse) GetSupportsBrightness() bool {
-	if m != nil {
-		return m.SupportsBrightness
-	}
-	return false
+func (m *BluetoothDeviceUnpairingResponse) Reset()         { *m = BluetoothDeviceUnpairingResponse{} }
+func (m *BluetoothDeviceUnpairingResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothDeviceUnpairingResponse) ProtoMessage()    {}
+func (*BluetoothDeviceUnpairingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
 }
 
-func (m *ListEntitiesLightResponse) GetSupportsRgb() bool {
-	if m != nil {
-		return m.SupportsRgb
-	}
-	return false
+func (m *BluetoothDeviceUnpairingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothDeviceUnpairingResponse.Unmarshal(m, b)
+}
+func (m *BluetoothDeviceUnpairingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothDeviceUnpairingResponse.Marshal(b, m, deterministic)
+}
+func (m *BluetoothDeviceUnpairingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothDeviceUnpairingResponse.Merge(m, src)
+}
+func (m *BluetoothDeviceUnpairingResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothDeviceUnpairingResponse.Size(m)
+}
+func (m *BluetoothDeviceUnpairingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothDeviceUnpairingResponse.DiscardUnknown(m)
 }
 
-func (m *ListEntitiesLightResponse) GetSupportsWhiteValue() bool {
+var xxx_messageInfo_BluetoothDeviceUnpairingResponse proto.InternalMessageInfo
+
+func (m *BluetoothDeviceUnpairingResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.SupportsWhiteValue
+		return m.Address
 	}
-	return false
+	return 0
 }
 
-func (m *ListEntitiesLightResponse) GetSupportsColorTemperature() bool {
+func (m *BluetoothDeviceUnpairingResponse) GetSuccess() bool {
 	if m != nil {
-		return m.SupportsColorTemperature
+		return m.Success
 	}
 	return false
 }
 
-func (m *ListEntitiesLightResponse) GetMinMireds() float32 {
+func (m *BluetoothDeviceUnpairingResponse) GetError() int32 {
 	if m != nil {
-		return m.MinMireds
+		return m.Error
 	}
 	return 0
 }
 
-func (m *ListEntitiesLightResponse) GetMaxMireds() float32 {
-	if m != nil {
-		return m.MaxMireds
-	}
-	return 0
+type UnsubscribeBluetoothLEAdvertisementsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesLightResponse) GetEffects() []string {
-	if m != nil {
-		return m.Effects
-	}
-	return nil
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) Reset() {
+	*m = UnsubscribeBluetoothLEAdvertisementsRequest{}
+}
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UnsubscribeBluetoothLEAdvertisementsRequest) ProtoMessage() {}
+func (*UnsubscribeBluetoothLEAdvertisementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
 }
 
-type LightStateResponse struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
-	Brightness           float32  `protobuf:"fixed32,3,opt,name=brightness,proto3" json:"brightness,omitempty"`
-	Red                  float32  `protobuf:"fixed32,4,opt,name=red,proto3" json:"red,omitempty"`
-	Green                float32  `protobuf:"fixed32,5,opt,name=green,proto3" json:"green,omitempty"`
-	Blue                 float32  `protobuf:"fixed32,6,opt,name=blue,proto3" json:"blue,omitempty"`
-	White                float32  `protobuf:"fixed32,7,opt,name=white,proto3" json:"white,omitempty"`
-	ColorTemperature     float32  `protobuf:"fixed32,8,opt,name=color_temperature,json=colorTemperature,proto3" json:"color_temperature,omitempty"`
-	Effect               string   `protobuf:"bytes,9,opt,name=effect,proto3" json:"effect,omitempty"`
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeBluetoothLEAdvertisementsRequest.Unmarshal(m, b)
+}
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeBluetoothLEAdvertisementsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeBluetoothLEAdvertisementsRequest.Merge(m, src)
+}
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeBluetoothLEAdvertisementsRequest.Size(m)
+}
+func (m *UnsubscribeBluetoothLEAdvertisementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeBluetoothLEAdvertisementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeBluetoothLEAdvertisementsRequest proto.InternalMessageInfo
+
+type BluetoothDeviceClearCacheResponse struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error                int32    `protobuf:"varint,3,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightStateResponse) Reset()         { *m = LightStateResponse{} }
-func (m *LightStateResponse) String() string { return proto.CompactTextString(m) }
-func (*LightStateResponse) ProtoMessage()    {}
-func (*LightStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+func (m *BluetoothDeviceClearCacheResponse) Reset()         { *m = BluetoothDeviceClearCacheResponse{} }
+func (m *BluetoothDeviceClearCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothDeviceClearCacheResponse) ProtoMessage()    {}
+func (*BluetoothDeviceClearCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
 }
 
-func (m *LightStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LightStateResponse.Unmarshal(m, b)
+func (m *BluetoothDeviceClearCacheResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothDeviceClearCacheResponse.Unmarshal(m, b)
 }
-func (m *LightStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LightStateResponse.Marshal(b, m, deterministic)
+func (m *BluetoothDeviceClearCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothDeviceClearCacheResponse.Marshal(b, m, deterministic)
 }
-func (m *LightStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LightStateResponse.Merge(m, src)
+func (m *BluetoothDeviceClearCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothDeviceClearCacheResponse.Merge(m, src)
 }
-func (m *LightStateResponse) XXX_Size() int {
-	return xxx_messageInfo_LightStateResponse.Size(m)
+func (m *BluetoothDeviceClearCacheResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothDeviceClearCacheResponse.Size(m)
 }
-func (m *LightStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LightStateResponse.DiscardUnknown(m)
+func (m *BluetoothDeviceClearCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothDeviceClearCacheResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LightStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothDeviceClearCacheResponse proto.InternalMessageInfo
 
-func (m *LightStateResponse) GetKey() uint32 {
+func (m *BluetoothDeviceClearCacheResponse) GetAddress() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return 0
 }
 
-func (m *LightStateResponse) GetState() bool {
+func (m *BluetoothDeviceClearCacheResponse) GetSuccess() bool {
 	if m != nil {
-		return m.State
+		return m.Success
 	}
 	return false
 }
 
-func (m *LightStateResponse) GetBrightness() float32 {
+func (m *BluetoothDeviceClearCacheResponse) GetError() int32 {
 	if m != nil {
-		return m.Brightness
+		return m.Error
 	}
 	return 0
 }
 
-func (m *LightStateResponse) GetRed() float32 {
-	if m != nil {
-		return m.Red
-	}
-	return 0
+type BluetoothLERawAdvertisement struct {
+	Address              uint64   `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rssi                 int32    `protobuf:"zigzag32,2,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	AddressType          uint32   `protobuf:"varint,3,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightStateResponse) GetGreen() float32 {
-	if m != nil {
-		return m.Green
-	}
-	return 0
+func (m *BluetoothLERawAdvertisement) Reset()         { *m = BluetoothLERawAdvertisement{} }
+func (m *BluetoothLERawAdvertisement) String() string { return proto.CompactTextString(m) }
+func (*BluetoothLERawAdvertisement) ProtoMessage()    {}
+func (*BluetoothLERawAdvertisement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
 }
 
-func (m *LightStateResponse) GetBlue() float32 {
+func (m *BluetoothLERawAdvertisement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothLERawAdvertisement.Unmarshal(m, b)
+}
+func (m *BluetoothLERawAdvertisement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothLERawAdvertisement.Marshal(b, m, deterministic)
+}
+func (m *BluetoothLERawAdvertisement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothLERawAdvertisement.Merge(m, src)
+}
+func (m *BluetoothLERawAdvertisement) XXX_Size() int {
+	return xxx_messageInfo_BluetoothLERawAdvertisement.Size(m)
+}
+func (m *BluetoothLERawAdvertisement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothLERawAdvertisement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BluetoothLERawAdvertisement proto.InternalMessageInfo
+
+func (m *BluetoothLERawAdvertisement) GetAddress() uint64 {
 	if m != nil {
-		return m.Blue
+		return m.Address
 	}
 	return 0
 }
 
-func (m *LightStateResponse) GetWhite() float32 {
+func (m *BluetoothLERawAdvertisement) GetRssi() int32 {
 	if m != nil {
-		return m.White
+		return m.Rssi
 	}
 	return 0
 }
 
-func (m *LightStateResponse) GetColorTemperature() float32 {
+func (m *BluetoothLERawAdvertisement) GetAddressType() uint32 {
 	if m != nil {
-		return m.ColorTemperature
+		return m.AddressType
 	}
 	return 0
 }
 
-func (m *LightStateResponse) GetEffect() string {
+func (m *BluetoothLERawAdvertisement) GetData() []byte {
 	if m != nil {
-		return m.Effect
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-type LightCommandRequest struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	HasState             bool     `protobuf:"varint,2,opt,name=has_state,json=hasState,proto3" json:"has_state,omitempty"`
-	State                bool     `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
-	HasBrightness        bool     `protobuf:"varint,4,opt,name=has_brightness,json=hasBrightness,proto3" json:"has_brightness,omitempty"`
-	Brightness           float32  `protobuf:"fixed32,5,opt,name=brightness,proto3" json:"brightness,omitempty"`
-	HasRgb               bool     `protobuf:"varint,6,opt,name=has_rgb,json=hasRgb,proto3" json:"has_rgb,omitempty"`
-	Red                  float32  `protobuf:"fixed32,7,opt,name=red,proto3" json:"red,omitempty"`
-	Green                float32  `protobuf:"fixed32,8,opt,name=green,proto3" json:"green,omitempty"`
-	Blue                 float32  `protobuf:"fixed32,9,opt,name=blue,proto3" json:"blue,omitempty"`
-	HasWhite             bool     `protobuf:"varint,10,opt,name=has_white,json=hasWhite,proto3" json:"has_white,omitempty"`
-	White                float32  `protobuf:"fixed32,11,opt,name=white,proto3" json:"white,omitempty"`
-	HasColorTemperature  bool     `protobuf:"varint,12,opt,name=has_color_temperature,json=hasColorTemperature,proto3" json:"has_color_temperature,omitempty"`
-	ColorTemperature     float32  `protobuf:"fixed32,13,opt,name=color_temperature,json=colorTemperature,proto3" json:"color_temperature,omitempty"`
-	HasTransitionLength  bool     `protobuf:"varint,14,opt,name=has_transition_length,json=hasTransitionLength,proto3" json:"has_transition_length,omitempty"`
-	TransitionLength     uint32   `protobuf:"varint,15,opt,name=transition_length,json=transitionLength,proto3" json:"transition_length,omitempty"`
-	HasFlashLength       bool     `protobuf:"varint,16,opt,name=has_flash_length,json=hasFlashLength,proto3" json:"has_flash_length,omitempty"`
-	FlashLength          uint32   `protobuf:"varint,17,opt,name=flash_length,json=flashLength,proto3" json:"flash_length,omitempty"`
-	HasEffect            bool     `protobuf:"varint,18,opt,name=has_effect,json=hasEffect,proto3" json:"has_effect,omitempty"`
-	Effect               string   `protobuf:"bytes,19,opt,name=effect,proto3" json:"effect,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BluetoothLERawAdvertisementsResponse struct {
+	Advertisements       []*BluetoothLERawAdvertisement `protobuf:"bytes,1,rep,name=advertisements,proto3" json:"advertisements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *LightCommandRequest) Reset()         { *m = LightCommandRequest{} }
-func (m *LightCommandRequest) String() string { return proto.CompactTextString(m) }
-func (*LightCommandRequest) ProtoMessage()    {}
-func (*LightCommandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *BluetoothLERawAdvertisementsResponse) Reset()         { *m = BluetoothLERawAdvertisementsResponse{} }
+func (m *BluetoothLERawAdvertisementsResponse) String() string { return proto.CompactTextString(m) }
+func (*BluetoothLERawAdvertisementsResponse) ProtoMessage()    {}
+func (*BluetoothLERawAdvertisementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
 }
 
-func (m *LightCommandRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LightCommandRequest.Unmarshal(m, b)
+func (m *BluetoothLERawAdvertisementsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BluetoothLERawAdvertisementsResponse.Unmarshal(m, b)
 }
-func (m *LightCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LightCommandRequest.Marshal(b, m, deterministic)
+func (m *BluetoothLERawAdvertisementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BluetoothLERawAdvertisementsResponse.Marshal(b, m, deterministic)
 }
-func (m *LightCommandRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LightCommandRequest.Merge(m, src)
+func (m *BluetoothLERawAdvertisementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BluetoothLERawAdvertisementsResponse.Merge(m, src)
 }
-func (m *LightCommandRequest) XXX_Size() int {
-	return xxx_messageInfo_LightCommandRequest.Size(m)
+func (m *BluetoothLERawAdvertisementsResponse) XXX_Size() int {
+	return xxx_messageInfo_BluetoothLERawAdvertisementsResponse.Size(m)
 }
-func (m *LightCommandRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LightCommandRequest.DiscardUnknown(m)
+func (m *BluetoothLERawAdvertisementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BluetoothLERawAdvertisementsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LightCommandRequest proto.InternalMessageInfo
+var xxx_messageInfo_BluetoothLERawAdvertisementsResponse proto.InternalMessageInfo
 
-func (m *LightCommandRequest) GetKey() uint32 {
+func (m *BluetoothLERawAdvertisementsResponse) GetAdvertisements() []*BluetoothLERawAdvertisement {
 	if m != nil {
-		return m.Key
+		return m.Advertisements
 	}
-	return 0
+	return nil
 }
 
-func (m *LightCommandRequest) GetHasState() bool {
-	if m != nil {
-		return m.HasState
-	}
-	return false
+// ==================== VOICE ASSISTANT ====================
+type SubscribeVoiceAssistantRequest struct {
+	Subscribe            bool     `protobuf:"varint,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Flags                uint32   `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightCommandRequest) GetState() bool {
-	if m != nil {
-		return m.State
-	}
-	return false
+func (m *SubscribeVoiceAssistantRequest) Reset()         { *m = SubscribeVoiceAssistantRequest{} }
+func (m *SubscribeVoiceAssistantRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeVoiceAssistantRequest) ProtoMessage()    {}
+func (*SubscribeVoiceAssistantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
 }
 
-func (m *LightCommandRequest) GetHasBrightness() bool {
-	if m != nil {
-		return m.HasBrightness
-	}
-	return false
+func (m *SubscribeVoiceAssistantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeVoiceAssistantRequest.Unmarshal(m, b)
 }
-
-func (m *LightCommandRequest) GetBrightness() float32 {
-	if m != nil {
-		return m.Brightness
-	}
-	return 0
+func (m *SubscribeVoiceAssistantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeVoiceAssistantRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeVoiceAssistantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeVoiceAssistantRequest.Merge(m, src)
+}
+func (m *SubscribeVoiceAssistantRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeVoiceAssistantRequest.Size(m)
+}
+func (m *SubscribeVoiceAssistantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeVoiceAssistantRequest.DiscardUnknown(m)
 }
 
-func (m *LightCommandRequest) GetHasRgb() bool {
+var xxx_messageInfo_SubscribeVoiceAssistantRequest proto.InternalMessageInfo
+
+func (m *SubscribeVoiceAssistantRequest) GetSubscribe() bool {
 	if m != nil {
-		return m.HasRgb
+		return m.Subscribe
 	}
 	return false
 }
 
-func (m *LightCommandRequest) GetRed() float32 {
+func (m *SubscribeVoiceAssistantRequest) GetFlags() uint32 {
 	if m != nil {
-		return m.Red
+		return m.Flags
 	}
 	return 0
 }
 
-func (m *LightCommandRequest) GetGreen() float32 {
-	if m != nil {
-		return m.Green
-	}
-	return 0
+type VoiceAssistantAudioSettings struct {
+	NoiseSuppressionLevel uint32   `protobuf:"varint,1,opt,name=noise_suppression_level,json=noiseSuppressionLevel,proto3" json:"noise_suppression_level,omitempty"`
+	AutoGain              uint32   `protobuf:"varint,2,opt,name=auto_gain,json=autoGain,proto3" json:"auto_gain,omitempty"`
+	VolumeMultiplier      float32  `protobuf:"fixed32,3,opt,name=volume_multiplier,json=volumeMultiplier,proto3" json:"volume_multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *LightCommandRequest) GetBlue() float32 {
-	if m != nil {
-		return m.Blue
-	}
-	return 0
+func (m *VoiceAssistantAudioSettings) Reset()         { *m = VoiceAssistantAudioSettings{} }
+func (m *VoiceAssistantAudioSettings) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantAudioSettings) ProtoMessage()    {}
+func (*VoiceAssistantAudioSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
 }
 
-func (m *LightCommandRequest) GetHasWhite() bool {
-	if m != nil {
-		return m.HasWhite
-	}
-	return false
+func (m *VoiceAssistantAudioSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantAudioSettings.Unmarshal(m, b)
+}
+func (m *VoiceAssistantAudioSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantAudioSettings.Marshal(b, m, deterministic)
 }
+func (m *VoiceAssistantAudioSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantAudioSettings.Merge(m, src)
+}
+func (m *VoiceAssistantAudioSettings) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantAudioSettings.Size(m)
+}
+func (m *VoiceAssistantAudioSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantAudioSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoiceAssistantAudioSettings proto.InternalMessageInfo
 
-func (m *LightCommandRequest) GetWhite() float32 {
+func (m *VoiceAssistantAudioSettings) GetNoiseSuppressionLevel() uint32 {
 	if m != nil {
-		return m.White
+		return m.NoiseSuppressionLevel
 	}
 	return 0
 }
 
-func (m *LightCommandRequest) GetHasColorTemperature() bool {
+func (m *VoiceAssistantAudioSettings) GetAutoGain() uint32 {
 	if m != nil {
-		return m.HasColorTemperature
+		return m.AutoGain
 	}
-	return false
+	return 0
 }
 
-func (m *LightCommandRequest) GetColorTemperature() float32 {
+func (m *VoiceAssistantAudioSettings) GetVolumeMultiplier() float32 {
 	if m != nil {
-		return m.ColorTemperature
+		return m.VolumeMultiplier
 	}
 	return 0
 }
 
-func (m *LightCommandRequest) GetHasTransitionLength() bool {
-	if m != nil {
-		return m.HasTransitionLength
-	}
-	return false
+type VoiceAssistantRequest struct {
+	Start                bool                         `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	ConversationId       string                       `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Flags                uint32                       `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	AudioSettings        *VoiceAssistantAudioSettings `protobuf:"bytes,4,opt,name=audio_settings,json=audioSettings,proto3" json:"audio_settings,omitempty"`
+	WakeWordPhrase       string                       `protobuf:"bytes,5,opt,name=wake_word_phrase,json=wakeWordPhrase,proto3" json:"wake_word_phrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (m *LightCommandRequest) GetTransitionLength() uint32 {
+func (m *VoiceAssistantRequest) Reset()         { *m = VoiceAssistantRequest{} }
+func (m *VoiceAssistantRequest) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantRequest) ProtoMessage()    {}
+func (*VoiceAssistantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *VoiceAssistantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantRequest.Unmarshal(m, b)
+}
+func (m *VoiceAssistantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantRequest.Marshal(b, m, deterministic)
+}
+func (m *VoiceAssistantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantRequest.Merge(m, src)
+}
+func (m *VoiceAssistantRequest) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantRequest.Size(m)
+}
+func (m *VoiceAssistantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoiceAssistantRequest proto.InternalMessageInfo
+
+func (m *VoiceAssistantRequest) GetStart() bool {
 	if m != nil {
-		return m.TransitionLength
+		return m.Start
 	}
-	return 0
+	return false
 }
 
-func (m *LightCommandRequest) GetHasFlashLength() bool {
+func (m *VoiceAssistantRequest) GetConversationId() string {
 	if m != nil {
-		return m.HasFlashLength
+		return m.ConversationId
 	}
-	return false
+	return ""
 }
 
-func (m *LightCommandRequest) GetFlashLength() uint32 {
+func (m *VoiceAssistantRequest) GetFlags() uint32 {
 	if m != nil {
-		return m.FlashLength
+		return m.Flags
 	}
 	return 0
 }
 
-func (m *LightCommandRequest) GetHasEffect() bool {
+func (m *VoiceAssistantRequest) GetAudioSettings() *VoiceAssistantAudioSettings {
 	if m != nil {
-		return m.HasEffect
+		return m.AudioSettings
 	}
-	return false
+	return nil
 }
 
-func (m *LightCommandRequest) GetEffect() string {
+func (m *VoiceAssistantRequest) GetWakeWordPhrase() string {
 	if m != nil {
-		return m.Effect
+		return m.WakeWordPhrase
 	}
 	return ""
 }
 
-// ==================== SENSOR ====================
-type ListEntitiesSensorResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	Icon                 string   `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
-	UnitOfMeasurement    string   `protobuf:"bytes,6,opt,name=unit_of_measurement,json=unitOfMeasurement,proto3" json:"unit_of_measurement,omitempty"`
-	AccuracyDecimals     int32    `protobuf:"varint,7,opt,name=accuracy_decimals,json=accuracyDecimals,proto3" json:"accuracy_decimals,omitempty"`
-	ForceUpdate          bool     `protobuf:"varint,8,opt,name=force_update,json=forceUpdate,proto3" json:"force_update,omitempty"`
+type VoiceAssistantResponse struct {
+	Port                 uint32   `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Error                bool     `protobuf:"varint,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesSensorResponse) Reset()         { *m = ListEntitiesSensorResponse{} }
-func (m *ListEntitiesSensorResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesSensorResponse) ProtoMessage()    {}
-func (*ListEntitiesSensorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *VoiceAssistantResponse) Reset()         { *m = VoiceAssistantResponse{} }
+func (m *VoiceAssistantResponse) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantResponse) ProtoMessage()    {}
+func (*VoiceAssistantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
 }
 
-func (m *ListEntitiesSensorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesSensorResponse.Unmarshal(m, b)
+func (m *VoiceAssistantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesSensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesSensorResponse.Marshal(b, m, deterministic)
+func (m *VoiceAssistantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesSensorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesSensorResponse.Merge(m, src)
+func (m *VoiceAssistantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantResponse.Merge(m, src)
 }
-func (m *ListEntitiesSensorResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesSensorResponse.Size(m)
+func (m *VoiceAssistantResponse) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantResponse.Size(m)
 }
-func (m *ListEntitiesSensorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesSensorResponse.DiscardUnknown(m)
+func (m *VoiceAssistantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesSensorResponse proto.InternalMessageInfo
+var xxx_messageInfo_VoiceAssistantResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesSensorResponse) GetObjectId() string {
+func (m *VoiceAssistantResponse) GetPort() uint32 {
 	if m != nil {
-		return m.ObjectId
+		return m.Port
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesSensorResponse) GetKey() uint32 {
+func (m *VoiceAssistantResponse) GetError() bool {
 	if m != nil {
-		return m.Key
+		return m.Error
 	}
-	return 0
+	return false
 }
 
-func (m *ListEntitiesSensorResponse) GetName() string {
+type VoiceAssistantEventData struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoiceAssistantEventData) Reset()         { *m = VoiceAssistantEventData{} }
+func (m *VoiceAssistantEventData) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantEventData) ProtoMessage()    {}
+func (*VoiceAssistantEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *VoiceAssistantEventData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantEventData.Unmarshal(m, b)
+}
+func (m *VoiceAssistantEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantEventData.Marshal(b, m, deterministic)
+}
+func (m *VoiceAssistantEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantEventData.Merge(m, src)
+}
+func (m *VoiceAssistantEventData) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantEventData.Size(m)
+}
+func (m *VoiceAssistantEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantEventData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoiceAssistantEventData proto.InternalMessageInfo
+
+func (m *VoiceAssistantEventData) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ListEntitiesSensorResponse) GetUniqueId() string {
+func (m *VoiceAssistantEventData) GetValue() string {
 	if m != nil {
-		return m.UniqueId
+		return m.Value
 	}
 	return ""
 }
 
-func (m *ListEntitiesSensorResponse) GetIcon() string {
+type VoiceAssistantEventResponse struct {
+	EventType            VoiceAssistantEvent        `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=VoiceAssistantEvent" json:"event_type,omitempty"`
+	Data                 []*VoiceAssistantEventData `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *VoiceAssistantEventResponse) Reset()         { *m = VoiceAssistantEventResponse{} }
+func (m *VoiceAssistantEventResponse) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantEventResponse) ProtoMessage()    {}
+func (*VoiceAssistantEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *VoiceAssistantEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantEventResponse.Unmarshal(m, b)
+}
+func (m *VoiceAssistantEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantEventResponse.Marshal(b, m, deterministic)
+}
+func (m *VoiceAssistantEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantEventResponse.Merge(m, src)
+}
+func (m *VoiceAssistantEventResponse) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantEventResponse.Size(m)
+}
+func (m *VoiceAssistantEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoiceAssistantEventResponse proto.InternalMessageInfo
+
+func (m *VoiceAssistantEventResponse) GetEventType() VoiceAssistantEvent {
 	if m != nil {
-		return m.Icon
+		return m.EventType
 	}
-	return ""
+	return VoiceAssistantEvent_VOICE_ASSISTANT_ERROR
 }
 
-func (m *ListEntitiesSensorResponse) GetUnitOfMeasurement() string {
+func (m *VoiceAssistantEventResponse) GetData() []*VoiceAssistantEventData {
 	if m != nil {
-		return m.UnitOfMeasurement
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (m *ListEntitiesSensorResponse) GetAccuracyDecimals() int32 {
+type VoiceAssistantAudio struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	End                  bool     `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoiceAssistantAudio) Reset()         { *m = VoiceAssistantAudio{} }
+func (m *VoiceAssistantAudio) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantAudio) ProtoMessage()    {}
+func (*VoiceAssistantAudio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *VoiceAssistantAudio) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantAudio.Unmarshal(m, b)
+}
+func (m *VoiceAssistantAudio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantAudio.Marshal(b, m, deterministic)
+}
+func (m *VoiceAssistantAudio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantAudio.Merge(m, src)
+}
+func (m *VoiceAssistantAudio) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantAudio.Size(m)
+}
+func (m *VoiceAssistantAudio) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantAudio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoiceAssistantAudio proto.InternalMessageInfo
+
+func (m *VoiceAssistantAudio) GetData() []byte {
 	if m != nil {
-		return m.AccuracyDecimals
+		return m.Data
 	}
-	return 0
+	return nil
 }
 
-func (m *ListEntitiesSensorResponse) GetForceUpdate() bool {
+func (m *VoiceAssistantAudio) GetEnd() bool {
 	if m != nil {
-		return m.ForceUpdate
+		return m.End
 	}
 	return false
 }
 
-type SensorStateResponse struct {
-	Key   uint32  `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	State float32 `protobuf:"fixed32,2,opt,name=state,proto3" json:"state,omitempty"`
-	// If the sensor does not have a valid state yet.
-	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
-	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type VoiceAssistantTimerEventResponse struct {
+	EventType            VoiceAssistantTimerEvent `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=VoiceAssistantTimerEvent" json:"event_type,omitempty"`
+	TimerId              string                   `protobuf:"bytes,2,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	Name                 string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TotalSeconds         uint32                   `protobuf:"varint,4,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	SecondsLeft          uint32                   `protobuf:"varint,5,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"`
+	IsActive             bool                     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *SensorStateResponse) Reset()         { *m = SensorStateResponse{} }
-func (m *SensorStateResponse) String() string { return proto.CompactTextString(m) }
-func (*SensorStateResponse) ProtoMessage()    {}
-func (*SensorStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+func (m *VoiceAssistantTimerEventResponse) Reset()         { *m = VoiceAssistantTimerEventResponse{} }
+func (m *VoiceAssistantTimerEventResponse) String() string { return proto.CompactTextString(m) }
+func (*VoiceAssistantTimerEventResponse) ProtoMessage()    {}
+func (*VoiceAssistantTimerEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
 }
 
-func (m *SensorStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SensorStateResponse.Unmarshal(m, b)
+func (m *VoiceAssistantTimerEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoiceAssistantTimerEventResponse.Unmarshal(m, b)
 }
-func (m *SensorStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SensorStateResponse.Marshal(b, m, deterministic)
+func (m *VoiceAssistantTimerEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoiceAssistantTimerEventResponse.Marshal(b, m, deterministic)
 }
-func (m *SensorStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SensorStateResponse.Merge(m, src)
+func (m *VoiceAssistantTimerEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceAssistantTimerEventResponse.Merge(m, src)
 }
-func (m *SensorStateResponse) XXX_Size() int {
-	return xxx_messageInfo_SensorStateResponse.Size(m)
+func (m *VoiceAssistantTimerEventResponse) XXX_Size() int {
+	return xxx_messageInfo_VoiceAssistantTimerEventResponse.Size(m)
 }
-func (m *SensorStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SensorStateResponse.DiscardUnknown(m)
+func (m *VoiceAssistantTimerEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceAssistantTimerEventResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SensorStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_VoiceAssistantTimerEventResponse proto.InternalMessageInfo
 
-func (m *SensorStateResponse) GetKey() uint32 {
+func (m *VoiceAssistantTimerEventResponse) GetEventType() VoiceAssistantTimerEvent {
 	if m != nil {
-		return m.Key
+		return m.EventType
+	}
+	return VoiceAssistantTimerEvent_VOICE_ASSISTANT_TIMER_STARTED
+}
+
+func (m *VoiceAssistantTimerEventResponse) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
+	}
+	return ""
+}
+
+func (m *VoiceAssistantTimerEventResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VoiceAssistantTimerEventResponse) GetTotalSeconds() uint32 {
+	if m != nil {
+		return m.TotalSeconds
 	}
 	return 0
 }
 
-func (m *SensorStateResponse) GetState() float32 {
+func (m *VoiceAssistantTimerEventResponse) GetSecondsLeft() uint32 {
 	if m != nil {
-		return m.State
+		return m.SecondsLeft
 	}
 	return 0
 }
 
-func (m *SensorStateResponse) GetMissingState() bool {
+func (m *VoiceAssistantTimerEventResponse) GetIsActive() bool {
 	if m != nil {
-		return m.MissingState
+		return m.IsActive
 	}
 	return false
 }
 
-// ==================== SWITCH ====================
-type ListEntitiesSwitchResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	Icon                 string   `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
-	AssumedState         bool     `protobuf:"varint,6,opt,name=assumed_state,json=assumedState,proto3" json:"assumed_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListEntitiesAlarmControlPanelResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	SupportedFeatures    uint32         `protobuf:"varint,8,opt,name=supported_features,json=supportedFeatures,proto3" json:"supported_features,omitempty"`
+	RequiresCode         bool           `protobuf:"varint,9,opt,name=requires_code,json=requiresCode,proto3" json:"requires_code,omitempty"`
+	RequiresCodeToArm    bool           `protobuf:"varint,10,opt,name=requires_code_to_arm,json=requiresCodeToArm,proto3" json:"requires_code_to_arm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ListEntitiesSwitchResponse) Reset()         { *m = ListEntitiesSwitchResponse{} }
-func (m *ListEntitiesSwitchResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesSwitchResponse) ProtoMessage()    {}
-func (*ListEntitiesSwitchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *ListEntitiesAlarmControlPanelResponse) Reset()         { *m = ListEntitiesAlarmControlPanelResponse{} }
+func (m *ListEntitiesAlarmControlPanelResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesAlarmControlPanelResponse) ProtoMessage()    {}
+func (*ListEntitiesAlarmControlPanelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
 }
 
-func (m *ListEntitiesSwitchResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesSwitchResponse.Unmarshal(m, b)
+func (m *ListEntitiesAlarmControlPanelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesAlarmControlPanelResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesSwitchResponse.Marshal(b, m, deterministic)
+func (m *ListEntitiesAlarmControlPanelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesAlarmControlPanelResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesSwitchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesSwitchResponse.Merge(m, src)
+func (m *ListEntitiesAlarmControlPanelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesAlarmControlPanelResponse.Merge(m, src)
 }
-func (m *ListEntitiesSwitchResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesSwitchResponse.Size(m)
+func (m *ListEntitiesAlarmControlPanelResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesAlarmControlPanelResponse.Size(m)
 }
-func (m *ListEntitiesSwitchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesSwitchResponse.DiscardUnknown(m)
+func (m *ListEntitiesAlarmControlPanelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesAlarmControlPanelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesSwitchResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesAlarmControlPanelResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesSwitchResponse) GetObjectId() string {
+func (m *ListEntitiesAlarmControlPanelResponse) GetObjectId() string {
 	if m != nil {
 		return m.ObjectId
 	}
 	return ""
 }
 
-func (m *ListEntitiesSwitchResponse) GetKey() uint32 {
+func (m *ListEntitiesAlarmControlPanelResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ListEntitiesSwitchResponse) GetName() string {
+func (m *ListEntitiesAlarmControlPanelResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ListEntitiesSwitchResponse) GetUniqueId() string {
+func (m *ListEntitiesAlarmControlPanelResponse) GetUniqueId() string {
 	if m != nil {
 		return m.UniqueId
 	}
 	return ""
 }
 
-func (m *ListEntitiesSwitchResponse) GetIcon() string {
+func (m *ListEntitiesAlarmControlPanelResponse) GetIcon() string {
 	if m != nil {
 		return m.Icon
 	}
 	return ""
 }
 
-func (m *ListEntitiesSwitchResponse) GetAssumedState() bool {
+func (m *ListEntitiesAlarmControlPanelResponse) GetDisabledByDefault() bool {
 	if m != nil {
-		return m.AssumedState
+		return m.DisabledByDefault
 	}
 	return false
 }
 
-type SwitchStateResponse struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListEntitiesAlarmControlPanelResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
 }
 
-func (m *SwitchStateResponse) Reset()         { *m = SwitchStateResponse{} }
-func (m *SwitchStateResponse) String() string { return proto.CompactTextString(m) }
-func (*SwitchStateResponse) ProtoMessage()    {}
-func (*SwitchStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *ListEntitiesAlarmControlPanelResponse) GetSupportedFeatures() uint32 {
+	if m != nil {
+		return m.SupportedFeatures
+	}
+	return 0
 }
 
-func (m *SwitchStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SwitchStateResponse.Unmarshal(m, b)
+func (m *ListEntitiesAlarmControlPanelResponse) GetRequiresCode() bool {
+	if m != nil {
+		return m.RequiresCode
+	}
+	return false
 }
-func (m *SwitchStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SwitchStateResponse.Marshal(b, m, deterministic)
+
+func (m *ListEntitiesAlarmControlPanelResponse) GetRequiresCodeToArm() bool {
+	if m != nil {
+		return m.RequiresCodeToArm
+	}
+	return false
 }
-func (m *SwitchStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwitchStateResponse.Merge(m, src)
+
+type AlarmControlPanelStateResponse struct {
+	Key                  uint32                 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                AlarmControlPanelState `protobuf:"varint,2,opt,name=state,proto3,enum=AlarmControlPanelState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
-func (m *SwitchStateResponse) XXX_Size() int {
-	return xxx_messageInfo_SwitchStateResponse.Size(m)
+
+func (m *AlarmControlPanelStateResponse) Reset()         { *m = AlarmControlPanelStateResponse{} }
+func (m *AlarmControlPanelStateResponse) String() string { return proto.CompactTextString(m) }
+func (*AlarmControlPanelStateResponse) ProtoMessage()    {}
+func (*AlarmControlPanelStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
 }
-func (m *SwitchStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwitchStateResponse.DiscardUnknown(m)
+
+func (m *AlarmControlPanelStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlarmControlPanelStateResponse.Unmarshal(m, b)
+}
+func (m *AlarmControlPanelStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlarmControlPanelStateResponse.Marshal(b, m, deterministic)
+}
+func (m *AlarmControlPanelStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlarmControlPanelStateResponse.Merge(m, src)
+}
+func (m *AlarmControlPanelStateResponse) XXX_Size() int {
+	return xxx_messageInfo_AlarmControlPanelStateResponse.Size(m)
+}
+func (m *AlarmControlPanelStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlarmControlPanelStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SwitchStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_AlarmControlPanelStateResponse proto.InternalMessageInfo
 
-func (m *SwitchStateResponse) GetKey() uint32 {
+func (m *AlarmControlPanelStateResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *SwitchStateResponse) GetState() bool {
+func (m *AlarmControlPanelStateResponse) GetState() AlarmControlPanelState {
 	if m != nil {
 		return m.State
-	}
-	return false
-}
-
-type SwitchCommandRequest struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	State                bool     `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	}
+	return AlarmControlPanelState_ALARM_STATE_DISARMED
 }
 
-func (m *SwitchCommandRequest) Reset()         { *m = SwitchCommandRequest{} }
-func (m *SwitchCommandRequest) String() string { return proto.CompactTextString(m) }
-func (*SwitchCommandRequest) ProtoMessage()    {}
-func (*SwitchCommandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+type AlarmControlPanelCommandRequest struct {
+	Key                  uint32                        `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Command              AlarmControlPanelStateCommand `protobuf:"varint,2,opt,name=command,proto3,enum=AlarmControlPanelStateCommand" json:"command,omitempty"`
+	Code                 string                        `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *SwitchCommandRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SwitchCommandRequest.Unmarshal(m, b)
+func (m *AlarmControlPanelCommandRequest) Reset()         { *m = AlarmControlPanelCommandRequest{} }
+func (m *AlarmControlPanelCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*AlarmControlPanelCommandRequest) ProtoMessage()    {}
+func (*AlarmControlPanelCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{108}
 }
-func (m *SwitchCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SwitchCommandRequest.Marshal(b, m, deterministic)
+
+func (m *AlarmControlPanelCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlarmControlPanelCommandRequest.Unmarshal(m, b)
 }
-func (m *SwitchCommandRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwitchCommandRequest.Merge(m, src)
+func (m *AlarmControlPanelCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlarmControlPanelCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *SwitchCommandRequest) XXX_Size() int {
-	return xxx_messageInfo_SwitchCommandRequest.Size(m)
+func (m *AlarmControlPanelCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlarmControlPanelCommandRequest.Merge(m, src)
 }
-func (m *SwitchCommandRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwitchCommandRequest.DiscardUnknown(m)
+func (m *AlarmControlPanelCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_AlarmControlPanelCommandRequest.Size(m)
+}
+func (m *AlarmControlPanelCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlarmControlPanelCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SwitchCommandRequest proto.InternalMessageInfo
+var xxx_messageInfo_AlarmControlPanelCommandRequest proto.InternalMessageInfo
 
-func (m *SwitchCommandRequest) GetKey() uint32 {
+func (m *AlarmControlPanelCommandRequest) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *SwitchCommandRequest) GetState() bool {
+func (m *AlarmControlPanelCommandRequest) GetCommand() AlarmControlPanelStateCommand {
 	if m != nil {
-		return m.State
+		return m.Command
 	}
-	return false
+	return AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_DISARM
 }
 
-// ==================== TEXT SENSOR ====================
-type ListEntitiesTextSensorResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	Icon                 string   `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AlarmControlPanelCommandRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (m *ListEntitiesTextSensorResponse) Reset()         { *m = ListEntitiesTextSensorResponse{} }
-func (m *ListEntitiesTextSensorResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesTextSensorResponse) ProtoMessage()    {}
-func (*ListEntitiesTextSensorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+type ListEntitiesTextResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	MinLength            uint32         `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength            uint32         `protobuf:"varint,9,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	Pattern              string         `protobuf:"bytes,10,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Mode                 TextMode       `protobuf:"varint,11,opt,name=mode,proto3,enum=TextMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ListEntitiesTextSensorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesTextSensorResponse.Unmarshal(m, b)
+func (m *ListEntitiesTextResponse) Reset()         { *m = ListEntitiesTextResponse{} }
+func (m *ListEntitiesTextResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesTextResponse) ProtoMessage()    {}
+func (*ListEntitiesTextResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{109}
 }
-func (m *ListEntitiesTextSensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesTextSensorResponse.Marshal(b, m, deterministic)
+
+func (m *ListEntitiesTextResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesTextResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesTextSensorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesTextSensorResponse.Merge(m, src)
+func (m *ListEntitiesTextResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesTextResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesTextSensorResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesTextSensorResponse.Size(m)
+func (m *ListEntitiesTextResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesTextResponse.Merge(m, src)
 }
-func (m *ListEntitiesTextSensorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesTextSensorResponse.DiscardUnknown(m)
+func (m *ListEntitiesTextResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesTextResponse.Size(m)
+}
+func (m *ListEntitiesTextResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesTextResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesTextSensorResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesTextResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesTextSensorResponse) GetObjectId() string {
+func (m *ListEntitiesTextResponse) GetObjectId() string {
 	if m != nil {
 		return m.ObjectId
 	}
 	return ""
 }
 
-func (m *ListEntitiesTextSensorResponse) GetKey() uint32 {
+func (m *ListEntitiesTextResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ListEntitiesTextSensorResponse) GetName() string {
+func (m *ListEntitiesTextResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ListEntitiesTextSensorResponse) GetUniqueId() string {
+func (m *ListEntitiesTextResponse) GetUniqueId() string {
 	if m != nil {
 		return m.UniqueId
 	}
 	return ""
 }
 
-func (m *ListEntitiesTextSensorResponse) GetIcon() string {
+func (m *ListEntitiesTextResponse) GetIcon() string {
 	if m != nil {
 		return m.Icon
 	}
 	return ""
 }
 
-type TextSensorStateResponse struct {
+func (m *ListEntitiesTextResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
+}
+
+func (m *ListEntitiesTextResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
+
+func (m *ListEntitiesTextResponse) GetMinLength() uint32 {
+	if m != nil {
+		return m.MinLength
+	}
+	return 0
+}
+
+func (m *ListEntitiesTextResponse) GetMaxLength() uint32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *ListEntitiesTextResponse) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *ListEntitiesTextResponse) GetMode() TextMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TextMode_TEXT_MODE_TEXT
+}
+
+type TextStateResponse struct {
 	Key   uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
 	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	// If the text sensor does not have a valid state yet.
+	// If the Text does not have a valid state yet.
 	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
 	MissingState         bool     `protobuf:"varint,3,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2353,1339 +8837,1412 @@ type TextSensorStateResponse struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TextSensorStateResponse) Reset()         { *m = TextSensorStateResponse{} }
-func (m *TextSensorStateResponse) String() string { return proto.CompactTextString(m) }
-func (*TextSensorStateResponse) ProtoMessage()    {}
-func (*TextSensorStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+func (m *TextStateResponse) Reset()         { *m = TextStateResponse{} }
+func (m *TextStateResponse) String() string { return proto.CompactTextString(m) }
+func (*TextStateResponse) ProtoMessage()    {}
+func (*TextStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{110}
 }
 
-func (m *TextSensorStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TextSensorStateResponse.Unmarshal(m, b)
+func (m *TextStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TextStateResponse.Unmarshal(m, b)
 }
-func (m *TextSensorStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TextSensorStateResponse.Marshal(b, m, deterministic)
+func (m *TextStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TextStateResponse.Marshal(b, m, deterministic)
 }
-func (m *TextSensorStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TextSensorStateResponse.Merge(m, src)
+func (m *TextStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextStateResponse.Merge(m, src)
 }
-func (m *TextSensorStateResponse) XXX_Size() int {
-	return xxx_messageInfo_TextSensorStateResponse.Size(m)
+func (m *TextStateResponse) XXX_Size() int {
+	return xxx_messageInfo_TextStateResponse.Size(m)
 }
-func (m *TextSensorStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TextSensorStateResponse.DiscardUnknown(m)
+func (m *TextStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TextSensorStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_TextStateResponse proto.InternalMessageInfo
 
-func (m *TextSensorStateResponse) GetKey() uint32 {
+func (m *TextStateResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *TextSensorStateResponse) GetState() string {
+func (m *TextStateResponse) GetState() string {
 	if m != nil {
 		return m.State
 	}
 	return ""
 }
 
-func (m *TextSensorStateResponse) GetMissingState() bool {
+func (m *TextStateResponse) GetMissingState() bool {
 	if m != nil {
 		return m.MissingState
 	}
 	return false
 }
 
-type SubscribeLogsRequest struct {
-	Level                LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=LogLevel" json:"level,omitempty"`
-	DumpConfig           bool     `protobuf:"varint,2,opt,name=dump_config,json=dumpConfig,proto3" json:"dump_config,omitempty"`
+type TextCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeLogsRequest) Reset()         { *m = SubscribeLogsRequest{} }
-func (m *SubscribeLogsRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeLogsRequest) ProtoMessage()    {}
-func (*SubscribeLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+func (m *TextCommandRequest) Reset()         { *m = TextCommandRequest{} }
+func (m *TextCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*TextCommandRequest) ProtoMessage()    {}
+func (*TextCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{111}
 }
 
-func (m *SubscribeLogsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeLogsRequest.Unmarshal(m, b)
+func (m *TextCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TextCommandRequest.Unmarshal(m, b)
 }
-func (m *SubscribeLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeLogsRequest.Marshal(b, m, deterministic)
+func (m *TextCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TextCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *SubscribeLogsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeLogsRequest.Merge(m, src)
+func (m *TextCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextCommandRequest.Merge(m, src)
 }
-func (m *SubscribeLogsRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeLogsRequest.Size(m)
+func (m *TextCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_TextCommandRequest.Size(m)
 }
-func (m *SubscribeLogsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeLogsRequest.DiscardUnknown(m)
+func (m *TextCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeLogsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TextCommandRequest proto.InternalMessageInfo
 
-func (m *SubscribeLogsRequest) GetLevel() LogLevel {
+func (m *TextCommandRequest) GetKey() uint32 {
 	if m != nil {
-		return m.Level
+		return m.Key
 	}
-	return LogLevel_LOG_LEVEL_NONE
+	return 0
 }
 
-func (m *SubscribeLogsRequest) GetDumpConfig() bool {
+func (m *TextCommandRequest) GetState() string {
 	if m != nil {
-		return m.DumpConfig
+		return m.State
 	}
-	return false
+	return ""
 }
 
-type SubscribeLogsResponse struct {
-	Level                LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=LogLevel" json:"level,omitempty"`
-	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	SendFailed           bool     `protobuf:"varint,4,opt,name=send_failed,json=sendFailed,proto3" json:"send_failed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ==================== DATETIME DATE ====================
+type ListEntitiesDateResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *SubscribeLogsResponse) Reset()         { *m = SubscribeLogsResponse{} }
-func (m *SubscribeLogsResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscribeLogsResponse) ProtoMessage()    {}
-func (*SubscribeLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+func (m *ListEntitiesDateResponse) Reset()         { *m = ListEntitiesDateResponse{} }
+func (m *ListEntitiesDateResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesDateResponse) ProtoMessage()    {}
+func (*ListEntitiesDateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{112}
 }
 
-func (m *SubscribeLogsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeLogsResponse.Unmarshal(m, b)
+func (m *ListEntitiesDateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesDateResponse.Unmarshal(m, b)
 }
-func (m *SubscribeLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeLogsResponse.Marshal(b, m, deterministic)
+func (m *ListEntitiesDateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesDateResponse.Marshal(b, m, deterministic)
 }
-func (m *SubscribeLogsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeLogsResponse.Merge(m, src)
+func (m *ListEntitiesDateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesDateResponse.Merge(m, src)
 }
-func (m *SubscribeLogsResponse) XXX_Size() int {
-	return xxx_messageInfo_SubscribeLogsResponse.Size(m)
+func (m *ListEntitiesDateResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesDateResponse.Size(m)
 }
-func (m *SubscribeLogsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeLogsResponse.DiscardUnknown(m)
+func (m *ListEntitiesDateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesDateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeLogsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesDateResponse proto.InternalMessageInfo
 
-func (m *SubscribeLogsResponse) GetLevel() LogLevel {
+func (m *ListEntitiesDateResponse) GetObjectId() string {
 	if m != nil {
-		return m.Level
+		return m.ObjectId
 	}
-	return LogLevel_LOG_LEVEL_NONE
+	return ""
 }
 
-func (m *SubscribeLogsResponse) GetTag() string {
+func (m *ListEntitiesDateResponse) GetKey() uint32 {
 	if m != nil {
-		return m.Tag
+		return m.Key
 	}
-	return ""
+	return 0
 }
 
-func (m *SubscribeLogsResponse) GetMessage() string {
+func (m *ListEntitiesDateResponse) GetName() string {
 	if m != nil {
-		return m.Message
+		return m.Name
 	}
 	return ""
 }
 
-func (m *SubscribeLogsResponse) GetSendFailed() bool {
+func (m *ListEntitiesDateResponse) GetUniqueId() string {
 	if m != nil {
-		return m.SendFailed
+		return m.UniqueId
 	}
-	return false
-}
-
-// ==================== HOMEASSISTANT.SERVICE ====================
-type SubscribeHomeassistantServicesRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *SubscribeHomeassistantServicesRequest) Reset()         { *m = SubscribeHomeassistantServicesRequest{} }
-func (m *SubscribeHomeassistantServicesRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeHomeassistantServicesRequest) ProtoMessage()    {}
-func (*SubscribeHomeassistantServicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
-}
-
-func (m *SubscribeHomeassistantServicesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeHomeassistantServicesRequest.Unmarshal(m, b)
-}
-func (m *SubscribeHomeassistantServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeHomeassistantServicesRequest.Marshal(b, m, deterministic)
-}
-func (m *SubscribeHomeassistantServicesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeHomeassistantServicesRequest.Merge(m, src)
-}
-func (m *SubscribeHomeassistantServicesRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeHomeassistantServicesRequest.Size(m)
-}
-func (m *SubscribeHomeassistantServicesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeHomeassistantServicesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SubscribeHomeassistantServicesRequest proto.InternalMessageInfo
-
-type HomeassistantServiceMap struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *HomeassistantServiceMap) Reset()         { *m = HomeassistantServiceMap{} }
-func (m *HomeassistantServiceMap) String() string { return proto.CompactTextString(m) }
-func (*HomeassistantServiceMap) ProtoMessage()    {}
-func (*HomeassistantServiceMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+	return ""
 }
 
-func (m *HomeassistantServiceMap) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HomeassistantServiceMap.Unmarshal(m, b)
-}
-func (m *HomeassistantServiceMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HomeassistantServiceMap.Marshal(b, m, deterministic)
-}
-func (m *HomeassistantServiceMap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HomeassistantServiceMap.Merge(m, src)
-}
-func (m *HomeassistantServiceMap) XXX_Size() int {
-	return xxx_messageInfo_HomeassistantServiceMap.Size(m)
-}
-func (m *HomeassistantServiceMap) XXX_DiscardUnknown() {
-	xxx_messageInfo_HomeassistantServiceMap.DiscardUnknown(m)
+func (m *ListEntitiesDateResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
 }
 
-var xxx_messageInfo_HomeassistantServiceMap proto.InternalMessageInfo
-
-func (m *HomeassistantServiceMap) GetKey() string {
+func (m *ListEntitiesDateResponse) GetDisabledByDefault() bool {
 	if m != nil {
-		return m.Key
+		return m.DisabledByDefault
 	}
-	return ""
+	return false
 }
 
-func (m *HomeassistantServiceMap) GetValue() string {
+func (m *ListEntitiesDateResponse) GetEntityCategory() EntityCategory {
 	if m != nil {
-		return m.Value
+		return m.EntityCategory
 	}
-	return ""
+	return EntityCategory_ENTITY_CATEGORY_NONE
 }
 
-type HomeassistantServiceResponse struct {
-	Service              string                     `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Data                 []*HomeassistantServiceMap `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
-	DataTemplate         []*HomeassistantServiceMap `protobuf:"bytes,3,rep,name=data_template,json=dataTemplate,proto3" json:"data_template,omitempty"`
-	Variables            []*HomeassistantServiceMap `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
-	IsEvent              bool                       `protobuf:"varint,5,opt,name=is_event,json=isEvent,proto3" json:"is_event,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+type DateStateResponse struct {
+	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	// If the date does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,2,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	Year                 uint32   `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Month                uint32   `protobuf:"varint,4,opt,name=month,proto3" json:"month,omitempty"`
+	Day                  uint32   `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HomeassistantServiceResponse) Reset()         { *m = HomeassistantServiceResponse{} }
-func (m *HomeassistantServiceResponse) String() string { return proto.CompactTextString(m) }
-func (*HomeassistantServiceResponse) ProtoMessage()    {}
-func (*HomeassistantServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+func (m *DateStateResponse) Reset()         { *m = DateStateResponse{} }
+func (m *DateStateResponse) String() string { return proto.CompactTextString(m) }
+func (*DateStateResponse) ProtoMessage()    {}
+func (*DateStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{113}
 }
 
-func (m *HomeassistantServiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HomeassistantServiceResponse.Unmarshal(m, b)
+func (m *DateStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DateStateResponse.Unmarshal(m, b)
 }
-func (m *HomeassistantServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HomeassistantServiceResponse.Marshal(b, m, deterministic)
+func (m *DateStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DateStateResponse.Marshal(b, m, deterministic)
 }
-func (m *HomeassistantServiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HomeassistantServiceResponse.Merge(m, src)
+func (m *DateStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DateStateResponse.Merge(m, src)
 }
-func (m *HomeassistantServiceResponse) XXX_Size() int {
-	return xxx_messageInfo_HomeassistantServiceResponse.Size(m)
+func (m *DateStateResponse) XXX_Size() int {
+	return xxx_messageInfo_DateStateResponse.Size(m)
 }
-func (m *HomeassistantServiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HomeassistantServiceResponse.DiscardUnknown(m)
+func (m *DateStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DateStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HomeassistantServiceResponse proto.InternalMessageInfo
+var xxx_messageInfo_DateStateResponse proto.InternalMessageInfo
 
-func (m *HomeassistantServiceResponse) GetService() string {
+func (m *DateStateResponse) GetKey() uint32 {
 	if m != nil {
-		return m.Service
+		return m.Key
 	}
-	return ""
+	return 0
 }
 
-func (m *HomeassistantServiceResponse) GetData() []*HomeassistantServiceMap {
+func (m *DateStateResponse) GetMissingState() bool {
 	if m != nil {
-		return m.Data
+		return m.MissingState
 	}
-	return nil
+	return false
 }
 
-func (m *HomeassistantServiceResponse) GetDataTemplate() []*HomeassistantServiceMap {
+func (m *DateStateResponse) GetYear() uint32 {
 	if m != nil {
-		return m.DataTemplate
+		return m.Year
 	}
-	return nil
+	return 0
 }
 
-func (m *HomeassistantServiceResponse) GetVariables() []*HomeassistantServiceMap {
+func (m *DateStateResponse) GetMonth() uint32 {
 	if m != nil {
-		return m.Variables
+		return m.Month
 	}
-	return nil
+	return 0
 }
 
-func (m *HomeassistantServiceResponse) GetIsEvent() bool {
+func (m *DateStateResponse) GetDay() uint32 {
 	if m != nil {
-		return m.IsEvent
+		return m.Day
 	}
-	return false
+	return 0
 }
 
-// ==================== IMPORT HOME ASSISTANT STATES ====================
-// 1. Client sends SubscribeHomeAssistantStatesRequest
-// 2. Server responds with zero or more SubscribeHomeAssistantStateResponse (async)
-// 3. Client sends HomeAssistantStateResponse for state changes.
-type SubscribeHomeAssistantStatesRequest struct {
+type DateCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Year                 uint32   `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month                uint32   `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Day                  uint32   `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeHomeAssistantStatesRequest) Reset()         { *m = SubscribeHomeAssistantStatesRequest{} }
-func (m *SubscribeHomeAssistantStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeHomeAssistantStatesRequest) ProtoMessage()    {}
-func (*SubscribeHomeAssistantStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+func (m *DateCommandRequest) Reset()         { *m = DateCommandRequest{} }
+func (m *DateCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*DateCommandRequest) ProtoMessage()    {}
+func (*DateCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{114}
 }
 
-func (m *SubscribeHomeAssistantStatesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Unmarshal(m, b)
+func (m *DateCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DateCommandRequest.Unmarshal(m, b)
 }
-func (m *SubscribeHomeAssistantStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Marshal(b, m, deterministic)
+func (m *DateCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DateCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *SubscribeHomeAssistantStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Merge(m, src)
+func (m *DateCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DateCommandRequest.Merge(m, src)
 }
-func (m *SubscribeHomeAssistantStatesRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeHomeAssistantStatesRequest.Size(m)
+func (m *DateCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_DateCommandRequest.Size(m)
 }
-func (m *SubscribeHomeAssistantStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeHomeAssistantStatesRequest.DiscardUnknown(m)
+func (m *DateCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DateCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeHomeAssistantStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_DateCommandRequest proto.InternalMessageInfo
 
-type SubscribeHomeAssistantStateResponse struct {
-	EntityId             string   `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DateCommandRequest) GetKey() uint32 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
 }
 
-func (m *SubscribeHomeAssistantStateResponse) Reset()         { *m = SubscribeHomeAssistantStateResponse{} }
-func (m *SubscribeHomeAssistantStateResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscribeHomeAssistantStateResponse) ProtoMessage()    {}
-func (*SubscribeHomeAssistantStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+func (m *DateCommandRequest) GetYear() uint32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
 }
 
-func (m *SubscribeHomeAssistantStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeHomeAssistantStateResponse.Unmarshal(m, b)
-}
-func (m *SubscribeHomeAssistantStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeHomeAssistantStateResponse.Marshal(b, m, deterministic)
-}
-func (m *SubscribeHomeAssistantStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeHomeAssistantStateResponse.Merge(m, src)
-}
-func (m *SubscribeHomeAssistantStateResponse) XXX_Size() int {
-	return xxx_messageInfo_SubscribeHomeAssistantStateResponse.Size(m)
-}
-func (m *SubscribeHomeAssistantStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeHomeAssistantStateResponse.DiscardUnknown(m)
+func (m *DateCommandRequest) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
 }
 
-var xxx_messageInfo_SubscribeHomeAssistantStateResponse proto.InternalMessageInfo
-
-func (m *SubscribeHomeAssistantStateResponse) GetEntityId() string {
+func (m *DateCommandRequest) GetDay() uint32 {
 	if m != nil {
-		return m.EntityId
+		return m.Day
 	}
-	return ""
+	return 0
 }
 
-type HomeAssistantStateResponse struct {
-	EntityId             string   `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ==================== DATETIME TIME ====================
+type ListEntitiesTimeResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *HomeAssistantStateResponse) Reset()         { *m = HomeAssistantStateResponse{} }
-func (m *HomeAssistantStateResponse) String() string { return proto.CompactTextString(m) }
-func (*HomeAssistantStateResponse) ProtoMessage()    {}
-func (*HomeAssistantStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+func (m *ListEntitiesTimeResponse) Reset()         { *m = ListEntitiesTimeResponse{} }
+func (m *ListEntitiesTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesTimeResponse) ProtoMessage()    {}
+func (*ListEntitiesTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{115}
 }
 
-func (m *HomeAssistantStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HomeAssistantStateResponse.Unmarshal(m, b)
+func (m *ListEntitiesTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesTimeResponse.Unmarshal(m, b)
 }
-func (m *HomeAssistantStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HomeAssistantStateResponse.Marshal(b, m, deterministic)
+func (m *ListEntitiesTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesTimeResponse.Marshal(b, m, deterministic)
 }
-func (m *HomeAssistantStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HomeAssistantStateResponse.Merge(m, src)
+func (m *ListEntitiesTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesTimeResponse.Merge(m, src)
 }
-func (m *HomeAssistantStateResponse) XXX_Size() int {
-	return xxx_messageInfo_HomeAssistantStateResponse.Size(m)
+func (m *ListEntitiesTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesTimeResponse.Size(m)
 }
-func (m *HomeAssistantStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HomeAssistantStateResponse.DiscardUnknown(m)
+func (m *ListEntitiesTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesTimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HomeAssistantStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesTimeResponse proto.InternalMessageInfo
 
-func (m *HomeAssistantStateResponse) GetEntityId() string {
+func (m *ListEntitiesTimeResponse) GetObjectId() string {
 	if m != nil {
-		return m.EntityId
+		return m.ObjectId
 	}
 	return ""
 }
 
-func (m *HomeAssistantStateResponse) GetState() string {
+func (m *ListEntitiesTimeResponse) GetKey() uint32 {
 	if m != nil {
-		return m.State
+		return m.Key
 	}
-	return ""
+	return 0
 }
 
-// ==================== IMPORT TIME ====================
-type GetTimeRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListEntitiesTimeResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *GetTimeRequest) Reset()         { *m = GetTimeRequest{} }
-func (m *GetTimeRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTimeRequest) ProtoMessage()    {}
-func (*GetTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+func (m *ListEntitiesTimeResponse) GetUniqueId() string {
+	if m != nil {
+		return m.UniqueId
+	}
+	return ""
 }
 
-func (m *GetTimeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTimeRequest.Unmarshal(m, b)
-}
-func (m *GetTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTimeRequest.Marshal(b, m, deterministic)
-}
-func (m *GetTimeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTimeRequest.Merge(m, src)
-}
-func (m *GetTimeRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTimeRequest.Size(m)
+func (m *ListEntitiesTimeResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
 }
-func (m *GetTimeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTimeRequest.DiscardUnknown(m)
+
+func (m *ListEntitiesTimeResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
 }
 
-var xxx_messageInfo_GetTimeRequest proto.InternalMessageInfo
+func (m *ListEntitiesTimeResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
+}
 
-type GetTimeResponse struct {
-	EpochSeconds         uint32   `protobuf:"fixed32,1,opt,name=epoch_seconds,json=epochSeconds,proto3" json:"epoch_seconds,omitempty"`
+type TimeStateResponse struct {
+	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	// If the time does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,2,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	Hour                 uint32   `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute               uint32   `protobuf:"varint,4,opt,name=minute,proto3" json:"minute,omitempty"`
+	Second               uint32   `protobuf:"varint,5,opt,name=second,proto3" json:"second,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTimeResponse) Reset()         { *m = GetTimeResponse{} }
-func (m *GetTimeResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTimeResponse) ProtoMessage()    {}
-func (*GetTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+func (m *TimeStateResponse) Reset()         { *m = TimeStateResponse{} }
+func (m *TimeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*TimeStateResponse) ProtoMessage()    {}
+func (*TimeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{116}
 }
 
-func (m *GetTimeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTimeResponse.Unmarshal(m, b)
+func (m *TimeStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeStateResponse.Unmarshal(m, b)
 }
-func (m *GetTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTimeResponse.Marshal(b, m, deterministic)
+func (m *TimeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeStateResponse.Marshal(b, m, deterministic)
 }
-func (m *GetTimeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTimeResponse.Merge(m, src)
+func (m *TimeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeStateResponse.Merge(m, src)
 }
-func (m *GetTimeResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTimeResponse.Size(m)
+func (m *TimeStateResponse) XXX_Size() int {
+	return xxx_messageInfo_TimeStateResponse.Size(m)
 }
-func (m *GetTimeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTimeResponse.DiscardUnknown(m)
+func (m *TimeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTimeResponse proto.InternalMessageInfo
+var xxx_messageInfo_TimeStateResponse proto.InternalMessageInfo
 
-func (m *GetTimeResponse) GetEpochSeconds() uint32 {
+func (m *TimeStateResponse) GetKey() uint32 {
 	if m != nil {
-		return m.EpochSeconds
+		return m.Key
 	}
 	return 0
 }
 
-type ListEntitiesServicesArgument struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type                 ServiceArgType `protobuf:"varint,2,opt,name=type,proto3,enum=ServiceArgType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
-
-func (m *ListEntitiesServicesArgument) Reset()         { *m = ListEntitiesServicesArgument{} }
-func (m *ListEntitiesServicesArgument) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesServicesArgument) ProtoMessage()    {}
-func (*ListEntitiesServicesArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+func (m *TimeStateResponse) GetMissingState() bool {
+	if m != nil {
+		return m.MissingState
+	}
+	return false
 }
 
-func (m *ListEntitiesServicesArgument) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesServicesArgument.Unmarshal(m, b)
-}
-func (m *ListEntitiesServicesArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesServicesArgument.Marshal(b, m, deterministic)
-}
-func (m *ListEntitiesServicesArgument) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesServicesArgument.Merge(m, src)
-}
-func (m *ListEntitiesServicesArgument) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesServicesArgument.Size(m)
-}
-func (m *ListEntitiesServicesArgument) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesServicesArgument.DiscardUnknown(m)
+func (m *TimeStateResponse) GetHour() uint32 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
 }
 
-var xxx_messageInfo_ListEntitiesServicesArgument proto.InternalMessageInfo
-
-func (m *ListEntitiesServicesArgument) GetName() string {
+func (m *TimeStateResponse) GetMinute() uint32 {
 	if m != nil {
-		return m.Name
+		return m.Minute
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesServicesArgument) GetType() ServiceArgType {
+func (m *TimeStateResponse) GetSecond() uint32 {
 	if m != nil {
-		return m.Type
+		return m.Second
 	}
-	return ServiceArgType_SERVICE_ARG_TYPE_BOOL
+	return 0
 }
 
-type ListEntitiesServicesResponse struct {
-	Name                 string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key                  uint32                          `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Args                 []*ListEntitiesServicesArgument `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+type TimeCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Hour                 uint32   `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute               uint32   `protobuf:"varint,3,opt,name=minute,proto3" json:"minute,omitempty"`
+	Second               uint32   `protobuf:"varint,4,opt,name=second,proto3" json:"second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesServicesResponse) Reset()         { *m = ListEntitiesServicesResponse{} }
-func (m *ListEntitiesServicesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesServicesResponse) ProtoMessage()    {}
-func (*ListEntitiesServicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+func (m *TimeCommandRequest) Reset()         { *m = TimeCommandRequest{} }
+func (m *TimeCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*TimeCommandRequest) ProtoMessage()    {}
+func (*TimeCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{117}
 }
 
-func (m *ListEntitiesServicesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesServicesResponse.Unmarshal(m, b)
+func (m *TimeCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeCommandRequest.Unmarshal(m, b)
 }
-func (m *ListEntitiesServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesServicesResponse.Marshal(b, m, deterministic)
+func (m *TimeCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesServicesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesServicesResponse.Merge(m, src)
+func (m *TimeCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeCommandRequest.Merge(m, src)
 }
-func (m *ListEntitiesServicesResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesServicesResponse.Size(m)
+func (m *TimeCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_TimeCommandRequest.Size(m)
 }
-func (m *ListEntitiesServicesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesServicesResponse.DiscardUnknown(m)
+func (m *TimeCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesServicesResponse proto.InternalMessageInfo
+var xxx_messageInfo_TimeCommandRequest proto.InternalMessageInfo
 
-func (m *ListEntitiesServicesResponse) GetName() string {
+func (m *TimeCommandRequest) GetKey() uint32 {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
-	return ""
+	return 0
 }
 
-func (m *ListEntitiesServicesResponse) GetKey() uint32 {
+func (m *TimeCommandRequest) GetHour() uint32 {
 	if m != nil {
-		return m.Key
+		return m.Hour
 	}
 	return 0
 }
 
-func (m *ListEntitiesServicesResponse) GetArgs() []*ListEntitiesServicesArgument {
+func (m *TimeCommandRequest) GetMinute() uint32 {
 	if m != nil {
-		return m.Args
+		return m.Minute
 	}
-	return nil
+	return 0
 }
 
-type ExecuteServiceArgument struct {
-	Bool_     bool    `protobuf:"varint,1,opt,name=bool_,json=bool,proto3" json:"bool_,omitempty"`
-	LegacyInt int32   `protobuf:"varint,2,opt,name=legacy_int,json=legacyInt,proto3" json:"legacy_int,omitempty"`
-	Float_    float32 `protobuf:"fixed32,3,opt,name=float_,json=float,proto3" json:"float_,omitempty"`
-	String_   string  `protobuf:"bytes,4,opt,name=string_,json=string,proto3" json:"string_,omitempty"`
-	// ESPHome 1.14 (api v1.3) make int a signed value
-	Int_                 int32     `protobuf:"zigzag32,5,opt,name=int_,json=int,proto3" json:"int_,omitempty"`
-	BoolArray            []bool    `protobuf:"varint,6,rep,name=bool_array,json=boolArray,proto3" json:"bool_array,omitempty"`
-	IntArray             []int32   `protobuf:"zigzag32,7,rep,name=int_array,json=intArray,proto3" json:"int_array,omitempty"`
-	FloatArray           []float32 `protobuf:"fixed32,8,rep,name=float_array,json=floatArray,proto3" json:"float_array,omitempty"`
-	StringArray          []string  `protobuf:"bytes,9,rep,name=string_array,json=stringArray,proto3" json:"string_array,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *TimeCommandRequest) GetSecond() uint32 {
+	if m != nil {
+		return m.Second
+	}
+	return 0
 }
 
-func (m *ExecuteServiceArgument) Reset()         { *m = ExecuteServiceArgument{} }
-func (m *ExecuteServiceArgument) String() string { return proto.CompactTextString(m) }
-func (*ExecuteServiceArgument) ProtoMessage()    {}
-func (*ExecuteServiceArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+// ==================== EVENT ====================
+type ListEntitiesEventResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	EventTypes           []string       `protobuf:"bytes,9,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ExecuteServiceArgument) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExecuteServiceArgument.Unmarshal(m, b)
+func (m *ListEntitiesEventResponse) Reset()         { *m = ListEntitiesEventResponse{} }
+func (m *ListEntitiesEventResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesEventResponse) ProtoMessage()    {}
+func (*ListEntitiesEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{118}
 }
-func (m *ExecuteServiceArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExecuteServiceArgument.Marshal(b, m, deterministic)
+
+func (m *ListEntitiesEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesEventResponse.Unmarshal(m, b)
 }
-func (m *ExecuteServiceArgument) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecuteServiceArgument.Merge(m, src)
+func (m *ListEntitiesEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesEventResponse.Marshal(b, m, deterministic)
 }
-func (m *ExecuteServiceArgument) XXX_Size() int {
-	return xxx_messageInfo_ExecuteServiceArgument.Size(m)
+func (m *ListEntitiesEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesEventResponse.Merge(m, src)
 }
-func (m *ExecuteServiceArgument) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecuteServiceArgument.DiscardUnknown(m)
+func (m *ListEntitiesEventResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesEventResponse.Size(m)
+}
+func (m *ListEntitiesEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesEventResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecuteServiceArgument proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesEventResponse proto.InternalMessageInfo
 
-func (m *ExecuteServiceArgument) GetBool_() bool {
+func (m *ListEntitiesEventResponse) GetObjectId() string {
 	if m != nil {
-		return m.Bool_
+		return m.ObjectId
 	}
-	return false
+	return ""
 }
 
-func (m *ExecuteServiceArgument) GetLegacyInt() int32 {
+func (m *ListEntitiesEventResponse) GetKey() uint32 {
 	if m != nil {
-		return m.LegacyInt
+		return m.Key
 	}
 	return 0
 }
 
-func (m *ExecuteServiceArgument) GetFloat_() float32 {
+func (m *ListEntitiesEventResponse) GetName() string {
 	if m != nil {
-		return m.Float_
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *ExecuteServiceArgument) GetString_() string {
+func (m *ListEntitiesEventResponse) GetUniqueId() string {
 	if m != nil {
-		return m.String_
+		return m.UniqueId
 	}
 	return ""
 }
 
-func (m *ExecuteServiceArgument) GetInt_() int32 {
+func (m *ListEntitiesEventResponse) GetIcon() string {
 	if m != nil {
-		return m.Int_
+		return m.Icon
 	}
-	return 0
+	return ""
 }
 
-func (m *ExecuteServiceArgument) GetBoolArray() []bool {
+func (m *ListEntitiesEventResponse) GetDisabledByDefault() bool {
 	if m != nil {
-		return m.BoolArray
+		return m.DisabledByDefault
 	}
-	return nil
+	return false
 }
 
-func (m *ExecuteServiceArgument) GetIntArray() []int32 {
+func (m *ListEntitiesEventResponse) GetEntityCategory() EntityCategory {
 	if m != nil {
-		return m.IntArray
+		return m.EntityCategory
 	}
-	return nil
+	return EntityCategory_ENTITY_CATEGORY_NONE
 }
 
-func (m *ExecuteServiceArgument) GetFloatArray() []float32 {
+func (m *ListEntitiesEventResponse) GetDeviceClass() string {
 	if m != nil {
-		return m.FloatArray
+		return m.DeviceClass
 	}
-	return nil
+	return ""
 }
 
-func (m *ExecuteServiceArgument) GetStringArray() []string {
+func (m *ListEntitiesEventResponse) GetEventTypes() []string {
 	if m != nil {
-		return m.StringArray
+		return m.EventTypes
 	}
 	return nil
 }
 
-type ExecuteServiceRequest struct {
-	Key                  uint32                    `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	Args                 []*ExecuteServiceArgument `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+type EventResponse struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	EventType            string   `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExecuteServiceRequest) Reset()         { *m = ExecuteServiceRequest{} }
-func (m *ExecuteServiceRequest) String() string { return proto.CompactTextString(m) }
-func (*ExecuteServiceRequest) ProtoMessage()    {}
-func (*ExecuteServiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+func (m *EventResponse) Reset()         { *m = EventResponse{} }
+func (m *EventResponse) String() string { return proto.CompactTextString(m) }
+func (*EventResponse) ProtoMessage()    {}
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{119}
 }
 
-func (m *ExecuteServiceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExecuteServiceRequest.Unmarshal(m, b)
+func (m *EventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventResponse.Unmarshal(m, b)
 }
-func (m *ExecuteServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExecuteServiceRequest.Marshal(b, m, deterministic)
+func (m *EventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventResponse.Marshal(b, m, deterministic)
 }
-func (m *ExecuteServiceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecuteServiceRequest.Merge(m, src)
+func (m *EventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventResponse.Merge(m, src)
 }
-func (m *ExecuteServiceRequest) XXX_Size() int {
-	return xxx_messageInfo_ExecuteServiceRequest.Size(m)
+func (m *EventResponse) XXX_Size() int {
+	return xxx_messageInfo_EventResponse.Size(m)
 }
-func (m *ExecuteServiceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecuteServiceRequest.DiscardUnknown(m)
+func (m *EventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecuteServiceRequest proto.InternalMessageInfo
+var xxx_messageInfo_EventResponse proto.InternalMessageInfo
 
-func (m *ExecuteServiceRequest) GetKey() uint32 {
+func (m *EventResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ExecuteServiceRequest) GetArgs() []*ExecuteServiceArgument {
+func (m *EventResponse) GetEventType() string {
 	if m != nil {
-		return m.Args
+		return m.EventType
 	}
-	return nil
+	return ""
 }
 
-// ==================== CAMERA ====================
-type ListEntitiesCameraResponse struct {
-	ObjectId             string   `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                  uint32   `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId             string   `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ==================== VALVE ====================
+type ListEntitiesValveResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	AssumedState         bool           `protobuf:"varint,9,opt,name=assumed_state,json=assumedState,proto3" json:"assumed_state,omitempty"`
+	SupportsPosition     bool           `protobuf:"varint,10,opt,name=supports_position,json=supportsPosition,proto3" json:"supports_position,omitempty"`
+	SupportsStop         bool           `protobuf:"varint,11,opt,name=supports_stop,json=supportsStop,proto3" json:"supports_stop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ListEntitiesCameraResponse) Reset()         { *m = ListEntitiesCameraResponse{} }
-func (m *ListEntitiesCameraResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesCameraResponse) ProtoMessage()    {}
-func (*ListEntitiesCameraResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+func (m *ListEntitiesValveResponse) Reset()         { *m = ListEntitiesValveResponse{} }
+func (m *ListEntitiesValveResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesValveResponse) ProtoMessage()    {}
+func (*ListEntitiesValveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{120}
 }
 
-func (m *ListEntitiesCameraResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesCameraResponse.Unmarshal(m, b)
+func (m *ListEntitiesValveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesValveResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesCameraResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesCameraResponse.Marshal(b, m, deterministic)
+func (m *ListEntitiesValveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesValveResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesCameraResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesCameraResponse.Merge(m, src)
+func (m *ListEntitiesValveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesValveResponse.Merge(m, src)
 }
-func (m *ListEntitiesCameraResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesCameraResponse.Size(m)
+func (m *ListEntitiesValveResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesValveResponse.Size(m)
 }
-func (m *ListEntitiesCameraResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesCameraResponse.DiscardUnknown(m)
+func (m *ListEntitiesValveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesValveResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesCameraResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesValveResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesCameraResponse) GetObjectId() string {
+func (m *ListEntitiesValveResponse) GetObjectId() string {
 	if m != nil {
 		return m.ObjectId
 	}
 	return ""
 }
 
-func (m *ListEntitiesCameraResponse) GetKey() uint32 {
+func (m *ListEntitiesValveResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ListEntitiesCameraResponse) GetName() string {
+func (m *ListEntitiesValveResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ListEntitiesCameraResponse) GetUniqueId() string {
+func (m *ListEntitiesValveResponse) GetUniqueId() string {
 	if m != nil {
 		return m.UniqueId
 	}
 	return ""
 }
 
-type CameraImageResponse struct {
-	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Done                 bool     `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListEntitiesValveResponse) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
 }
 
-func (m *CameraImageResponse) Reset()         { *m = CameraImageResponse{} }
-func (m *CameraImageResponse) String() string { return proto.CompactTextString(m) }
-func (*CameraImageResponse) ProtoMessage()    {}
-func (*CameraImageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+func (m *ListEntitiesValveResponse) GetDisabledByDefault() bool {
+	if m != nil {
+		return m.DisabledByDefault
+	}
+	return false
 }
 
-func (m *CameraImageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CameraImageResponse.Unmarshal(m, b)
+func (m *ListEntitiesValveResponse) GetEntityCategory() EntityCategory {
+	if m != nil {
+		return m.EntityCategory
+	}
+	return EntityCategory_ENTITY_CATEGORY_NONE
 }
-func (m *CameraImageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CameraImageResponse.Marshal(b, m, deterministic)
+
+func (m *ListEntitiesValveResponse) GetDeviceClass() string {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return ""
 }
-func (m *CameraImageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CameraImageResponse.Merge(m, src)
+
+func (m *ListEntitiesValveResponse) GetAssumedState() bool {
+	if m != nil {
+		return m.AssumedState
+	}
+	return false
 }
-func (m *CameraImageResponse) XXX_Size() int {
-	return xxx_messageInfo_CameraImageResponse.Size(m)
+
+func (m *ListEntitiesValveResponse) GetSupportsPosition() bool {
+	if m != nil {
+		return m.SupportsPosition
+	}
+	return false
 }
-func (m *CameraImageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CameraImageResponse.DiscardUnknown(m)
+
+func (m *ListEntitiesValveResponse) GetSupportsStop() bool {
+	if m != nil {
+		return m.SupportsStop
+	}
+	return false
 }
 
-var xxx_messageInfo_CameraImageResponse proto.InternalMessageInfo
+type ValveStateResponse struct {
+	Key                  uint32         `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Position             float32        `protobuf:"fixed32,2,opt,name=position,proto3" json:"position,omitempty"`
+	CurrentOperation     ValveOperation `protobuf:"varint,3,opt,name=current_operation,json=currentOperation,proto3,enum=ValveOperation" json:"current_operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
 
-func (m *CameraImageResponse) GetKey() uint32 {
+func (m *ValveStateResponse) Reset()         { *m = ValveStateResponse{} }
+func (m *ValveStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ValveStateResponse) ProtoMessage()    {}
+func (*ValveStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{121}
+}
+
+func (m *ValveStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValveStateResponse.Unmarshal(m, b)
+}
+func (m *ValveStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValveStateResponse.Marshal(b, m, deterministic)
+}
+func (m *ValveStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValveStateResponse.Merge(m, src)
+}
+func (m *ValveStateResponse) XXX_Size() int {
+	return xxx_messageInfo_ValveStateResponse.Size(m)
+}
+func (m *ValveStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValveStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValveStateResponse proto.InternalMessageInfo
+
+func (m *ValveStateResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *CameraImageResponse) GetData() []byte {
+func (m *ValveStateResponse) GetPosition() float32 {
 	if m != nil {
-		return m.Data
+		return m.Position
 	}
-	return nil
+	return 0
 }
 
-func (m *CameraImageResponse) GetDone() bool {
+func (m *ValveStateResponse) GetCurrentOperation() ValveOperation {
 	if m != nil {
-		return m.Done
+		return m.CurrentOperation
 	}
-	return false
+	return ValveOperation_VALVE_OPERATION_IDLE
 }
 
-type CameraImageRequest struct {
-	Single               bool     `protobuf:"varint,1,opt,name=single,proto3" json:"single,omitempty"`
-	Stream               bool     `protobuf:"varint,2,opt,name=stream,proto3" json:"stream,omitempty"`
+type ValveCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	HasPosition          bool     `protobuf:"varint,2,opt,name=has_position,json=hasPosition,proto3" json:"has_position,omitempty"`
+	Position             float32  `protobuf:"fixed32,3,opt,name=position,proto3" json:"position,omitempty"`
+	Stop                 bool     `protobuf:"varint,4,opt,name=stop,proto3" json:"stop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
-
-func (m *CameraImageRequest) Reset()         { *m = CameraImageRequest{} }
-func (m *CameraImageRequest) String() string { return proto.CompactTextString(m) }
-func (*CameraImageRequest) ProtoMessage()    {}
-func (*CameraImageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+
+func (m *ValveCommandRequest) Reset()         { *m = ValveCommandRequest{} }
+func (m *ValveCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*ValveCommandRequest) ProtoMessage()    {}
+func (*ValveCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{122}
 }
 
-func (m *CameraImageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CameraImageRequest.Unmarshal(m, b)
+func (m *ValveCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValveCommandRequest.Unmarshal(m, b)
 }
-func (m *CameraImageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CameraImageRequest.Marshal(b, m, deterministic)
+func (m *ValveCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValveCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *CameraImageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CameraImageRequest.Merge(m, src)
+func (m *ValveCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValveCommandRequest.Merge(m, src)
 }
-func (m *CameraImageRequest) XXX_Size() int {
-	return xxx_messageInfo_CameraImageRequest.Size(m)
+func (m *ValveCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_ValveCommandRequest.Size(m)
 }
-func (m *CameraImageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CameraImageRequest.DiscardUnknown(m)
+func (m *ValveCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValveCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CameraImageRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValveCommandRequest proto.InternalMessageInfo
 
-func (m *CameraImageRequest) GetSingle() bool {
+func (m *ValveCommandRequest) GetKey() uint32 {
 	if m != nil {
-		return m.Single
+		return m.Key
+	}
+	return 0
+}
+
+func (m *ValveCommandRequest) GetHasPosition() bool {
+	if m != nil {
+		return m.HasPosition
 	}
 	return false
 }
 
-func (m *CameraImageRequest) GetStream() bool {
+func (m *ValveCommandRequest) GetPosition() float32 {
 	if m != nil {
-		return m.Stream
+		return m.Position
+	}
+	return 0
+}
+
+func (m *ValveCommandRequest) GetStop() bool {
+	if m != nil {
+		return m.Stop
 	}
 	return false
 }
 
-type ListEntitiesClimateResponse struct {
-	ObjectId                          string             `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Key                               uint32             `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name                              string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UniqueId                          string             `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
-	SupportsCurrentTemperature        bool               `protobuf:"varint,5,opt,name=supports_current_temperature,json=supportsCurrentTemperature,proto3" json:"supports_current_temperature,omitempty"`
-	SupportsTwoPointTargetTemperature bool               `protobuf:"varint,6,opt,name=supports_two_point_target_temperature,json=supportsTwoPointTargetTemperature,proto3" json:"supports_two_point_target_temperature,omitempty"`
-	SupportedModes                    []ClimateMode      `protobuf:"varint,7,rep,packed,name=supported_modes,json=supportedModes,proto3,enum=ClimateMode" json:"supported_modes,omitempty"`
-	VisualMinTemperature              float32            `protobuf:"fixed32,8,opt,name=visual_min_temperature,json=visualMinTemperature,proto3" json:"visual_min_temperature,omitempty"`
-	VisualMaxTemperature              float32            `protobuf:"fixed32,9,opt,name=visual_max_temperature,json=visualMaxTemperature,proto3" json:"visual_max_temperature,omitempty"`
-	VisualTemperatureStep             float32            `protobuf:"fixed32,10,opt,name=visual_temperature_step,json=visualTemperatureStep,proto3" json:"visual_temperature_step,omitempty"`
-	SupportsAway                      bool               `protobuf:"varint,11,opt,name=supports_away,json=supportsAway,proto3" json:"supports_away,omitempty"`
-	SupportsAction                    bool               `protobuf:"varint,12,opt,name=supports_action,json=supportsAction,proto3" json:"supports_action,omitempty"`
-	SupportedFanModes                 []ClimateFanMode   `protobuf:"varint,13,rep,packed,name=supported_fan_modes,json=supportedFanModes,proto3,enum=ClimateFanMode" json:"supported_fan_modes,omitempty"`
-	SupportedSwingModes               []ClimateSwingMode `protobuf:"varint,14,rep,packed,name=supported_swing_modes,json=supportedSwingModes,proto3,enum=ClimateSwingMode" json:"supported_swing_modes,omitempty"`
-	XXX_NoUnkeyedLiteral              struct{}           `json:"-"`
-	XXX_unrecognized                  []byte             `json:"-"`
-	XXX_sizecache                     int32              `json:"-"`
+// ==================== DATETIME DATETIME ====================
+type ListEntitiesDateTimeResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ListEntitiesClimateResponse) Reset()         { *m = ListEntitiesClimateResponse{} }
-func (m *ListEntitiesClimateResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEntitiesClimateResponse) ProtoMessage()    {}
-func (*ListEntitiesClimateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+func (m *ListEntitiesDateTimeResponse) Reset()         { *m = ListEntitiesDateTimeResponse{} }
+func (m *ListEntitiesDateTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesDateTimeResponse) ProtoMessage()    {}
+func (*ListEntitiesDateTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{123}
 }
 
-func (m *ListEntitiesClimateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEntitiesClimateResponse.Unmarshal(m, b)
+func (m *ListEntitiesDateTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesDateTimeResponse.Unmarshal(m, b)
 }
-func (m *ListEntitiesClimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEntitiesClimateResponse.Marshal(b, m, deterministic)
+func (m *ListEntitiesDateTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesDateTimeResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEntitiesClimateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEntitiesClimateResponse.Merge(m, src)
+func (m *ListEntitiesDateTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesDateTimeResponse.Merge(m, src)
 }
-func (m *ListEntitiesClimateResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEntitiesClimateResponse.Size(m)
+func (m *ListEntitiesDateTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesDateTimeResponse.Size(m)
 }
-func (m *ListEntitiesClimateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEntitiesClimateResponse.DiscardUnknown(m)
+func (m *ListEntitiesDateTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesDateTimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEntitiesClimateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEntitiesDateTimeResponse proto.InternalMessageInfo
 
-func (m *ListEntitiesClimateResponse) GetObjectId() string {
+func (m *ListEntitiesDateTimeResponse) GetObjectId() string {
 	if m != nil {
 		return m.ObjectId
 	}
 	return ""
 }
 
-func (m *ListEntitiesClimateResponse) GetKey() uint32 {
+func (m *ListEntitiesDateTimeResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ListEntitiesClimateResponse) GetName() string {
+func (m *ListEntitiesDateTimeResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ListEntitiesClimateResponse) GetUniqueId() string {
+func (m *ListEntitiesDateTimeResponse) GetUniqueId() string {
 	if m != nil {
 		return m.UniqueId
 	}
 	return ""
 }
 
-func (m *ListEntitiesClimateResponse) GetSupportsCurrentTemperature() bool {
+func (m *ListEntitiesDateTimeResponse) GetIcon() string {
 	if m != nil {
-		return m.SupportsCurrentTemperature
+		return m.Icon
 	}
-	return false
+	return ""
 }
 
-func (m *ListEntitiesClimateResponse) GetSupportsTwoPointTargetTemperature() bool {
+func (m *ListEntitiesDateTimeResponse) GetDisabledByDefault() bool {
 	if m != nil {
-		return m.SupportsTwoPointTargetTemperature
+		return m.DisabledByDefault
 	}
 	return false
 }
 
-func (m *ListEntitiesClimateResponse) GetSupportedModes() []ClimateMode {
+func (m *ListEntitiesDateTimeResponse) GetEntityCategory() EntityCategory {
 	if m != nil {
-		return m.SupportedModes
+		return m.EntityCategory
 	}
-	return nil
+	return EntityCategory_ENTITY_CATEGORY_NONE
 }
 
-func (m *ListEntitiesClimateResponse) GetVisualMinTemperature() float32 {
-	if m != nil {
-		return m.VisualMinTemperature
-	}
-	return 0
+type DateTimeStateResponse struct {
+	Key uint32 `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	// If the datetime does not have a valid state yet.
+	// Equivalent to `!obj->has_state()` - inverse logic to make state packets smaller
+	MissingState         bool     `protobuf:"varint,2,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	EpochSeconds         uint32   `protobuf:"fixed32,3,opt,name=epoch_seconds,json=epochSeconds,proto3" json:"epoch_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEntitiesClimateResponse) GetVisualMaxTemperature() float32 {
-	if m != nil {
-		return m.VisualMaxTemperature
-	}
-	return 0
+func (m *DateTimeStateResponse) Reset()         { *m = DateTimeStateResponse{} }
+func (m *DateTimeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*DateTimeStateResponse) ProtoMessage()    {}
+func (*DateTimeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{124}
 }
 
-func (m *ListEntitiesClimateResponse) GetVisualTemperatureStep() float32 {
-	if m != nil {
-		return m.VisualTemperatureStep
-	}
-	return 0
+func (m *DateTimeStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DateTimeStateResponse.Unmarshal(m, b)
 }
-
-func (m *ListEntitiesClimateResponse) GetSupportsAway() bool {
-	if m != nil {
-		return m.SupportsAway
-	}
-	return false
+func (m *DateTimeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DateTimeStateResponse.Marshal(b, m, deterministic)
+}
+func (m *DateTimeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DateTimeStateResponse.Merge(m, src)
+}
+func (m *DateTimeStateResponse) XXX_Size() int {
+	return xxx_messageInfo_DateTimeStateResponse.Size(m)
+}
+func (m *DateTimeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DateTimeStateResponse.DiscardUnknown(m)
 }
 
-func (m *ListEntitiesClimateResponse) GetSupportsAction() bool {
+var xxx_messageInfo_DateTimeStateResponse proto.InternalMessageInfo
+
+func (m *DateTimeStateResponse) GetKey() uint32 {
 	if m != nil {
-		return m.SupportsAction
+		return m.Key
 	}
-	return false
+	return 0
 }
 
-func (m *ListEntitiesClimateResponse) GetSupportedFanModes() []ClimateFanMode {
+func (m *DateTimeStateResponse) GetMissingState() bool {
 	if m != nil {
-		return m.SupportedFanModes
+		return m.MissingState
 	}
-	return nil
+	return false
 }
 
-func (m *ListEntitiesClimateResponse) GetSupportedSwingModes() []ClimateSwingMode {
+func (m *DateTimeStateResponse) GetEpochSeconds() uint32 {
 	if m != nil {
-		return m.SupportedSwingModes
+		return m.EpochSeconds
 	}
-	return nil
+	return 0
 }
 
-type ClimateStateResponse struct {
-	Key                   uint32           `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	Mode                  ClimateMode      `protobuf:"varint,2,opt,name=mode,proto3,enum=ClimateMode" json:"mode,omitempty"`
-	CurrentTemperature    float32          `protobuf:"fixed32,3,opt,name=current_temperature,json=currentTemperature,proto3" json:"current_temperature,omitempty"`
-	TargetTemperature     float32          `protobuf:"fixed32,4,opt,name=target_temperature,json=targetTemperature,proto3" json:"target_temperature,omitempty"`
-	TargetTemperatureLow  float32          `protobuf:"fixed32,5,opt,name=target_temperature_low,json=targetTemperatureLow,proto3" json:"target_temperature_low,omitempty"`
-	TargetTemperatureHigh float32          `protobuf:"fixed32,6,opt,name=target_temperature_high,json=targetTemperatureHigh,proto3" json:"target_temperature_high,omitempty"`
-	Away                  bool             `protobuf:"varint,7,opt,name=away,proto3" json:"away,omitempty"`
-	Action                ClimateAction    `protobuf:"varint,8,opt,name=action,proto3,enum=ClimateAction" json:"action,omitempty"`
-	FanMode               ClimateFanMode   `protobuf:"varint,9,opt,name=fan_mode,json=fanMode,proto3,enum=ClimateFanMode" json:"fan_mode,omitempty"`
-	SwingMode             ClimateSwingMode `protobuf:"varint,10,opt,name=swing_mode,json=swingMode,proto3,enum=ClimateSwingMode" json:"swing_mode,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+type DateTimeCommandRequest struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	EpochSeconds         uint32   `protobuf:"fixed32,2,opt,name=epoch_seconds,json=epochSeconds,proto3" json:"epoch_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClimateStateResponse) Reset()         { *m = ClimateStateResponse{} }
-func (m *ClimateStateResponse) String() string { return proto.CompactTextString(m) }
-func (*ClimateStateResponse) ProtoMessage()    {}
-func (*ClimateStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+func (m *DateTimeCommandRequest) Reset()         { *m = DateTimeCommandRequest{} }
+func (m *DateTimeCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*DateTimeCommandRequest) ProtoMessage()    {}
+func (*DateTimeCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{125}
 }
 
-func (m *ClimateStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClimateStateResponse.Unmarshal(m, b)
+func (m *DateTimeCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DateTimeCommandRequest.Unmarshal(m, b)
 }
-func (m *ClimateStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClimateStateResponse.Marshal(b, m, deterministic)
+func (m *DateTimeCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DateTimeCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *ClimateStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClimateStateResponse.Merge(m, src)
+func (m *DateTimeCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DateTimeCommandRequest.Merge(m, src)
 }
-func (m *ClimateStateResponse) XXX_Size() int {
-	return xxx_messageInfo_ClimateStateResponse.Size(m)
+func (m *DateTimeCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_DateTimeCommandRequest.Size(m)
 }
-func (m *ClimateStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClimateStateResponse.DiscardUnknown(m)
+func (m *DateTimeCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DateTimeCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClimateStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_DateTimeCommandRequest proto.InternalMessageInfo
 
-func (m *ClimateStateResponse) GetKey() uint32 {
+func (m *DateTimeCommandRequest) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ClimateStateResponse) GetMode() ClimateMode {
+func (m *DateTimeCommandRequest) GetEpochSeconds() uint32 {
 	if m != nil {
-		return m.Mode
+		return m.EpochSeconds
 	}
-	return ClimateMode_CLIMATE_MODE_OFF
+	return 0
 }
 
-func (m *ClimateStateResponse) GetCurrentTemperature() float32 {
+type ListEntitiesUpdateResponse struct {
+	ObjectId             string         `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key                  uint32         `protobuf:"fixed32,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueId             string         `protobuf:"bytes,4,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Icon                 string         `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisabledByDefault    bool           `protobuf:"varint,6,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	EntityCategory       EntityCategory `protobuf:"varint,7,opt,name=entity_category,json=entityCategory,proto3,enum=EntityCategory" json:"entity_category,omitempty"`
+	DeviceClass          string         `protobuf:"bytes,8,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListEntitiesUpdateResponse) Reset()         { *m = ListEntitiesUpdateResponse{} }
+func (m *ListEntitiesUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntitiesUpdateResponse) ProtoMessage()    {}
+func (*ListEntitiesUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{126}
+}
+
+func (m *ListEntitiesUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntitiesUpdateResponse.Unmarshal(m, b)
+}
+func (m *ListEntitiesUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntitiesUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntitiesUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesUpdateResponse.Merge(m, src)
+}
+func (m *ListEntitiesUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntitiesUpdateResponse.Size(m)
+}
+func (m *ListEntitiesUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesUpdateResponse proto.InternalMessageInfo
+
+func (m *ListEntitiesUpdateResponse) GetObjectId() string {
 	if m != nil {
-		return m.CurrentTemperature
+		return m.ObjectId
 	}
-	return 0
+	return ""
 }
 
-func (m *ClimateStateResponse) GetTargetTemperature() float32 {
+func (m *ListEntitiesUpdateResponse) GetKey() uint32 {
 	if m != nil {
-		return m.TargetTemperature
+		return m.Key
 	}
 	return 0
 }
 
-func (m *ClimateStateResponse) GetTargetTemperatureLow() float32 {
+func (m *ListEntitiesUpdateResponse) GetName() string {
 	if m != nil {
-		return m.TargetTemperatureLow
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *ClimateStateResponse) GetTargetTemperatureHigh() float32 {
+func (m *ListEntitiesUpdateResponse) GetUniqueId() string {
 	if m != nil {
-		return m.TargetTemperatureHigh
+		return m.UniqueId
 	}
-	return 0
+	return ""
 }
 
-func (m *ClimateStateResponse) GetAway() bool {
+func (m *ListEntitiesUpdateResponse) GetIcon() string {
 	if m != nil {
-		return m.Away
+		return m.Icon
 	}
-	return false
+	return ""
 }
 
-func (m *ClimateStateResponse) GetAction() ClimateAction {
+func (m *ListEntitiesUpdateResponse) GetDisabledByDefault() bool {
 	if m != nil {
-		return m.Action
+		return m.DisabledByDefault
 	}
-	return ClimateAction_CLIMATE_ACTION_OFF
+	return false
 }
 
-func (m *ClimateStateResponse) GetFanMode() ClimateFanMode {
+func (m *ListEntitiesUpdateResponse) GetEntityCategory() EntityCategory {
 	if m != nil {
-		return m.FanMode
+		return m.EntityCategory
 	}
-	return ClimateFanMode_CLIMATE_FAN_ON
+	return EntityCategory_ENTITY_CATEGORY_NONE
 }
 
-func (m *ClimateStateResponse) GetSwingMode() ClimateSwingMode {
+func (m *ListEntitiesUpdateResponse) GetDeviceClass() string {
 	if m != nil {
-		return m.SwingMode
+		return m.DeviceClass
 	}
-	return ClimateSwingMode_CLIMATE_SWING_OFF
+	return ""
 }
 
-type ClimateCommandRequest struct {
-	Key                      uint32           `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
-	HasMode                  bool             `protobuf:"varint,2,opt,name=has_mode,json=hasMode,proto3" json:"has_mode,omitempty"`
-	Mode                     ClimateMode      `protobuf:"varint,3,opt,name=mode,proto3,enum=ClimateMode" json:"mode,omitempty"`
-	HasTargetTemperature     bool             `protobuf:"varint,4,opt,name=has_target_temperature,json=hasTargetTemperature,proto3" json:"has_target_temperature,omitempty"`
-	TargetTemperature        float32          `protobuf:"fixed32,5,opt,name=target_temperature,json=targetTemperature,proto3" json:"target_temperature,omitempty"`
-	HasTargetTemperatureLow  bool             `protobuf:"varint,6,opt,name=has_target_temperature_low,json=hasTargetTemperatureLow,proto3" json:"has_target_temperature_low,omitempty"`
-	TargetTemperatureLow     float32          `protobuf:"fixed32,7,opt,name=target_temperature_low,json=targetTemperatureLow,proto3" json:"target_temperature_low,omitempty"`
-	HasTargetTemperatureHigh bool             `protobuf:"varint,8,opt,name=has_target_temperature_high,json=hasTargetTemperatureHigh,proto3" json:"has_target_temperature_high,omitempty"`
-	TargetTemperatureHigh    float32          `protobuf:"fixed32,9,opt,name=target_temperature_high,json=targetTemperatureHigh,proto3" json:"target_temperature_high,omitempty"`
-	HasAway                  bool             `protobuf:"varint,10,opt,name=has_away,json=hasAway,proto3" json:"has_away,omitempty"`
-	Away                     bool             `protobuf:"varint,11,opt,name=away,proto3" json:"away,omitempty"`
-	HasFanMode               bool             `protobuf:"varint,12,opt,name=has_fan_mode,json=hasFanMode,proto3" json:"has_fan_mode,omitempty"`
-	FanMode                  ClimateFanMode   `protobuf:"varint,13,opt,name=fan_mode,json=fanMode,proto3,enum=ClimateFanMode" json:"fan_mode,omitempty"`
-	HasSwingMode             bool             `protobuf:"varint,14,opt,name=has_swing_mode,json=hasSwingMode,proto3" json:"has_swing_mode,omitempty"`
-	SwingMode                ClimateSwingMode `protobuf:"varint,15,opt,name=swing_mode,json=swingMode,proto3,enum=ClimateSwingMode" json:"swing_mode,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}         `json:"-"`
-	XXX_unrecognized         []byte           `json:"-"`
-	XXX_sizecache            int32            `json:"-"`
+type UpdateStateResponse struct {
+	Key                  uint32   `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	MissingState         bool     `protobuf:"varint,2,opt,name=missing_state,json=missingState,proto3" json:"missing_state,omitempty"`
+	InProgress           bool     `protobuf:"varint,3,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	HasProgress          bool     `protobuf:"varint,4,opt,name=has_progress,json=hasProgress,proto3" json:"has_progress,omitempty"`
+	Progress             float32  `protobuf:"fixed32,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	CurrentVersion       string   `protobuf:"bytes,6,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	LatestVersion        string   `protobuf:"bytes,7,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Title                string   `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseSummary       string   `protobuf:"bytes,9,opt,name=release_summary,json=releaseSummary,proto3" json:"release_summary,omitempty"`
+	ReleaseUrl           string   `protobuf:"bytes,10,opt,name=release_url,json=releaseUrl,proto3" json:"release_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClimateCommandRequest) Reset()         { *m = ClimateCommandRequest{} }
-func (m *ClimateCommandRequest) String() string { return proto.CompactTextString(m) }
-func (*ClimateCommandRequest) ProtoMessage()    {}
-func (*ClimateCommandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+func (m *UpdateStateResponse) Reset()         { *m = UpdateStateResponse{} }
+func (m *UpdateStateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateStateResponse) ProtoMessage()    {}
+func (*UpdateStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{127}
 }
 
-func (m *ClimateCommandRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClimateCommandRequest.Unmarshal(m, b)
+func (m *UpdateStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateStateResponse.Unmarshal(m, b)
 }
-func (m *ClimateCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClimateCommandRequest.Marshal(b, m, deterministic)
+func (m *UpdateStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateStateResponse.Marshal(b, m, deterministic)
 }
-func (m *ClimateCommandRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClimateCommandRequest.Merge(m, src)
+func (m *UpdateStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStateResponse.Merge(m, src)
 }
-func (m *ClimateCommandRequest) XXX_Size() int {
-	return xxx_messageInfo_ClimateCommandRequest.Size(m)
+func (m *UpdateStateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateStateResponse.Size(m)
 }
-func (m *ClimateCommandRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClimateCommandRequest.DiscardUnknown(m)
+func (m *UpdateStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClimateCommandRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateStateResponse proto.InternalMessageInfo
 
-func (m *ClimateCommandRequest) GetKey() uint32 {
+func (m *UpdateStateResponse) GetKey() uint32 {
 	if m != nil {
 		return m.Key
 	}
 	return 0
 }
 
-func (m *ClimateCommandRequest) GetHasMode() bool {
+func (m *UpdateStateResponse) GetMissingState() bool {
 	if m != nil {
-		return m.HasMode
+		return m.MissingState
 	}
 	return false
 }
 
-func (m *ClimateCommandRequest) GetMode() ClimateMode {
+func (m *UpdateStateResponse) GetInProgress() bool {
 	if m != nil {
-		return m.Mode
+		return m.InProgress
 	}
-	return ClimateMode_CLIMATE_MODE_OFF
+	return false
 }
 
-func (m *ClimateCommandRequest) GetHasTargetTemperature() bool {
+func (m *UpdateStateResponse) GetHasProgress() bool {
 	if m != nil {
-		return m.HasTargetTemperature
+		return m.HasProgress
 	}
 	return false
 }
 
-func (m *ClimateCommandRequest) GetTargetTemperature() float32 {
+func (m *UpdateStateResponse) GetProgress() float32 {
 	if m != nil {
-		return m.TargetTemperature
+		return m.Progress
 	}
 	return 0
 }
 
-func (m *ClimateCommandRequest) GetHasTargetTemperatureLow() bool {
+func (m *UpdateStateResponse) GetCurrentVersion() string {
 	if m != nil {
-		return m.HasTargetTemperatureLow
+		return m.CurrentVersion
 	}
-	return false
+	return ""
 }
 
-func (m *ClimateCommandRequest) GetTargetTemperatureLow() float32 {
+func (m *UpdateStateResponse) GetLatestVersion() string {
 	if m != nil {
-		return m.TargetTemperatureLow
+		return m.LatestVersion
 	}
-	return 0
+	return ""
 }
 
-func (m *ClimateCommandRequest) GetHasTargetTemperatureHigh() bool {
+func (m *UpdateStateResponse) GetTitle() string {
 	if m != nil {
-		return m.HasTargetTemperatureHigh
+		return m.Title
 	}
-	return false
+	return ""
 }
 
-func (m *ClimateCommandRequest) GetTargetTemperatureHigh() float32 {
+func (m *UpdateStateResponse) GetReleaseSummary() string {
 	if m != nil {
-		return m.TargetTemperatureHigh
+		return m.ReleaseSummary
 	}
-	return 0
+	return ""
 }
 
-func (m *ClimateCommandRequest) GetHasAway() bool {
+func (m *UpdateStateResponse) GetReleaseUrl() string {
 	if m != nil {
-		return m.HasAway
+		return m.ReleaseUrl
 	}
-	return false
+	return ""
 }
 
-func (m *ClimateCommandRequest) GetAway() bool {
-	if m != nil {
-		return m.Away
-	}
-	return false
+type UpdateCommandRequest struct {
+	Key                  uint32        `protobuf:"fixed32,1,opt,name=key,proto3" json:"key,omitempty"`
+	Command              UpdateCommand `protobuf:"varint,2,opt,name=command,proto3,enum=UpdateCommand" json:"command,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ClimateCommandRequest) GetHasFanMode() bool {
-	if m != nil {
-		return m.HasFanMode
-	}
-	return false
+func (m *UpdateCommandRequest) Reset()         { *m = UpdateCommandRequest{} }
+func (m *UpdateCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCommandRequest) ProtoMessage()    {}
+func (*UpdateCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{128}
 }
 
-func (m *ClimateCommandRequest) GetFanMode() ClimateFanMode {
-	if m != nil {
-		return m.FanMode
-	}
-	return ClimateFanMode_CLIMATE_FAN_ON
+func (m *UpdateCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCommandRequest.Unmarshal(m, b)
+}
+func (m *UpdateCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCommandRequest.Merge(m, src)
+}
+func (m *UpdateCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCommandRequest.Size(m)
+}
+func (m *UpdateCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCommandRequest.DiscardUnknown(m)
 }
 
-func (m *ClimateCommandRequest) GetHasSwingMode() bool {
+var xxx_messageInfo_UpdateCommandRequest proto.InternalMessageInfo
+
+func (m *UpdateCommandRequest) GetKey() uint32 {
 	if m != nil {
-		return m.HasSwingMode
+		return m.Key
 	}
-	return false
+	return 0
 }
 
-func (m *ClimateCommandRequest) GetSwingMode() ClimateSwingMode {
+func (m *UpdateCommandRequest) GetCommand() UpdateCommand {
 	if m != nil {
-		return m.SwingMode
+		return m.Command
 	}
-	return ClimateSwingMode_CLIMATE_SWING_OFF
+	return UpdateCommand_UPDATE_COMMAND_NONE
 }
 
 func init() {
+	proto.RegisterEnum("EntityCategory", EntityCategory_name, EntityCategory_value)
 	proto.RegisterEnum("LegacyCoverState", LegacyCoverState_name, LegacyCoverState_value)
 	proto.RegisterEnum("CoverOperation", CoverOperation_name, CoverOperation_value)
 	proto.RegisterEnum("LegacyCoverCommand", LegacyCoverCommand_name, LegacyCoverCommand_value)
 	proto.RegisterEnum("FanSpeed", FanSpeed_name, FanSpeed_value)
+	proto.RegisterEnum("FanDirection", FanDirection_name, FanDirection_value)
+	proto.RegisterEnum("ColorMode", ColorMode_name, ColorMode_value)
+	proto.RegisterEnum("SensorStateClass", SensorStateClass_name, SensorStateClass_value)
+	proto.RegisterEnum("SensorLastResetType", SensorLastResetType_name, SensorLastResetType_value)
 	proto.RegisterEnum("LogLevel", LogLevel_name, LogLevel_value)
 	proto.RegisterEnum("ServiceArgType", ServiceArgType_name, ServiceArgType_value)
 	proto.RegisterEnum("ClimateMode", ClimateMode_name, ClimateMode_value)
 	proto.RegisterEnum("ClimateFanMode", ClimateFanMode_name, ClimateFanMode_value)
 	proto.RegisterEnum("ClimateSwingMode", ClimateSwingMode_name, ClimateSwingMode_value)
 	proto.RegisterEnum("ClimateAction", ClimateAction_name, ClimateAction_value)
+	proto.RegisterEnum("ClimatePreset", ClimatePreset_name, ClimatePreset_value)
+	proto.RegisterEnum("NumberMode", NumberMode_name, NumberMode_value)
+	proto.RegisterEnum("LockState", LockState_name, LockState_value)
+	proto.RegisterEnum("LockCommand", LockCommand_name, LockCommand_value)
+	proto.RegisterEnum("MediaPlayerState", MediaPlayerState_name, MediaPlayerState_value)
+	proto.RegisterEnum("MediaPlayerCommand", MediaPlayerCommand_name, MediaPlayerCommand_value)
+	proto.RegisterEnum("MediaPlayerFormatPurpose", MediaPlayerFormatPurpose_name, MediaPlayerFormatPurpose_value)
+	proto.RegisterEnum("BluetoothDeviceRequestType", BluetoothDeviceRequestType_name, BluetoothDeviceRequestType_value)
+	proto.RegisterEnum("VoiceAssistantEvent", VoiceAssistantEvent_name, VoiceAssistantEvent_value)
+	proto.RegisterEnum("VoiceAssistantTimerEvent", VoiceAssistantTimerEvent_name, VoiceAssistantTimerEvent_value)
+	proto.RegisterEnum("AlarmControlPanelState", AlarmControlPanelState_name, AlarmControlPanelState_value)
+	proto.RegisterEnum("AlarmControlPanelStateCommand", AlarmControlPanelStateCommand_name, AlarmControlPanelStateCommand_value)
+	proto.RegisterEnum("TextMode", TextMode_name, TextMode_value)
+	proto.RegisterEnum("ValveOperation", ValveOperation_name, ValveOperation_value)
+	proto.RegisterEnum("UpdateCommand", UpdateCommand_name, UpdateCommand_value)
 	proto.RegisterType((*HelloRequest)(nil), "HelloRequest")
 	proto.RegisterType((*HelloResponse)(nil), "HelloResponse")
 	proto.RegisterType((*ConnectRequest)(nil), "ConnectRequest")
@@ -3737,255 +10294,683 @@ func init() {
 	proto.RegisterType((*ListEntitiesClimateResponse)(nil), "ListEntitiesClimateResponse")
 	proto.RegisterType((*ClimateStateResponse)(nil), "ClimateStateResponse")
 	proto.RegisterType((*ClimateCommandRequest)(nil), "ClimateCommandRequest")
+	proto.RegisterType((*ListEntitiesNumberResponse)(nil), "ListEntitiesNumberResponse")
+	proto.RegisterType((*NumberStateResponse)(nil), "NumberStateResponse")
+	proto.RegisterType((*NumberCommandRequest)(nil), "NumberCommandRequest")
+	proto.RegisterType((*ListEntitiesSelectResponse)(nil), "ListEntitiesSelectResponse")
+	proto.RegisterType((*SelectStateResponse)(nil), "SelectStateResponse")
+	proto.RegisterType((*SelectCommandRequest)(nil), "SelectCommandRequest")
+	proto.RegisterType((*ListEntitiesSirenResponse)(nil), "ListEntitiesSirenResponse")
+	proto.RegisterType((*SirenStateResponse)(nil), "SirenStateResponse")
+	proto.RegisterType((*SirenCommandRequest)(nil), "SirenCommandRequest")
+	proto.RegisterType((*ListEntitiesLockResponse)(nil), "ListEntitiesLockResponse")
+	proto.RegisterType((*LockStateResponse)(nil), "LockStateResponse")
+	proto.RegisterType((*LockCommandRequest)(nil), "LockCommandRequest")
+	proto.RegisterType((*ListEntitiesButtonResponse)(nil), "ListEntitiesButtonResponse")
+	proto.RegisterType((*ButtonCommandRequest)(nil), "ButtonCommandRequest")
+	proto.RegisterType((*MediaPlayerSupportedFormat)(nil), "MediaPlayerSupportedFormat")
+	proto.RegisterType((*ListEntitiesMediaPlayerResponse)(nil), "ListEntitiesMediaPlayerResponse")
+	proto.RegisterType((*MediaPlayerStateResponse)(nil), "MediaPlayerStateResponse")
+	proto.RegisterType((*MediaPlayerCommandRequest)(nil), "MediaPlayerCommandRequest")
+	proto.RegisterType((*SubscribeBluetoothLEAdvertisementsRequest)(nil), "SubscribeBluetoothLEAdvertisementsRequest")
+	proto.RegisterType((*BluetoothServiceData)(nil), "BluetoothServiceData")
+	proto.RegisterType((*BluetoothLEAdvertisementResponse)(nil), "BluetoothLEAdvertisementResponse")
+	proto.RegisterType((*BluetoothDeviceRequest)(nil), "BluetoothDeviceRequest")
+	proto.RegisterType((*BluetoothDeviceConnectionResponse)(nil), "BluetoothDeviceConnectionResponse")
+	proto.RegisterType((*BluetoothGATTGetServicesRequest)(nil), "BluetoothGATTGetServicesRequest")
+	proto.RegisterType((*BluetoothGATTDescriptor)(nil), "BluetoothGATTDescriptor")
+	proto.RegisterType((*BluetoothGATTCharacteristic)(nil), "BluetoothGATTCharacteristic")
+	proto.RegisterType((*BluetoothGATTService)(nil), "BluetoothGATTService")
+	proto.RegisterType((*BluetoothGATTGetServicesResponse)(nil), "BluetoothGATTGetServicesResponse")
+	proto.RegisterType((*BluetoothGATTGetServicesDoneResponse)(nil), "BluetoothGATTGetServicesDoneResponse")
+	proto.RegisterType((*BluetoothGATTReadRequest)(nil), "BluetoothGATTReadRequest")
+	proto.RegisterType((*BluetoothGATTReadResponse)(nil), "BluetoothGATTReadResponse")
+	proto.RegisterType((*BluetoothGATTWriteRequest)(nil), "BluetoothGATTWriteRequest")
+	proto.RegisterType((*BluetoothGATTReadDescriptorRequest)(nil), "BluetoothGATTReadDescriptorRequest")
+	proto.RegisterType((*BluetoothGATTWriteDescriptorRequest)(nil), "BluetoothGATTWriteDescriptorRequest")
+	proto.RegisterType((*BluetoothGATTNotifyRequest)(nil), "BluetoothGATTNotifyRequest")
+	proto.RegisterType((*BluetoothGATTNotifyDataResponse)(nil), "BluetoothGATTNotifyDataResponse")
+	proto.RegisterType((*SubscribeBluetoothConnectionsFreeRequest)(nil), "SubscribeBluetoothConnectionsFreeRequest")
+	proto.RegisterType((*BluetoothConnectionsFreeResponse)(nil), "BluetoothConnectionsFreeResponse")
+	proto.RegisterType((*BluetoothGATTErrorResponse)(nil), "BluetoothGATTErrorResponse")
+	proto.RegisterType((*BluetoothGATTWriteResponse)(nil), "BluetoothGATTWriteResponse")
+	proto.RegisterType((*BluetoothGATTNotifyResponse)(nil), "BluetoothGATTNotifyResponse")
+	proto.RegisterType((*BluetoothDevicePairingResponse)(nil), "BluetoothDevicePairingResponse")
+	proto.RegisterType((*BluetoothDeviceUnpairingResponse)(nil), "BluetoothDeviceUnpairingResponse")
+	proto.RegisterType((*UnsubscribeBluetoothLEAdvertisementsRequest)(nil), "UnsubscribeBluetoothLEAdvertisementsRequest")
+	proto.RegisterType((*BluetoothDeviceClearCacheResponse)(nil), "BluetoothDeviceClearCacheResponse")
+	proto.RegisterType((*BluetoothLERawAdvertisement)(nil), "BluetoothLERawAdvertisement")
+	proto.RegisterType((*BluetoothLERawAdvertisementsResponse)(nil), "BluetoothLERawAdvertisementsResponse")
+	proto.RegisterType((*SubscribeVoiceAssistantRequest)(nil), "SubscribeVoiceAssistantRequest")
+	proto.RegisterType((*VoiceAssistantAudioSettings)(nil), "VoiceAssistantAudioSettings")
+	proto.RegisterType((*VoiceAssistantRequest)(nil), "VoiceAssistantRequest")
+	proto.RegisterType((*VoiceAssistantResponse)(nil), "VoiceAssistantResponse")
+	proto.RegisterType((*VoiceAssistantEventData)(nil), "VoiceAssistantEventData")
+	proto.RegisterType((*VoiceAssistantEventResponse)(nil), "VoiceAssistantEventResponse")
+	proto.RegisterType((*VoiceAssistantAudio)(nil), "VoiceAssistantAudio")
+	proto.RegisterType((*VoiceAssistantTimerEventResponse)(nil), "VoiceAssistantTimerEventResponse")
+	proto.RegisterType((*ListEntitiesAlarmControlPanelResponse)(nil), "ListEntitiesAlarmControlPanelResponse")
+	proto.RegisterType((*AlarmControlPanelStateResponse)(nil), "AlarmControlPanelStateResponse")
+	proto.RegisterType((*AlarmControlPanelCommandRequest)(nil), "AlarmControlPanelCommandRequest")
+	proto.RegisterType((*ListEntitiesTextResponse)(nil), "ListEntitiesTextResponse")
+	proto.RegisterType((*TextStateResponse)(nil), "TextStateResponse")
+	proto.RegisterType((*TextCommandRequest)(nil), "TextCommandRequest")
+	proto.RegisterType((*ListEntitiesDateResponse)(nil), "ListEntitiesDateResponse")
+	proto.RegisterType((*DateStateResponse)(nil), "DateStateResponse")
+	proto.RegisterType((*DateCommandRequest)(nil), "DateCommandRequest")
+	proto.RegisterType((*ListEntitiesTimeResponse)(nil), "ListEntitiesTimeResponse")
+	proto.RegisterType((*TimeStateResponse)(nil), "TimeStateResponse")
+	proto.RegisterType((*TimeCommandRequest)(nil), "TimeCommandRequest")
+	proto.RegisterType((*ListEntitiesEventResponse)(nil), "ListEntitiesEventResponse")
+	proto.RegisterType((*EventResponse)(nil), "EventResponse")
+	proto.RegisterType((*ListEntitiesValveResponse)(nil), "ListEntitiesValveResponse")
+	proto.RegisterType((*ValveStateResponse)(nil), "ValveStateResponse")
+	proto.RegisterType((*ValveCommandRequest)(nil), "ValveCommandRequest")
+	proto.RegisterType((*ListEntitiesDateTimeResponse)(nil), "ListEntitiesDateTimeResponse")
+	proto.RegisterType((*DateTimeStateResponse)(nil), "DateTimeStateResponse")
+	proto.RegisterType((*DateTimeCommandRequest)(nil), "DateTimeCommandRequest")
+	proto.RegisterType((*ListEntitiesUpdateResponse)(nil), "ListEntitiesUpdateResponse")
+	proto.RegisterType((*UpdateStateResponse)(nil), "UpdateStateResponse")
+	proto.RegisterType((*UpdateCommandRequest)(nil), "UpdateCommandRequest")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 3917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0x23, 0xc9,
-	0x75, 0x17, 0x3f, 0x45, 0x3e, 0x4a, 0x64, 0xb3, 0xf4, 0xc5, 0x91, 0xe6, 0x43, 0xc3, 0xd9, 0xd9,
-	0x1d, 0x6b, 0x77, 0xb9, 0xeb, 0xf1, 0xd8, 0x01, 0x64, 0x2f, 0xd2, 0x1c, 0x8a, 0x92, 0x18, 0x50,
-	0xa2, 0xd0, 0xe4, 0xcc, 0x64, 0x7c, 0xe9, 0xb4, 0xc8, 0x12, 0xd9, 0x1b, 0xb2, 0x9b, 0xee, 0x6e,
-	0x4a, 0xa3, 0xe4, 0x10, 0x03, 0x0e, 0x1c, 0x20, 0xc7, 0xf8, 0x10, 0x04, 0x39, 0x1a, 0x39, 0x24,
-	0x06, 0xf2, 0x87, 0x24, 0xff, 0x40, 0x90, 0x13, 0x73, 0x30, 0xa0, 0x63, 0x90, 0x43, 0xb0, 0x47,
-	0xe3, 0x55, 0x55, 0x37, 0xab, 0xc9, 0xd6, 0xcc, 0xee, 0x00, 0x9e, 0x3d, 0x91, 0xfd, 0xbe, 0xaa,
-	0xde, 0xef, 0xbd, 0x7a, 0x55, 0xf5, 0xba, 0x21, 0x6b, 0x8c, 0xcd, 0xca, 0xd8, 0xb1, 0x3d, 0x7b,
-	0xbb, 0x68, 0x8c, 0x4d, 0xdd, 0x1e, 0x7b, 0xa6, 0x6d, 0xb9, 0x9c, 0x54, 0xde, 0x87, 0x95, 0x63,
-	0x3a, 0x1c, 0xda, 0x1a, 0xfd, 0xc5, 0x84, 0xba, 0x1e, 0x79, 0x00, 0xb9, 0xee, 0xd0, 0xa4, 0x96,
-	0xa7, 0x9b, 0xd6, 0x85, 0x5d, 0x8a, 0xed, 0xc6, 0x9e, 0x64, 0x35, 0xe0, 0xa4, 0x86, 0x75, 0x61,
-	0xef, 0x67, 0xa7, 0x6a, 0xec, 0x46, 0x8d, 0xff, 0xb2, 0x1a, 0x2b, 0xff, 0x26, 0x06, 0xab, 0x42,
-	0xd9, 0x1d, 0xdb, 0x96, 0x4b, 0xc9, 0x1e, 0xb0, 0x21, 0x2e, 0xa9, 0xe3, 0x9a, 0xb6, 0xa5, 0x8f,
-	0x8c, 0xaf, 0x6d, 0x87, 0xd9, 0x58, 0xd5, 0x0a, 0xc6, 0xd8, 0x7c, 0xc9, 0xe9, 0x27, 0x48, 0x5e,
-	0x90, 0x35, 0x2d, 0xdb, 0x29, 0xc5, 0x17, 0x64, 0x91, 0x8c, 0xb3, 0x72, 0xa9, 0x73, 0x49, 0x1d,
-	0x3e, 0xab, 0x04, 0x9f, 0x15, 0x27, 0xf9, 0xb3, 0x8a, 0xdf, 0xa8, 0x31, 0x9c, 0xd5, 0x9f, 0x40,
-	0xbe, 0x66, 0x5b, 0x16, 0xed, 0x7a, 0xbe, 0x4f, 0xdb, 0x90, 0x19, 0x1b, 0xae, 0x7b, 0x65, 0x3b,
-	0x3d, 0xe1, 0x50, 0xf0, 0x8c, 0x8a, 0x09, 0xe1, 0xce, 0x11, 0x14, 0x02, 0x45, 0xe1, 0xcf, 0x0f,
-	0x40, 0x31, 0xad, 0x4b, 0x63, 0x68, 0xf6, 0xf4, 0x90, 0x85, 0x8c, 0x56, 0x10, 0xf4, 0x33, 0xc9,
-	0x50, 0x52, 0xcc, 0xe0, 0x3e, 0x14, 0x0f, 0x4c, 0xb7, 0x1b, 0x9a, 0x04, 0xf2, 0x53, 0x37, 0xea,
-	0x12, 0xf2, 0x1f, 0x00, 0x91, 0xf9, 0x7c, 0x2c, 0x14, 0x48, 0x0b, 0x81, 0x0d, 0xc8, 0x9d, 0x99,
-	0x56, 0xdf, 0x57, 0x4d, 0x4f, 0xd5, 0xe5, 0x1b, 0x75, 0xa9, 0xbc, 0x09, 0x2b, 0x9c, 0x2c, 0x34,
-	0xd2, 0x53, 0x35, 0x83, 0xf4, 0x1d, 0x28, 0x1e, 0xd0, 0x4b, 0xb3, 0x4b, 0x11, 0x0a, 0x49, 0x29,
-	0x7b, 0xa3, 0xc6, 0xcb, 0xbf, 0x8e, 0x03, 0x91, 0xb9, 0xc2, 0xb3, 0x47, 0xb0, 0x3a, 0x71, 0xa9,
-	0x3b, 0xef, 0xd6, 0x0a, 0x12, 0x7d, 0x9f, 0x08, 0x81, 0xa4, 0x65, 0x8c, 0x28, 0x8b, 0x4a, 0x56,
-	0x63, 0xff, 0x31, 0x14, 0x23, 0xa3, 0xab, 0x1b, 0xbd, 0x9e, 0x43, 0x5d, 0xd7, 0x0f, 0xc5, 0xc8,
-	0xe8, 0x56, 0x39, 0x85, 0x7c, 0x02, 0x05, 0xea, 0x8e, 0x07, 0xf6, 0x88, 0xfa, 0xb1, 0x2d, 0x25,
-	0x99, 0x50, 0x5e, 0x90, 0x45, 0x64, 0x11, 0xdc, 0xae, 0x3d, 0x1a, 0x9b, 0x43, 0x03, 0x13, 0x52,
-	0xf7, 0xcc, 0x11, 0x2d, 0xa5, 0x98, 0x64, 0x41, 0xa2, 0x77, 0xcc, 0x11, 0x25, 0xeb, 0x90, 0x1a,
-	0xd9, 0x3d, 0x3a, 0x2c, 0xa5, 0x19, 0x9f, 0x3f, 0x90, 0x8f, 0x20, 0x3f, 0x30, 0x5c, 0xbd, 0x47,
-	0xe9, 0x58, 0x77, 0x87, 0x94, 0x8e, 0x4b, 0xcb, 0xdc, 0x89, 0x81, 0xe1, 0x1e, 0x50, 0x3a, 0x6e,
-	0x23, 0x0d, 0x81, 0x80, 0x1b, 0x35, 0x56, 0xbe, 0x07, 0x6b, 0x4d, 0xd3, 0xf5, 0xea, 0x96, 0x67,
-	0x7a, 0x26, 0x75, 0x25, 0x9c, 0x72, 0x88, 0xd3, 0x63, 0x28, 0xc9, 0xec, 0x03, 0xdb, 0xa2, 0x72,
-	0x68, 0xd6, 0x44, 0x6c, 0x77, 0x61, 0xb3, 0x3d, 0x39, 0x77, 0xbb, 0x8e, 0x79, 0x4e, 0xdb, 0x9e,
-	0xe1, 0x85, 0x0c, 0xad, 0xa3, 0xa1, 0x6f, 0x62, 0xb0, 0x2b, 0x5b, 0x7a, 0x6e, 0x5a, 0x86, 0x73,
-	0xdd, 0xa6, 0x96, 0x6b, 0x3b, 0x01, 0xfc, 0x3b, 0x90, 0xb5, 0xcf, 0xbf, 0xa6, 0x5d, 0x4f, 0x37,
-	0x83, 0x9c, 0xe4, 0x84, 0x46, 0x8f, 0x28, 0x90, 0xf8, 0x4b, 0x7a, 0xcd, 0x50, 0x5f, 0xd6, 0xf0,
-	0x6f, 0x10, 0x88, 0x84, 0x14, 0x88, 0x1d, 0xc8, 0x4e, 0x2c, 0xf3, 0x17, 0x13, 0x8a, 0x26, 0x38,
-	0xc2, 0x19, 0x4e, 0x68, 0xf4, 0xc8, 0x43, 0x58, 0xe9, 0xb1, 0xa0, 0xeb, 0xdd, 0xa1, 0xe1, 0xba,
-	0x02, 0xd7, 0x1c, 0xa7, 0xd5, 0x90, 0x44, 0x7e, 0x0c, 0x5b, 0xa6, 0xab, 0xbb, 0x9e, 0xe1, 0x4d,
-	0x5c, 0xfd, 0x9c, 0x4d, 0x52, 0x77, 0xd9, 0x2c, 0x19, 0xca, 0x19, 0x6d, 0xdd, 0x74, 0xdb, 0x8c,
-	0x2b, 0x7b, 0xb0, 0xbf, 0x3d, 0x55, 0x57, 0x6e, 0xd4, 0xd8, 0xff, 0xa9, 0xc5, 0x17, 0xed, 0xba,
-	0xfe, 0xbc, 0x71, 0x5a, 0xd5, 0x5e, 0xeb, 0xed, 0xfa, 0x69, 0xbb, 0xa5, 0x95, 0xff, 0x2e, 0x06,
-	0x77, 0x64, 0x61, 0x06, 0x50, 0xe0, 0xb3, 0x70, 0x2b, 0x36, 0x73, 0x6b, 0x1d, 0x52, 0x38, 0x3e,
-	0x4f, 0xb0, 0x8c, 0xc6, 0x1f, 0x30, 0x35, 0x47, 0xa6, 0xeb, 0x9a, 0x56, 0x5f, 0xe7, 0xdc, 0x04,
-	0x8f, 0xaa, 0x20, 0x32, 0xa3, 0xfb, 0xf7, 0xa6, 0xea, 0xc6, 0x2d, 0xd3, 0xc0, 0x30, 0xfd, 0x6b,
-	0x1c, 0xee, 0xc8, 0x41, 0xa8, 0xd9, 0x97, 0xf4, 0xc3, 0xa1, 0xff, 0x08, 0x56, 0x0d, 0xd7, 0x9d,
-	0x8c, 0x68, 0x4f, 0x78, 0x90, 0xe2, 0x1e, 0x08, 0x22, 0xf3, 0x80, 0x7c, 0x0a, 0x45, 0x77, 0x32,
-	0x1e, 0xdb, 0x8e, 0xe7, 0xea, 0x63, 0xdb, 0x35, 0x31, 0xd9, 0x05, 0xf2, 0x8a, 0xcf, 0x38, 0x13,
-	0x74, 0xb4, 0x18, 0x08, 0x7b, 0xe6, 0xd0, 0xf3, 0x33, 0xdd, 0x27, 0x76, 0xcc, 0xa1, 0xb7, 0x10,
-	0xf4, 0xcc, 0x42, 0xd0, 0xf7, 0xc9, 0x54, 0x5d, 0x65, 0xb0, 0x65, 0x11, 0xb6, 0x5a, 0xeb, 0x65,
-	0x5d, 0x2b, 0xff, 0x4f, 0x0c, 0x08, 0xc3, 0xe7, 0x5d, 0xe1, 0x7a, 0x06, 0x2b, 0x43, 0xda, 0x37,
-	0xba, 0xd7, 0xfa, 0x2c, 0x6a, 0xf9, 0xa7, 0xc5, 0x4a, 0x93, 0x11, 0x25, 0x13, 0x39, 0x2e, 0xc6,
-	0xfd, 0xc4, 0xea, 0xeb, 0xbb, 0x87, 0x08, 0xc6, 0xb5, 0xe0, 0x19, 0x91, 0x65, 0xde, 0x24, 0x19,
-	0x9d, 0xfd, 0x27, 0x3f, 0x83, 0x62, 0x77, 0xe2, 0x38, 0xb8, 0x05, 0xd9, 0x63, 0xea, 0xb0, 0x22,
-	0xc0, 0x00, 0xcc, 0x3f, 0x2d, 0x54, 0xd8, 0x20, 0x2d, 0x9f, 0xac, 0x29, 0x42, 0x32, 0xa0, 0xec,
-	0x6f, 0x4c, 0xd5, 0xcd, 0x39, 0x07, 0x31, 0x1f, 0xfe, 0x2d, 0x0e, 0x6b, 0x4c, 0xb7, 0x66, 0x8f,
-	0x46, 0x86, 0xd5, 0xf3, 0xb7, 0x86, 0x45, 0x27, 0x3f, 0x03, 0x82, 0x45, 0x45, 0x38, 0xda, 0xe5,
-	0xe2, 0x22, 0x41, 0x95, 0x81, 0xe1, 0xfa, 0xce, 0x32, 0x3a, 0xd9, 0x87, 0xfc, 0x9c, 0x64, 0x82,
-	0xcd, 0x74, 0x4d, 0x06, 0xc5, 0x1f, 0x73, 0x75, 0x18, 0xd2, 0x7d, 0x08, 0x58, 0xa8, 0x66, 0xb1,
-	0x4f, 0xb2, 0x31, 0x72, 0x03, 0x63, 0x16, 0x76, 0x19, 0xbb, 0xd4, 0x1c, 0x76, 0x77, 0x20, 0x83,
-	0xea, 0x0c, 0x3f, 0x9e, 0x36, 0xcb, 0x03, 0x83, 0x27, 0x82, 0x0f, 0xeb, 0xb2, 0x04, 0x2b, 0x81,
-	0xa4, 0xeb, 0xd9, 0x63, 0x96, 0x14, 0x19, 0x8d, 0xfd, 0x47, 0xb0, 0xee, 0xdf, 0xa8, 0xf1, 0x79,
-	0xb0, 0x7e, 0x1f, 0x83, 0x2d, 0x79, 0xf1, 0x1c, 0x1a, 0xd6, 0x07, 0x5b, 0x3a, 0x3f, 0x84, 0xf5,
-	0x20, 0xd1, 0x6d, 0xb7, 0x6b, 0x0e, 0x87, 0xb3, 0x04, 0xc8, 0x68, 0x6b, 0x3e, 0xaf, 0x35, 0x63,
-	0x91, 0xc7, 0x90, 0x0f, 0x54, 0xdc, 0x31, 0xa5, 0x3d, 0x01, 0x47, 0xb0, 0x62, 0xda, 0x48, 0xdc,
-	0x57, 0xa6, 0x6a, 0x9e, 0x65, 0xc6, 0x32, 0x3a, 0x7b, 0x58, 0x3d, 0x2d, 0xff, 0x43, 0x0c, 0x94,
-	0x43, 0xc3, 0x7a, 0xbf, 0x2a, 0xb5, 0x0b, 0xb9, 0x60, 0x7e, 0x56, 0x5f, 0xd4, 0x28, 0x99, 0x44,
-	0x1e, 0x40, 0x8a, 0x4f, 0x27, 0xc9, 0x52, 0x22, 0x5b, 0xc1, 0xb1, 0x90, 0xa0, 0x71, 0xfa, 0xfe,
-	0xda, 0x54, 0xdd, 0x0a, 0xcd, 0x08, 0xc1, 0xff, 0xff, 0x18, 0x14, 0x0f, 0x0d, 0xeb, 0x9d, 0x79,
-	0xba, 0x03, 0x59, 0x0c, 0xbf, 0x3c, 0x33, 0xcc, 0x07, 0xbe, 0xe6, 0x82, 0x29, 0x27, 0xe4, 0x29,
-	0xfb, 0x2a, 0xc1, 0xa4, 0x84, 0x0a, 0x3e, 0xcf, 0x66, 0x9b, 0x8a, 0x9e, 0x2d, 0xee, 0xeb, 0xa8,
-	0x2d, 0x3b, 0xcd, 0x71, 0xc6, 0x4d, 0xb8, 0x25, 0xf9, 0x3d, 0x87, 0xcc, 0xf2, 0x02, 0x32, 0xe8,
-	0xf8, 0x03, 0x96, 0x77, 0xb2, 0xe3, 0xbf, 0x4b, 0x84, 0x4b, 0x76, 0xd3, 0xec, 0x0f, 0xbc, 0x0f,
-	0x96, 0x77, 0x5f, 0x40, 0x90, 0x5b, 0xfa, 0xb9, 0x83, 0x43, 0x5b, 0x54, 0xec, 0x9b, 0x19, 0x8d,
-	0xf8, 0xac, 0xe7, 0x01, 0x07, 0x57, 0x6f, 0xa0, 0xe0, 0xf4, 0xcf, 0x05, 0x16, 0x39, 0x9f, 0xa6,
-	0xf5, 0xcf, 0xc9, 0x97, 0x52, 0x2e, 0x5f, 0x0d, 0x4c, 0x8f, 0xea, 0x97, 0xc6, 0x70, 0x42, 0x05,
-	0x22, 0x81, 0xd1, 0x57, 0xc8, 0x7a, 0x89, 0x1c, 0xf2, 0x33, 0xd8, 0x0e, 0x34, 0xba, 0xf6, 0xd0,
-	0x76, 0x74, 0x8f, 0x8e, 0x58, 0x6d, 0x9b, 0x38, 0x54, 0x2c, 0xdd, 0x92, 0x2f, 0x51, 0x43, 0x81,
-	0xce, 0x8c, 0x4f, 0xee, 0x01, 0x8c, 0x4c, 0x3c, 0x49, 0x3b, 0xb4, 0xe7, 0x96, 0xb2, 0x6c, 0xf1,
-	0x67, 0x47, 0xa6, 0x75, 0xc2, 0x08, 0x8c, 0x6d, 0xbc, 0xf1, 0xd9, 0x20, 0xd8, 0xc6, 0x1b, 0xc1,
-	0x2e, 0xc1, 0x32, 0xbd, 0xb8, 0xa0, 0x5d, 0xcf, 0x2d, 0xe5, 0x76, 0x13, 0x4f, 0xb2, 0x9a, 0xff,
-	0x88, 0x9b, 0x46, 0x61, 0x56, 0x53, 0x9b, 0x8d, 0xa3, 0xe3, 0x4e, 0xf9, 0xef, 0xe3, 0x40, 0x58,
-	0x84, 0xde, 0x6f, 0xf5, 0xdc, 0x07, 0x90, 0x50, 0xe6, 0xdb, 0x82, 0x44, 0x41, 0x3b, 0x8e, 0x48,
-	0xd2, 0xb8, 0x86, 0x7f, 0xd1, 0x4e, 0xdf, 0xa1, 0xd4, 0xaf, 0x83, 0xfc, 0x01, 0xe3, 0x7c, 0x8e,
-	0x90, 0xa6, 0x79, 0xa5, 0xc3, 0xff, 0x28, 0xc9, 0xd0, 0x16, 0xe5, 0x8f, 0x3f, 0xe0, 0x76, 0x1b,
-	0x8d, 0x68, 0x5c, 0x53, 0xba, 0xf3, 0x48, 0x6e, 0x42, 0x9a, 0x3b, 0xcf, 0x50, 0xcc, 0x6a, 0xe2,
-	0x09, 0x0b, 0x66, 0x69, 0x0e, 0x09, 0x4c, 0xdd, 0x5f, 0xa7, 0xf0, 0x6c, 0xd9, 0x1f, 0x78, 0x7f,
-	0x8c, 0x55, 0xfb, 0x98, 0x9f, 0x72, 0x25, 0xb8, 0xf8, 0xd2, 0x5d, 0x1d, 0x18, 0x72, 0x3e, 0x86,
-	0x11, 0x4d, 0x2d, 0x20, 0xba, 0x05, 0xb8, 0x3d, 0x48, 0xa9, 0x9a, 0x1e, 0x18, 0x2c, 0x4b, 0x05,
-	0xd4, 0xcb, 0x11, 0x50, 0x67, 0xa2, 0xa0, 0xce, 0x4a, 0x50, 0x0b, 0x77, 0x38, 0xdc, 0x10, 0xb8,
-	0xc3, 0x32, 0x7a, 0x16, 0x87, 0x9c, 0x1c, 0x87, 0xa7, 0xb0, 0x81, 0x2a, 0x8b, 0xb1, 0x58, 0xe1,
-	0x15, 0x7e, 0x60, 0x2c, 0x26, 0x76, 0x64, 0xec, 0x56, 0x6f, 0x89, 0x9d, 0x18, 0xc0, 0x73, 0x0c,
-	0x8b, 0xef, 0x94, 0xfa, 0x90, 0x5a, 0x7d, 0x6f, 0x50, 0xca, 0x07, 0x03, 0x74, 0x02, 0x5e, 0x93,
-	0xb1, 0x70, 0x80, 0x45, 0xf9, 0x02, 0xbb, 0x8b, 0x2a, 0xde, 0xbc, 0xf0, 0x13, 0xc0, 0x73, 0x80,
-	0x7e, 0x31, 0x34, 0xdc, 0x81, 0x2f, 0xab, 0x04, 0x95, 0xf0, 0x10, 0xc9, 0x42, 0xf2, 0x21, 0xac,
-	0x84, 0xa4, 0x8a, 0xcc, 0x62, 0xee, 0x42, 0x12, 0xb9, 0x07, 0x80, 0xc6, 0x44, 0xb6, 0x11, 0x66,
-	0x06, 0x31, 0xad, 0x33, 0x82, 0x94, 0x88, 0x6b, 0xf3, 0x89, 0xb8, 0x3b, 0xdb, 0xb9, 0x83, 0x44,
-	0xfc, 0x97, 0x38, 0x6c, 0xcb, 0x35, 0xf4, 0x03, 0xdf, 0x3a, 0x08, 0x24, 0xcd, 0xae, 0xd8, 0xac,
-	0xb3, 0x1a, 0xfb, 0x4f, 0x2a, 0xb0, 0x36, 0xb1, 0x4c, 0x4f, 0xb7, 0x2f, 0xf4, 0x11, 0x35, 0xdc,
-	0x89, 0x43, 0x47, 0xd4, 0xf2, 0xc4, 0x45, 0xae, 0x88, 0xac, 0xd6, 0xc5, 0xc9, 0x8c, 0x81, 0xa1,
-	0x30, 0xba, 0xdd, 0x89, 0x83, 0x67, 0xaa, 0x1e, 0xed, 0x9a, 0x23, 0x63, 0xe8, 0xb2, 0xe4, 0x4c,
-	0x69, 0x8a, 0xcf, 0x38, 0x10, 0x74, 0x06, 0xb0, 0xed, 0x74, 0xa9, 0x3e, 0x19, 0xf7, 0x70, 0xe1,
-	0xf0, 0x0a, 0x99, 0x63, 0xb4, 0x17, 0x8c, 0x84, 0x7b, 0x8d, 0xc2, 0x96, 0x2c, 0x20, 0x52, 0xe2,
-	0xa2, 0xf2, 0x57, 0xb0, 0xf6, 0x1e, 0x37, 0x94, 0xf8, 0x77, 0xba, 0xa1, 0x6c, 0x4e, 0xd5, 0x3b,
-	0xf3, 0x03, 0x63, 0x8c, 0xfe, 0x23, 0x36, 0x17, 0xa3, 0x2b, 0xd3, 0xeb, 0x0e, 0xbe, 0xd7, 0x18,
-	0x2d, 0xdc, 0x57, 0xd2, 0x8b, 0xf7, 0x15, 0x04, 0xb2, 0x28, 0xf9, 0xf3, 0xaa, 0xd1, 0xa9, 0x1d,
-	0x97, 0x5f, 0xc0, 0x1a, 0x9f, 0xff, 0x7b, 0x6d, 0x03, 0x88, 0xd1, 0xf6, 0xbc, 0x4d, 0xc4, 0xe8,
-	0x25, 0xac, 0x73, 0xb3, 0xef, 0x2c, 0xa8, 0xb7, 0xda, 0x7d, 0xc8, 0x96, 0xc7, 0x9c, 0xdd, 0x7f,
-	0x8f, 0xc1, 0x7d, 0x19, 0xfb, 0x0e, 0x7d, 0xe3, 0x7d, 0xff, 0x6b, 0x64, 0xbf, 0x34, 0x55, 0x09,
-	0x83, 0xa1, 0x80, 0xd3, 0xed, 0xd4, 0xff, 0xbc, 0xe3, 0x27, 0xea, 0xaf, 0x62, 0xb0, 0x35, 0x9b,
-	0xe4, 0x77, 0x02, 0x39, 0xfb, 0x9d, 0xb2, 0x75, 0x67, 0xaa, 0xee, 0x44, 0x4e, 0x01, 0x61, 0xfb,
-	0x0b, 0x58, 0x0f, 0x9a, 0x1e, 0x4d, 0xbb, 0xef, 0xce, 0x9a, 0x85, 0xa9, 0x21, 0xbd, 0xa4, 0x43,
-	0x36, 0x07, 0x3c, 0x33, 0x36, 0xed, 0x7e, 0x13, 0x09, 0x1a, 0xa7, 0x93, 0x07, 0x90, 0xeb, 0x4d,
-	0x46, 0x63, 0xbd, 0x6b, 0x5b, 0x17, 0x66, 0x5f, 0xc4, 0x08, 0x90, 0x54, 0x63, 0x94, 0xfd, 0xf4,
-	0x54, 0xbd, 0x7b, 0xa3, 0xc6, 0xcb, 0xbf, 0x89, 0xc1, 0xc6, 0xdc, 0x10, 0xc2, 0xcb, 0x77, 0x8e,
-	0xa1, 0x40, 0xc2, 0x33, 0xfa, 0xc2, 0x65, 0xfc, 0x8b, 0x27, 0x99, 0x11, 0x75, 0x5d, 0xa3, 0xef,
-	0x87, 0xc5, 0x7f, 0xe4, 0x7d, 0x44, 0xab, 0xa7, 0x5f, 0x18, 0xe6, 0x30, 0x38, 0x03, 0x03, 0x92,
-	0x0e, 0x19, 0x65, 0x7f, 0x65, 0xaa, 0xde, 0xbb, 0x51, 0x63, 0xdf, 0xa8, 0x4b, 0xbf, 0xac, 0x2e,
-	0x95, 0xbf, 0x80, 0xc7, 0xc1, 0xa4, 0x8e, 0x6d, 0x2c, 0x5f, 0xae, 0xe9, 0x7a, 0x86, 0xe5, 0xb5,
-	0xa9, 0x83, 0x77, 0x6a, 0xb9, 0xf7, 0x53, 0x46, 0x37, 0xaa, 0xb0, 0x15, 0x25, 0x77, 0x62, 0x8c,
-	0xe5, 0x68, 0x65, 0x83, 0x68, 0xf1, 0xf3, 0xa0, 0x88, 0x16, 0x7b, 0x28, 0xff, 0x2a, 0x0e, 0x77,
-	0xa3, 0x6c, 0x04, 0x80, 0x94, 0x60, 0xd9, 0xe5, 0x24, 0x61, 0xcc, 0x7f, 0x24, 0x9f, 0x41, 0xb2,
-	0x67, 0x78, 0x46, 0x29, 0xbe, 0x9b, 0x78, 0x92, 0x7b, 0x5a, 0xaa, 0xdc, 0x32, 0x15, 0x8d, 0x49,
-	0x91, 0xaf, 0x60, 0x15, 0x7f, 0xd9, 0x9e, 0x3a, 0xe4, 0x69, 0xf1, 0x76, 0xb5, 0x15, 0x14, 0xef,
-	0x08, 0x69, 0xf2, 0x13, 0xc8, 0x5e, 0x1a, 0x8e, 0x69, 0x9c, 0x0f, 0x29, 0x9e, 0x48, 0xde, 0xae,
-	0x3a, 0x13, 0xc5, 0x6b, 0xab, 0xe9, 0xea, 0xf4, 0x12, 0x37, 0x01, 0x7e, 0xba, 0x5e, 0x36, 0xdd,
-	0x3a, 0x3e, 0xee, 0x67, 0xa7, 0xea, 0x23, 0xd1, 0x66, 0xfb, 0x1c, 0x1e, 0x85, 0x90, 0xaf, 0x06,
-	0x46, 0xe7, 0x7b, 0x6e, 0x1f, 0x23, 0xee, 0x7f, 0xf6, 0x56, 0x71, 0x79, 0x6d, 0x53, 0x5c, 0xf9,
-	0xd7, 0xd2, 0xda, 0xe6, 0x84, 0x46, 0x0f, 0x6d, 0x7d, 0x72, 0xa3, 0xc6, 0xca, 0x3f, 0x87, 0xed,
-	0xf7, 0x34, 0x11, 0xbd, 0xfe, 0xd0, 0xad, 0x27, 0xa2, 0xc5, 0x5c, 0x82, 0xfc, 0x11, 0xf5, 0x3a,
-	0xe6, 0x88, 0x4a, 0x1e, 0x7c, 0x74, 0xa3, 0x2e, 0x95, 0xab, 0x50, 0x08, 0x38, 0xb3, 0x16, 0x2d,
-	0x1d, 0xdb, 0xdd, 0x81, 0xee, 0xd2, 0xae, 0x6d, 0xf5, 0x5c, 0xb1, 0xd2, 0x57, 0x18, 0xb1, 0xcd,
-	0x69, 0x68, 0xfc, 0xb1, 0xe8, 0x1a, 0xbf, 0x82, 0xbb, 0xe1, 0xbd, 0x9f, 0xe7, 0x68, 0xd5, 0xe9,
-	0x4f, 0xd8, 0xce, 0xea, 0x97, 0xaa, 0x98, 0x54, 0xaa, 0x1e, 0x41, 0xd2, 0xbb, 0x1e, 0xfb, 0xad,
-	0x9c, 0x42, 0x45, 0x28, 0x55, 0x9d, 0x7e, 0xe7, 0x7a, 0x4c, 0x35, 0xc6, 0x2c, 0xff, 0x4d, 0xb4,
-	0xe1, 0x60, 0xa2, 0x51, 0x86, 0x17, 0x2b, 0xe5, 0x0f, 0x21, 0x69, 0x38, 0x7d, 0x57, 0xa4, 0xd9,
-	0xbd, 0xca, 0xdb, 0xe6, 0xaa, 0x31, 0x51, 0x04, 0xe7, 0x07, 0x18, 0x92, 0x7f, 0x8e, 0xc3, 0x66,
-	0xfd, 0x0d, 0xed, 0x4e, 0x3c, 0x3a, 0x9b, 0x20, 0x77, 0x6a, 0x0d, 0x52, 0xe7, 0xb6, 0x3d, 0xd4,
-	0x45, 0xff, 0x3a, 0x89, 0x0f, 0x78, 0xa8, 0x12, 0x5d, 0x19, 0xd3, 0xf2, 0xd8, 0x1c, 0x52, 0x5a,
-	0x96, 0x53, 0x1a, 0x96, 0x47, 0x36, 0x20, 0x7d, 0x31, 0xb4, 0x0d, 0x4f, 0x17, 0x17, 0x8f, 0x14,
-	0x7b, 0xc2, 0x13, 0xb2, 0xeb, 0x39, 0x58, 0x26, 0x45, 0xd1, 0x4e, 0xf3, 0x47, 0x52, 0x84, 0xa4,
-	0x69, 0x79, 0x3a, 0x4b, 0xd7, 0xa2, 0x96, 0x30, 0x2d, 0x8f, 0x3c, 0x04, 0x60, 0xc3, 0x1a, 0x8e,
-	0x63, 0x5c, 0x97, 0xd2, 0xbb, 0x89, 0x27, 0x99, 0xe7, 0x71, 0x65, 0x49, 0xcb, 0x22, 0xb5, 0x8a,
-	0x44, 0xf2, 0x00, 0xb2, 0xa8, 0xc5, 0x25, 0x96, 0x77, 0x13, 0x4f, 0x8a, 0x4c, 0x22, 0x63, 0x5a,
-	0x1e, 0x17, 0x78, 0x04, 0x39, 0x3e, 0x0d, 0x2e, 0x92, 0xd9, 0x4d, 0x3c, 0x89, 0x33, 0x11, 0x60,
-	0x64, 0x2e, 0x84, 0xd7, 0x4c, 0x3e, 0x29, 0x2e, 0x95, 0x65, 0x57, 0xb3, 0x1c, 0xa7, 0x31, 0x91,
-	0xb2, 0x01, 0x1b, 0x61, 0x70, 0x6e, 0xdf, 0x2d, 0x3f, 0x15, 0x31, 0xe0, 0x15, 0x62, 0xab, 0x12,
-	0x0d, 0xaa, 0x40, 0x3f, 0x3b, 0x55, 0xf7, 0x44, 0xde, 0xfe, 0xe3, 0xdc, 0x99, 0xa5, 0x66, 0x8c,
-	0xa8, 0x63, 0x7c, 0xa8, 0x3d, 0x73, 0xff, 0xce, 0x54, 0xfd, 0x94, 0x6d, 0x4e, 0x0a, 0x6e, 0x4e,
-	0xf5, 0xf6, 0xd9, 0x8f, 0x9e, 0xea, 0xb5, 0xea, 0x49, 0x5d, 0xab, 0x96, 0xbf, 0x86, 0x35, 0x3e,
-	0x99, 0xc6, 0xc8, 0xe8, 0xbf, 0x6d, 0x6f, 0x24, 0x41, 0x71, 0x8c, 0x3d, 0x59, 0x11, 0x25, 0x10,
-	0x69, 0xb6, 0xe5, 0x6f, 0x88, 0xec, 0x3f, 0x8e, 0xf5, 0xd9, 0x2d, 0x63, 0x9d, 0x03, 0x09, 0x8d,
-	0xc5, 0x51, 0xde, 0x84, 0x34, 0x6e, 0xa3, 0x43, 0x2a, 0x52, 0x50, 0x3c, 0x31, 0xba, 0xe7, 0x50,
-	0x63, 0x24, 0xb6, 0x3d, 0xf1, 0xb4, 0x7f, 0x77, 0xaa, 0x7e, 0xce, 0xce, 0x26, 0x0b, 0x03, 0x20,
-	0xd2, 0xff, 0x95, 0x82, 0x9d, 0x10, 0xd2, 0x43, 0x73, 0x34, 0x57, 0x7f, 0xfe, 0xa8, 0xc7, 0x13,
-	0x15, 0xee, 0xce, 0x3a, 0x10, 0xa2, 0x0d, 0x2b, 0xdf, 0xba, 0x78, 0xc9, 0x0e, 0xba, 0x14, 0x35,
-	0x2e, 0x22, 0xdf, 0xbf, 0xce, 0xe0, 0xf1, 0xac, 0x55, 0x7d, 0x65, 0xeb, 0x63, 0x1b, 0x97, 0x81,
-	0x67, 0x38, 0x7d, 0x1a, 0x36, 0xc5, 0x0f, 0x99, 0x0f, 0x83, 0x16, 0xf6, 0x95, 0x7d, 0x86, 0xa2,
-	0x1d, 0x26, 0x29, 0x5b, 0xfc, 0x31, 0x14, 0x84, 0x10, 0xed, 0xe9, 0x23, 0xbb, 0x47, 0x5d, 0xb6,
-	0x9e, 0xf2, 0x4f, 0x57, 0x2a, 0x02, 0x9e, 0x13, 0xbb, 0x47, 0xb5, 0x7c, 0x20, 0x84, 0x8f, 0x2e,
-	0x79, 0x06, 0x9b, 0x97, 0xa6, 0x3b, 0x31, 0x86, 0xfa, 0xc8, 0xb4, 0x22, 0xae, 0xfd, 0xeb, 0x9c,
-	0x7b, 0x62, 0x5a, 0xf2, 0x60, 0x92, 0x96, 0xf1, 0x26, 0xa4, 0x95, 0x0d, 0x69, 0x19, 0x6f, 0x64,
-	0xad, 0x9f, 0xc0, 0x96, 0xd0, 0x92, 0x34, 0x74, 0xd7, 0xa3, 0x63, 0xd1, 0x68, 0xd9, 0xe0, 0x6c,
-	0x49, 0xa7, 0xed, 0xd1, 0x71, 0xa8, 0xaf, 0x6f, 0x5c, 0x19, 0xd7, 0xec, 0xae, 0x2c, 0xf5, 0xf5,
-	0xab, 0x57, 0xc6, 0x35, 0xf9, 0x24, 0xf0, 0xdf, 0xd5, 0x8d, 0x2e, 0x6b, 0x87, 0xf2, 0xcb, 0x72,
-	0xd0, 0xf7, 0xac, 0x32, 0x2a, 0xf9, 0xd3, 0xa0, 0x89, 0x45, 0xf1, 0x8c, 0x63, 0x09, 0xb0, 0x56,
-	0x19, 0x58, 0x05, 0x1f, 0xac, 0x43, 0xc3, 0x62, 0x78, 0x15, 0x03, 0x59, 0x41, 0x71, 0x49, 0x1d,
-	0x36, 0x66, 0x06, 0xdc, 0x2b, 0x2c, 0x3b, 0xdc, 0x44, 0x9e, 0x99, 0x28, 0xfa, 0x26, 0xda, 0xc8,
-	0x62, 0x46, 0x66, 0x03, 0x06, 0x34, 0x77, 0x7f, 0x7d, 0xaa, 0x56, 0xd8, 0x1a, 0xca, 0xb1, 0xbe,
-	0x72, 0xb3, 0x71, 0x52, 0xed, 0xd4, 0xcb, 0xff, 0x99, 0x80, 0x75, 0x5f, 0xff, 0x1d, 0x07, 0xd9,
-	0x5d, 0x48, 0xe2, 0xb8, 0x62, 0x5b, 0x0a, 0x87, 0x99, 0x71, 0xc8, 0x17, 0xb0, 0x16, 0x95, 0x9e,
-	0xbc, 0xa0, 0x93, 0xee, 0x62, 0x5a, 0x7e, 0x0e, 0x24, 0x22, 0x07, 0x79, 0x83, 0xa9, 0xe8, 0x2d,
-	0xe4, 0xdc, 0x33, 0xd8, 0x5c, 0x14, 0xd7, 0x87, 0xf6, 0x95, 0x68, 0xad, 0xac, 0x2f, 0xa8, 0x34,
-	0xed, 0x2b, 0x4c, 0x83, 0x08, 0xad, 0x81, 0xd9, 0x1f, 0x88, 0x0e, 0xd5, 0xc6, 0x82, 0xda, 0xb1,
-	0xd9, 0x1f, 0xe0, 0x32, 0x65, 0xd1, 0xe7, 0x9d, 0x41, 0xf6, 0x9f, 0x7c, 0x0c, 0x69, 0x11, 0xec,
-	0x0c, 0x43, 0x21, 0xef, 0xa3, 0xc0, 0x83, 0xad, 0x09, 0x2e, 0xd9, 0x83, 0x8c, 0x1f, 0x6a, 0x96,
-	0xa2, 0x11, 0x91, 0x5e, 0xbe, 0xe0, 0x7f, 0xc8, 0x97, 0x00, 0xb3, 0xa8, 0xb2, 0xcc, 0x8c, 0x0c,
-	0x6a, 0xd6, 0xf5, 0xff, 0xee, 0x6f, 0x4d, 0xd5, 0x2f, 0x16, 0x42, 0x89, 0x85, 0xea, 0xb7, 0x29,
-	0xd8, 0x10, 0x8a, 0xef, 0xbc, 0xa4, 0x89, 0x57, 0x15, 0x41, 0x48, 0xf9, 0xab, 0x0a, 0x36, 0x23,
-	0x3f, 0xd2, 0x89, 0x5b, 0x23, 0xfd, 0x0c, 0x36, 0x59, 0x3f, 0x27, 0x3a, 0x78, 0x19, 0x6d, 0x7d,
-	0x60, 0xb8, 0x8b, 0x35, 0x23, 0x3a, 0xdc, 0xa9, 0xdb, 0xc2, 0xfd, 0x53, 0xd8, 0x8e, 0x1e, 0x84,
-	0x85, 0x9c, 0x57, 0xaa, 0xad, 0xa8, 0x81, 0x30, 0xea, 0xb7, 0xe7, 0xca, 0xf2, 0x5b, 0x72, 0xe5,
-	0x2b, 0xd8, 0xb9, 0x65, 0x48, 0x96, 0x2f, 0xa2, 0xd9, 0x1b, 0x35, 0x26, 0x4b, 0x99, 0xb7, 0xa4,
-	0x5a, 0xf6, 0x6d, 0xa9, 0x26, 0x62, 0xc1, 0xd2, 0x0d, 0x82, 0x58, 0xb0, 0x3a, 0xe3, 0x67, 0x61,
-	0x4e, 0xca, 0xc2, 0x5d, 0xfe, 0x92, 0x2a, 0xc8, 0x30, 0x5e, 0x78, 0x60, 0x60, 0xb8, 0x22, 0xb9,
-	0x42, 0xf9, 0xb7, 0xfa, 0x8e, 0xfc, 0x13, 0x6f, 0xec, 0xa5, 0x1c, 0xcc, 0x07, 0x6f, 0xec, 0x83,
-	0xf4, 0x9b, 0xcb, 0xd2, 0xc2, 0xb7, 0xcb, 0xd2, 0x2f, 0xd9, 0x9e, 0x3a, 0x97, 0xa5, 0x7b, 0xa7,
-	0xa0, 0xcc, 0xbf, 0x9d, 0x24, 0x3b, 0xb0, 0xd5, 0xac, 0x1f, 0x55, 0x6b, 0xaf, 0xf9, 0x1b, 0x2f,
-	0xbd, 0xdd, 0xa9, 0x76, 0xea, 0x7a, 0xeb, 0xac, 0x7e, 0xaa, 0x2c, 0x91, 0x7b, 0x70, 0x27, 0x82,
-	0x59, 0x6b, 0xb6, 0xda, 0xf5, 0x03, 0x25, 0xb6, 0xf7, 0x35, 0xe4, 0xc3, 0xaf, 0x20, 0x49, 0x09,
-	0xd6, 0xb9, 0x64, 0xeb, 0xac, 0xae, 0x55, 0x3b, 0x8d, 0xd6, 0xa9, 0xde, 0x38, 0x68, 0xd6, 0x95,
-	0x25, 0x72, 0x1f, 0xb6, 0x17, 0x38, 0x6d, 0x36, 0x4e, 0xe3, 0xf4, 0x48, 0x89, 0xdd, 0xc2, 0xc7,
-	0xa1, 0x90, 0x1f, 0xdf, 0x73, 0x80, 0x2c, 0xbe, 0x44, 0x5c, 0x98, 0x60, 0xad, 0x75, 0x72, 0x52,
-	0x3d, 0x3d, 0xf0, 0xe7, 0x7f, 0x1f, 0xb6, 0x23, 0xd9, 0xcc, 0x03, 0x25, 0x76, 0xab, 0x7a, 0xbb,
-	0xd3, 0x3a, 0x53, 0xe2, 0x7b, 0x47, 0x90, 0xf1, 0xdf, 0xfb, 0x90, 0x22, 0xac, 0x1e, 0x56, 0x4f,
-	0xf5, 0xf6, 0x59, 0xbd, 0x7e, 0xa0, 0x37, 0x5b, 0xaf, 0x94, 0x25, 0xb2, 0x0e, 0xca, 0x8c, 0x74,
-	0x52, 0x3f, 0x68, 0xbc, 0x38, 0x51, 0x62, 0x84, 0x40, 0x7e, 0x46, 0x3d, 0x6e, 0x1c, 0x1d, 0x2b,
-	0xf1, 0xbd, 0xdf, 0xc6, 0x20, 0xe3, 0xdf, 0xd4, 0x51, 0xa0, 0xd9, 0x3a, 0xd2, 0x9b, 0xf5, 0x97,
-	0xf5, 0xa6, 0x7e, 0xda, 0x3a, 0x45, 0x74, 0xd6, 0xa0, 0x30, 0xa3, 0xd5, 0x35, 0xad, 0xa5, 0x71,
-	0x4b, 0x33, 0xe2, 0xab, 0xaa, 0x76, 0xaa, 0xc4, 0xc3, 0xb4, 0xc6, 0xe9, 0x61, 0x4b, 0x49, 0x84,
-	0x95, 0x0f, 0xea, 0xcf, 0x5f, 0x1c, 0x29, 0x49, 0xb2, 0x01, 0xc5, 0x19, 0xf1, 0x65, 0x5d, 0x7b,
-	0x8e, 0x1e, 0xa7, 0xc8, 0x36, 0x6c, 0x86, 0xc8, 0xaf, 0x03, 0x5e, 0x7a, 0xef, 0x6f, 0xe3, 0x90,
-	0x0f, 0x5f, 0x79, 0xc8, 0x1d, 0xd8, 0x68, 0xd7, 0xb5, 0x97, 0x8d, 0x5a, 0x5d, 0xaf, 0x6a, 0x47,
-	0x7a, 0xe7, 0xf5, 0x59, 0x5d, 0x7f, 0xde, 0x6a, 0x35, 0x95, 0x25, 0x0c, 0xf5, 0x02, 0xab, 0x71,
-	0xda, 0x51, 0x62, 0x38, 0xc6, 0x02, 0xe7, 0xb0, 0xd9, 0xaa, 0x76, 0x94, 0x38, 0xa6, 0xdb, 0x02,
-	0xaf, 0xdd, 0xd1, 0x30, 0xc6, 0x09, 0xf2, 0x00, 0x76, 0x22, 0x47, 0xd3, 0xab, 0x9a, 0x56, 0x7d,
-	0xad, 0x24, 0x31, 0x9e, 0x51, 0x63, 0x0a, 0x7e, 0x8a, 0xec, 0xc2, 0xdd, 0xe8, 0x91, 0x85, 0x44,
-	0x9a, 0x3c, 0x84, 0x7b, 0xb7, 0x8c, 0x2f, 0x44, 0x96, 0xf7, 0xfe, 0x29, 0x06, 0x39, 0xa9, 0xf0,
-	0x62, 0x98, 0xc5, 0x1a, 0xd2, 0x4f, 0x5a, 0x07, 0x75, 0xbd, 0x75, 0x78, 0xa8, 0x2c, 0x21, 0xbe,
-	0x21, 0x6a, 0xf5, 0x45, 0xa7, 0xa5, 0xc4, 0x16, 0xc8, 0x35, 0x04, 0x2b, 0xbe, 0x40, 0x3e, 0xae,
-	0x57, 0x3b, 0x4a, 0x02, 0xe1, 0x0d, 0x91, 0x31, 0x71, 0x5a, 0xa7, 0x4d, 0x74, 0x75, 0x7e, 0xd4,
-	0x03, 0xed, 0xb5, 0x92, 0xda, 0xfb, 0xef, 0x18, 0xe4, 0xc3, 0xe5, 0x04, 0x33, 0xc2, 0x17, 0xe4,
-	0xea, 0x3c, 0x9d, 0x42, 0xb4, 0xc3, 0x43, 0x25, 0x26, 0x5b, 0x44, 0x22, 0x9b, 0x70, 0x7c, 0x5e,
-	0x14, 0x33, 0x3b, 0x41, 0x36, 0x81, 0xc8, 0x44, 0x91, 0xdb, 0xc9, 0x79, 0x13, 0x2c, 0xbb, 0x53,
-	0x0b, 0xd2, 0x8d, 0x03, 0x5c, 0xf2, 0x69, 0xd9, 0x69, 0xa4, 0x1f, 0xb6, 0x6a, 0x2f, 0xda, 0xca,
-	0x32, 0xd9, 0x82, 0x35, 0x99, 0x7c, 0xd0, 0x38, 0x3c, 0x7c, 0xd1, 0xae, 0x2b, 0x99, 0xbd, 0xbf,
-	0x06, 0x65, 0xbe, 0xac, 0xc9, 0x36, 0xda, 0xaf, 0x30, 0x4a, 0x1c, 0x7d, 0x69, 0x48, 0x4e, 0x7e,
-	0xde, 0xea, 0x1c, 0xf3, 0xd4, 0x0b, 0xd3, 0x5f, 0xd6, 0xb5, 0x4e, 0xa3, 0x56, 0xc5, 0x18, 0xdc,
-	0x85, 0x92, 0xcc, 0xeb, 0xe8, 0xc7, 0x2d, 0xad, 0xf1, 0xf3, 0xd6, 0x69, 0xa7, 0xda, 0x54, 0x12,
-	0x7b, 0xbf, 0x8b, 0xc1, 0x6a, 0xe8, 0x48, 0x21, 0x8f, 0x51, 0xad, 0xb1, 0x82, 0xc4, 0xc7, 0x96,
-	0xc6, 0x10, 0x74, 0x0c, 0x32, 0xab, 0x52, 0x11, 0x3c, 0x8c, 0x34, 0xcf, 0x6e, 0xc9, 0x6f, 0xc1,
-	0x63, 0xa5, 0x31, 0x29, 0x67, 0x81, 0x60, 0x1c, 0x68, 0xaf, 0x51, 0x27, 0x15, 0x31, 0x87, 0xc3,
-	0xea, 0xa9, 0x92, 0x7e, 0xfa, 0xfb, 0x65, 0x58, 0xad, 0x9e, 0x35, 0xc4, 0x17, 0x7a, 0x26, 0x7b,
-	0xb3, 0x90, 0x1a, 0xd0, 0xe1, 0xd0, 0x26, 0xab, 0x15, 0xf9, 0x13, 0xc6, 0xed, 0x7c, 0x25, 0xf4,
-	0x51, 0x62, 0x39, 0xfd, 0xbf, 0xea, 0xd2, 0x37, 0xea, 0x12, 0x79, 0x06, 0xcb, 0xe2, 0x9b, 0x3b,
-	0x52, 0xa8, 0x84, 0x3f, 0x11, 0xdc, 0x56, 0x2a, 0x73, 0x9f, 0xfe, 0x05, 0x5a, 0x5f, 0x01, 0xf4,
-	0x82, 0x8f, 0xf5, 0x08, 0xa9, 0x2c, 0x7c, 0xd9, 0xb7, 0xbd, 0x56, 0x59, 0xfc, 0x9a, 0x2f, 0x50,
-	0xff, 0x14, 0x92, 0x63, 0xd3, 0xea, 0x93, 0x95, 0x8a, 0xf4, 0x45, 0xdf, 0xf6, 0x6a, 0x45, 0xfe,
-	0x90, 0x2f, 0x10, 0xfe, 0x29, 0x88, 0x8f, 0x75, 0xd8, 0x67, 0x8e, 0x38, 0xd8, 0xfc, 0x67, 0x7d,
-	0x38, 0xd8, 0xc2, 0xc7, 0x7c, 0xe5, 0x04, 0x2a, 0x57, 0x60, 0x75, 0x68, 0xba, 0x9e, 0x4e, 0xc5,
-	0xcd, 0x91, 0xac, 0x57, 0x22, 0xbe, 0x77, 0xdb, 0x4e, 0x55, 0x2e, 0x6d, 0xb3, 0x57, 0x46, 0x38,
-	0x14, 0xd7, 0xef, 0x99, 0xf1, 0xc6, 0xb0, 0x4b, 0xb6, 0x2a, 0xd1, 0x1f, 0xb7, 0xcd, 0xb4, 0xbe,
-	0x84, 0xfc, 0x4c, 0x6b, 0x68, 0xf7, 0x5d, 0xb2, 0x51, 0x89, 0xea, 0x0d, 0xcf, 0x34, 0xda, 0xb0,
-	0x3b, 0xd3, 0x18, 0xc8, 0x0d, 0x42, 0x5d, 0x34, 0x2e, 0x5d, 0xf2, 0x71, 0xe5, 0x5b, 0xf5, 0x59,
-	0x67, 0x46, 0xcf, 0xe0, 0x41, 0xd8, 0xa8, 0x2e, 0x59, 0xe5, 0xbe, 0x7c, 0x54, 0xf9, 0x16, 0x1d,
-	0xc4, 0x99, 0xc5, 0xa7, 0x90, 0x61, 0x87, 0x29, 0x73, 0x44, 0x49, 0xa1, 0x12, 0xee, 0xd2, 0x6d,
-	0x2b, 0x95, 0xb9, 0xe6, 0x1c, 0x87, 0xfc, 0x29, 0x14, 0x28, 0xef, 0xa0, 0xf8, 0xae, 0x90, 0xcd,
-	0x4a, 0x64, 0x2f, 0x66, 0x36, 0x4e, 0x05, 0x56, 0xbb, 0xb8, 0x9f, 0xfb, 0x1f, 0x0c, 0x91, 0xf5,
-	0x4a, 0xc4, 0x87, 0x49, 0x33, 0xf9, 0x3d, 0xc8, 0xe1, 0xf1, 0xca, 0x97, 0x26, 0x95, 0x85, 0x8f,
-	0x43, 0x42, 0xb6, 0x87, 0x66, 0x7f, 0xe0, 0x49, 0xb6, 0x23, 0x5e, 0x4b, 0x87, 0x83, 0xc9, 0x5e,
-	0xb3, 0x04, 0x0a, 0x1b, 0x95, 0xa8, 0xf7, 0x2e, 0x33, 0x8d, 0xcf, 0x60, 0xa5, 0xcb, 0x5a, 0x20,
-	0xba, 0x39, 0x32, 0xfa, 0x94, 0xac, 0x55, 0x16, 0x3b, 0x22, 0x32, 0xa6, 0x85, 0x2e, 0x2f, 0x30,
-	0xc1, 0x00, 0x9b, 0x95, 0xc8, 0x4b, 0x43, 0xa0, 0x73, 0x9e, 0x66, 0xdf, 0x25, 0xff, 0xe8, 0x0f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x6e, 0x5c, 0xc8, 0xb7, 0x2c, 0x00, 0x00,
+	// 9506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5d, 0x70, 0x23, 0x59,
+	0x96, 0x10, 0x5c, 0x92, 0x2c, 0x5b, 0x3a, 0xb2, 0xec, 0x74, 0xfa, 0x4f, 0x65, 0xd7, 0x8f, 0x4b,
+	0x55, 0xd5, 0x5d, 0xed, 0xee, 0xd6, 0xcc, 0x54, 0xf7, 0xf4, 0xf4, 0xba, 0xa7, 0x67, 0x25, 0x4b,
+	0xe9, 0xb2, 0xb6, 0x65, 0xc9, 0x93, 0x92, 0x5d, 0x53, 0xfb, 0xed, 0x6c, 0x4e, 0x5a, 0x4a, 0xdb,
+	0xd9, 0x2d, 0x65, 0xaa, 0x33, 0x53, 0xae, 0xaa, 0x0f, 0x82, 0x85, 0xdd, 0x1d, 0x60, 0x77, 0x02,
+	0x22, 0x60, 0x61, 0x81, 0x18, 0x62, 0x89, 0x79, 0x58, 0x36, 0x16, 0xd8, 0x07, 0x96, 0xe0, 0x81,
+	0x20, 0x82, 0x08, 0x78, 0x85, 0x97, 0x7d, 0xe2, 0x89, 0xc0, 0xec, 0x53, 0x45, 0xf0, 0x02, 0x04,
+	0x41, 0xec, 0x44, 0xec, 0x03, 0x71, 0xee, 0xbd, 0x99, 0x79, 0xf3, 0xcf, 0x76, 0xd5, 0xf4, 0x0f,
+	0xd0, 0xf3, 0x62, 0x2b, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0xcf, 0xdf, 0xbd, 0x79, 0xff, 0x12, 0xf2,
+	0xea, 0x58, 0xaf, 0x8c, 0x2d, 0xd3, 0x31, 0xd7, 0x16, 0xd4, 0xb1, 0xae, 0x98, 0x63, 0x47, 0x37,
+	0x0d, 0x9b, 0x82, 0xca, 0x7f, 0x3b, 0x05, 0xb3, 0xbb, 0xda, 0x70, 0x68, 0xca, 0xda, 0xa7, 0x13,
+	0xcd, 0x76, 0xc4, 0xdb, 0x50, 0xe8, 0x0f, 0x75, 0xcd, 0x70, 0x14, 0xdd, 0x38, 0x36, 0x4b, 0xa9,
+	0x8d, 0xd4, 0x83, 0xbc, 0x0c, 0x14, 0xd4, 0x34, 0x8e, 0x4d, 0x71, 0x13, 0x08, 0x9b, 0x33, 0xcd,
+	0xb2, 0x75, 0xd3, 0x50, 0x46, 0xea, 0xc7, 0xa6, 0x55, 0x4a, 0x6f, 0xa4, 0x1e, 0x14, 0xe5, 0x79,
+	0x75, 0xac, 0x1f, 0x52, 0xf8, 0x1e, 0x82, 0x23, 0xb4, 0xba, 0x61, 0x5a, 0xa5, 0x4c, 0x84, 0x16,
+	0xc1, 0x5b, 0xf9, 0xf3, 0x6a, 0xea, 0x45, 0x35, 0xfd, 0x97, 0x6b, 0xa9, 0xf2, 0x1f, 0xa4, 0xa0,
+	0xc8, 0x84, 0xb2, 0xc7, 0xa6, 0x61, 0x6b, 0xf1, 0x95, 0xa6, 0x5e, 0xa2, 0xd2, 0x74, 0x6c, 0xa5,
+	0xd8, 0x5a, 0x5b, 0xb3, 0xce, 0x34, 0x8b, 0xb6, 0x36, 0x43, 0x5b, 0x4b, 0x41, 0xa4, 0xb5, 0x22,
+	0x4c, 0x19, 0xea, 0x48, 0x2b, 0x4d, 0x11, 0x0c, 0xf9, 0x8d, 0x92, 0xa6, 0x5f, 0x54, 0x53, 0x28,
+	0xe9, 0xb7, 0x60, 0xae, 0x6e, 0x1a, 0x86, 0xd6, 0x77, 0x5c, 0xfd, 0xad, 0x41, 0x6e, 0xac, 0xda,
+	0xf6, 0x53, 0xd3, 0x1a, 0x30, 0xe5, 0x79, 0xcf, 0x58, 0x30, 0xc3, 0x9a, 0xf8, 0x08, 0xe6, 0xbd,
+	0x82, 0xac, 0x8d, 0x6f, 0x80, 0xa0, 0x1b, 0x67, 0xea, 0x50, 0x1f, 0x28, 0x01, 0x0e, 0x39, 0x79,
+	0x9e, 0xc1, 0xf7, 0x39, 0x46, 0x53, 0x4c, 0x82, 0x5b, 0xb0, 0xd0, 0xd0, 0xed, 0x7e, 0x40, 0x08,
+	0xc4, 0x67, 0x5f, 0x54, 0xaf, 0x21, 0xfe, 0x36, 0x88, 0x3c, 0x9e, 0xd6, 0x85, 0x04, 0xd3, 0x8c,
+	0x60, 0x19, 0x0a, 0xfb, 0xba, 0x71, 0xe2, 0x16, 0x9d, 0x3e, 0xaf, 0xce, 0xbc, 0xa8, 0x5e, 0x2b,
+	0xaf, 0xc0, 0x2c, 0x05, 0xb3, 0x12, 0xd3, 0xe7, 0xd5, 0x1c, 0xc2, 0xd7, 0x61, 0xa1, 0xa1, 0x9d,
+	0xe9, 0x7d, 0x0d, 0xd5, 0xc3, 0x15, 0xca, 0xbf, 0xa8, 0xa6, 0xcb, 0x3f, 0xcd, 0x82, 0xc8, 0x63,
+	0x59, 0xcb, 0xee, 0x42, 0x71, 0x62, 0x6b, 0x76, 0xb8, 0x59, 0xb3, 0x08, 0x74, 0xdb, 0xe4, 0x69,
+	0x3a, 0xed, 0x6b, 0x1a, 0xcd, 0x33, 0x52, 0xfb, 0x8a, 0x3a, 0x18, 0x58, 0x9a, 0x6d, 0xbb, 0xe6,
+	0x19, 0xa9, 0xfd, 0x1a, 0x85, 0x88, 0xaf, 0xc3, 0xbc, 0x66, 0x8f, 0x4f, 0xcd, 0x91, 0xe6, 0xda,
+	0x9b, 0x59, 0x6a, 0x8e, 0x81, 0x99, 0xb5, 0x51, 0xb9, 0x7d, 0x73, 0x34, 0xd6, 0x87, 0x2a, 0x7a,
+	0xbf, 0xe2, 0xe8, 0x23, 0xad, 0x94, 0x25, 0x94, 0xf3, 0x1c, 0xbc, 0xa7, 0x8f, 0x34, 0x71, 0x09,
+	0xb2, 0x23, 0x73, 0xa0, 0x0d, 0x4b, 0xd3, 0x04, 0x4f, 0x1f, 0xc4, 0x7b, 0x30, 0x77, 0xaa, 0xda,
+	0xca, 0x40, 0xd3, 0xc6, 0x8a, 0x3d, 0xd4, 0xb4, 0x71, 0x69, 0x86, 0x36, 0xe2, 0x54, 0xb5, 0x1b,
+	0x9a, 0x36, 0xee, 0x22, 0x4c, 0xbc, 0x03, 0xb3, 0x63, 0xcb, 0xfc, 0x58, 0xeb, 0x3b, 0x0a, 0x69,
+	0x4c, 0x8e, 0xb0, 0x28, 0x30, 0x58, 0x1b, 0xdb, 0xf4, 0x3a, 0xcc, 0xbb, 0x24, 0xae, 0xc8, 0x79,
+	0x2a, 0x32, 0x03, 0xbb, 0x22, 0xdf, 0x87, 0xb9, 0xa7, 0xda, 0x11, 0x73, 0xcf, 0xb1, 0x69, 0x39,
+	0x25, 0x20, 0x4e, 0x5c, 0xf4, 0xa0, 0xfb, 0xa6, 0xe5, 0x88, 0x75, 0xb8, 0x35, 0xd4, 0x4e, 0xd4,
+	0xfe, 0x73, 0xe5, 0x68, 0x38, 0xd1, 0x1c, 0xd3, 0x74, 0x4e, 0x95, 0xb1, 0x65, 0x3e, 0x7b, 0xee,
+	0xb1, 0x2f, 0x90, 0x62, 0xeb, 0x94, 0x6a, 0xdb, 0x25, 0xda, 0x47, 0x1a, 0xb7, 0xae, 0x1a, 0xdc,
+	0x0c, 0x97, 0x3e, 0xd6, 0x54, 0x67, 0x62, 0x69, 0xca, 0xf1, 0x50, 0x3d, 0xb1, 0x4b, 0xf3, 0x84,
+	0xc7, 0xda, 0x51, 0xa0, 0xf4, 0x0e, 0x25, 0xd9, 0x41, 0x0a, 0xb1, 0x0c, 0xb3, 0x23, 0xd5, 0x98,
+	0x1c, 0xab, 0x7d, 0x84, 0x59, 0xa5, 0x59, 0xd2, 0xa8, 0x00, 0x0c, 0x1d, 0xe1, 0xd8, 0xd2, 0x35,
+	0x63, 0x30, 0x7c, 0x4e, 0xf5, 0x53, 0xa4, 0x44, 0x2e, 0x90, 0x28, 0xc8, 0x6f, 0xd0, 0x99, 0xa9,
+	0xf7, 0x35, 0x45, 0xb5, 0x6d, 0xdd, 0x76, 0x54, 0xc3, 0xd7, 0xd7, 0x1c, 0xdf, 0xa0, 0x43, 0x24,
+	0xaa, 0xb9, 0x34, 0x5c, 0x83, 0xc2, 0xa5, 0x83, 0x0d, 0x5a, 0xa0, 0x0d, 0x3a, 0x0b, 0x94, 0x0e,
+	0x34, 0xe8, 0x3e, 0xcc, 0xd9, 0x93, 0x93, 0x13, 0xcd, 0x76, 0xb4, 0x81, 0xa2, 0x5a, 0x9a, 0x5a,
+	0x12, 0x88, 0xb4, 0x45, 0x0f, 0x5a, 0xb3, 0x34, 0x15, 0x7d, 0x1f, 0x5e, 0x54, 0x53, 0xe5, 0x9b,
+	0xb0, 0xd8, 0xd2, 0x6d, 0x47, 0x32, 0x1c, 0xdd, 0xd1, 0x35, 0x9b, 0x0b, 0x8d, 0x02, 0x86, 0xc6,
+	0x7d, 0x28, 0xf1, 0xe8, 0x86, 0x69, 0x68, 0x7c, 0x34, 0x2e, 0xb2, 0x70, 0xde, 0x80, 0x95, 0xee,
+	0xe4, 0xc8, 0xee, 0x5b, 0xfa, 0x91, 0xd6, 0x75, 0x54, 0x27, 0xc0, 0x68, 0x09, 0x19, 0xfd, 0xaf,
+	0x34, 0x6c, 0xf0, 0x9c, 0xb6, 0x75, 0x43, 0xb5, 0x9e, 0x77, 0x35, 0xc3, 0x36, 0x2d, 0x2f, 0xe2,
+	0xd6, 0x21, 0x6f, 0x1e, 0x11, 0x1f, 0xd3, 0xbd, 0x34, 0x44, 0x01, 0xcd, 0x81, 0x28, 0x40, 0xe6,
+	0x13, 0xed, 0x39, 0x09, 0xb4, 0x19, 0x19, 0x7f, 0x7a, 0xb1, 0x97, 0xe1, 0x62, 0x6f, 0x1d, 0xf2,
+	0x13, 0x43, 0xff, 0x74, 0xa2, 0x21, 0x0b, 0x1a, 0x54, 0x39, 0x0a, 0x68, 0x0e, 0xd0, 0xcf, 0x07,
+	0x24, 0xce, 0x95, 0xfe, 0x50, 0xb5, 0x6d, 0x16, 0x4a, 0x05, 0x0a, 0xab, 0x23, 0x48, 0xfc, 0x26,
+	0xac, 0xea, 0xb6, 0x62, 0x3b, 0xaa, 0x33, 0xb1, 0x95, 0x23, 0x22, 0xa4, 0x62, 0x13, 0x29, 0x49,
+	0x60, 0xe5, 0xe4, 0x25, 0xdd, 0xee, 0x12, 0x2c, 0xdf, 0x02, 0xb1, 0x02, 0x8b, 0x03, 0xdd, 0x56,
+	0x8f, 0x86, 0xda, 0x40, 0x39, 0x7a, 0xae, 0x0c, 0xb4, 0x63, 0x75, 0x32, 0x74, 0x58, 0xb0, 0x2d,
+	0xb8, 0xa8, 0xed, 0xe7, 0x0d, 0x8a, 0x40, 0xd1, 0xf5, 0xbe, 0x69, 0xb0, 0x48, 0x23, 0xbf, 0xc5,
+	0xf7, 0x61, 0x5e, 0x43, 0xed, 0x3c, 0x57, 0xfa, 0xaa, 0xa3, 0x9d, 0x98, 0xd6, 0x73, 0x12, 0x62,
+	0x73, 0x0f, 0xe7, 0x2b, 0x44, 0x6b, 0xcf, 0xeb, 0x0c, 0x2c, 0xcf, 0x69, 0x81, 0xe7, 0xad, 0xb5,
+	0xf3, 0xea, 0xec, 0x8b, 0x6a, 0xea, 0x7f, 0x54, 0x17, 0x0e, 0xba, 0x92, 0xb2, 0xdd, 0x6c, 0xd7,
+	0xe4, 0x27, 0x4a, 0x57, 0x6a, 0x77, 0x3b, 0x72, 0xf9, 0xaf, 0xa5, 0xe0, 0x3a, 0x2f, 0x2a, 0x31,
+	0x8f, 0xa7, 0x71, 0xa6, 0xd4, 0x94, 0xaf, 0xd4, 0x25, 0xc8, 0x62, 0xeb, 0x69, 0x46, 0xcb, 0xc9,
+	0xf4, 0x01, 0x43, 0x60, 0xa4, 0xdb, 0xb6, 0x6e, 0x9c, 0x28, 0x14, 0x9b, 0xa1, 0x69, 0x84, 0x01,
+	0x09, 0xd3, 0xad, 0x9b, 0xe7, 0xd5, 0xe5, 0x04, 0x31, 0xd0, 0x49, 0xfe, 0x43, 0x06, 0xae, 0xf3,
+	0x2e, 0x50, 0x37, 0xcf, 0xb4, 0x2f, 0xce, 0xf6, 0x77, 0xa1, 0xa8, 0xda, 0xf6, 0x64, 0xa4, 0x0d,
+	0x58, 0x0b, 0xb2, 0xb4, 0x05, 0x0c, 0x48, 0x5a, 0x20, 0xbe, 0x09, 0x0b, 0xf6, 0x64, 0x8c, 0x59,
+	0xcb, 0x56, 0xc6, 0xa6, 0xad, 0x63, 0x76, 0x65, 0x76, 0x17, 0x5c, 0xc4, 0x3e, 0x83, 0x23, 0x47,
+	0x8f, 0xd8, 0xd1, 0x3d, 0x6b, 0xcf, 0xba, 0xc0, 0x9e, 0x3e, 0x74, 0x22, 0x2e, 0x97, 0x8b, 0xba,
+	0x5c, 0x82, 0xef, 0xe4, 0x2f, 0xf3, 0x1d, 0xb8, 0xd8, 0x77, 0x0a, 0x57, 0xf2, 0x9d, 0x40, 0x2b,
+	0x6c, 0xc7, 0x1c, 0x97, 0x66, 0x83, 0xad, 0xe8, 0x3a, 0xe6, 0x78, 0x4b, 0x3c, 0xaf, 0x16, 0x89,
+	0x65, 0xf3, 0x68, 0xd9, 0x7a, 0xe7, 0x50, 0x92, 0xcb, 0xff, 0x25, 0x05, 0x22, 0x31, 0xe1, 0x65,
+	0x1e, 0xf5, 0x2e, 0xcc, 0xb2, 0xcc, 0xe8, 0x3b, 0xd6, 0xdc, 0xc3, 0x85, 0x4a, 0x8b, 0x00, 0x39,
+	0x16, 0x05, 0x4a, 0x46, 0x1e, 0xc8, 0x88, 0xc4, 0xb5, 0x00, 0x1a, 0x39, 0x2d, 0x7b, 0xcf, 0xa8,
+	0x01, 0xa2, 0xf0, 0x29, 0x02, 0x27, 0xbf, 0xc5, 0x6f, 0xc3, 0x42, 0x7f, 0x62, 0x59, 0x38, 0x04,
+	0x34, 0xc7, 0x9a, 0x45, 0x3a, 0xc6, 0x52, 0x96, 0xe9, 0x80, 0x54, 0xd2, 0x71, 0xc1, 0xb2, 0xc0,
+	0x28, 0x3d, 0xc8, 0xd6, 0xf2, 0x79, 0x75, 0x25, 0xd4, 0x40, 0x74, 0xd9, 0x7f, 0x92, 0x86, 0x45,
+	0x52, 0xb6, 0x6e, 0x8e, 0x46, 0xaa, 0x31, 0x70, 0x87, 0x4b, 0xd1, 0x46, 0xbe, 0x05, 0x22, 0x76,
+	0xb4, 0xac, 0xa1, 0x7d, 0x4a, 0xce, 0x62, 0x48, 0x38, 0x55, 0x6d, 0xb7, 0xb1, 0x04, 0x2e, 0x6e,
+	0xc1, 0x5c, 0x88, 0x32, 0x43, 0x24, 0x5d, 0xe4, 0x95, 0xe2, 0xd6, 0x59, 0x1c, 0x06, 0xca, 0xde,
+	0x01, 0xec, 0xbc, 0x7d, 0xf7, 0x9c, 0x22, 0x75, 0x14, 0x4e, 0x55, 0xdf, 0x33, 0x79, 0xdd, 0x65,
+	0x43, 0xba, 0xbb, 0x0e, 0x39, 0x2c, 0x4e, 0xf4, 0x47, 0x3d, 0x7b, 0xe6, 0x54, 0xa5, 0xbe, 0xea,
+	0xaa, 0x75, 0x86, 0x53, 0xab, 0x08, 0x53, 0xc4, 0x2b, 0x72, 0x84, 0x94, 0xfc, 0x46, 0x65, 0xdd,
+	0x7a, 0x51, 0x4d, 0x87, 0x95, 0xf5, 0xe7, 0x19, 0x58, 0xe5, 0xe3, 0x7b, 0x47, 0x35, 0xbe, 0xb0,
+	0xe8, 0xfe, 0x06, 0x2c, 0x79, 0x5e, 0x6c, 0xda, 0x7d, 0x7d, 0x38, 0xf4, 0x1d, 0x20, 0x27, 0x2f,
+	0xba, 0xb8, 0x8e, 0x8f, 0xa2, 0x1d, 0xa5, 0xeb, 0xf8, 0x63, 0x4d, 0x1b, 0x30, 0x75, 0x78, 0xe1,
+	0xd0, 0x45, 0xa0, 0xf8, 0x36, 0x88, 0x1e, 0xd9, 0x40, 0xb7, 0xb4, 0x3e, 0xe1, 0xcb, 0x12, 0xbb,
+	0x8b, 0x69, 0xb8, 0x08, 0xf1, 0x21, 0x2c, 0x33, 0x20, 0x26, 0x1a, 0xe4, 0xa0, 0xf4, 0xcd, 0x89,
+	0xe1, 0x10, 0x05, 0x66, 0x3d, 0x49, 0xb4, 0x01, 0xe1, 0x5e, 0x47, 0xd4, 0x97, 0x9c, 0x00, 0xde,
+	0x85, 0x15, 0x5f, 0xe2, 0xb1, 0xa5, 0xd9, 0x9a, 0xa3, 0xe0, 0xe0, 0xd1, 0x2e, 0xcd, 0x6e, 0x64,
+	0x1e, 0xe4, 0xe5, 0x25, 0x0f, 0xbb, 0x4f, 0x90, 0x7b, 0x88, 0xdb, 0x12, 0xce, 0xab, 0x73, 0x24,
+	0x60, 0x66, 0xd0, 0x07, 0x76, 0x6a, 0xed, 0xf2, 0x0f, 0xd3, 0x20, 0xec, 0xa8, 0xc6, 0xab, 0xf5,
+	0x2f, 0x1b, 0x50, 0xf0, 0xcc, 0x66, 0x9c, 0xb0, 0xde, 0x85, 0x07, 0x89, 0x77, 0x21, 0x4b, 0xad,
+	0x34, 0x45, 0x9a, 0x95, 0xaf, 0x60, 0x5d, 0x08, 0xd8, 0x4e, 0x97, 0x52, 0x32, 0xc5, 0x89, 0x6f,
+	0x42, 0xde, 0xb7, 0x11, 0x0d, 0xfe, 0x22, 0x12, 0x7a, 0xf6, 0x91, 0x7d, 0x3c, 0x79, 0x8b, 0x22,
+	0x06, 0x1a, 0x6a, 0x67, 0x6c, 0xdc, 0x9c, 0x95, 0x81, 0x80, 0x5a, 0x08, 0x41, 0x02, 0x4e, 0x1f,
+	0xc4, 0xe6, 0x79, 0x19, 0xc6, 0x9e, 0x16, 0xb6, 0x16, 0xcf, 0xab, 0xab, 0x01, 0x25, 0x60, 0x18,
+	0xfc, 0x69, 0x06, 0x16, 0x76, 0x54, 0xe3, 0xd2, 0x8c, 0xb1, 0x0e, 0x79, 0x0c, 0x44, 0x5e, 0x19,
+	0x18, 0x99, 0x34, 0xfb, 0x79, 0x5a, 0xca, 0xf0, 0x5a, 0xba, 0xcd, 0x8a, 0x78, 0x7a, 0xc8, 0x91,
+	0xc6, 0x93, 0x62, 0xa4, 0xfd, 0x9e, 0x92, 0xb2, 0x17, 0x28, 0xe9, 0x75, 0x98, 0x47, 0x2e, 0xbc,
+	0xbe, 0xa9, 0xe7, 0xe3, 0xab, 0x42, 0xc7, 0x87, 0x86, 0x8d, 0x32, 0x13, 0x67, 0x94, 0x22, 0x79,
+	0xbd, 0xf0, 0x74, 0x9e, 0xf3, 0xdf, 0x2e, 0x3c, 0x3d, 0x07, 0x8c, 0x92, 0xbf, 0xc4, 0x28, 0xaf,
+	0x51, 0xe1, 0x78, 0xc3, 0x00, 0x0d, 0x4b, 0xb7, 0x91, 0x9e, 0x6d, 0x78, 0x9a, 0x42, 0xc4, 0x78,
+	0x8c, 0x11, 0x6f, 0xc0, 0x59, 0x8f, 0x91, 0xef, 0xc9, 0x61, 0x23, 0x17, 0xe3, 0x8c, 0x7c, 0x9b,
+	0x64, 0x3b, 0xde, 0xc8, 0x7f, 0x94, 0x0d, 0x8e, 0x65, 0x5a, 0xfa, 0xc9, 0xa9, 0xf3, 0x85, 0x65,
+	0xbb, 0xef, 0xf0, 0x49, 0xa6, 0x6f, 0x0e, 0x4d, 0x8b, 0x8b, 0xd8, 0xb9, 0x87, 0x50, 0xa9, 0x23,
+	0x0c, 0xa5, 0xe7, 0x12, 0x8e, 0x07, 0xb3, 0xc5, 0x2a, 0xac, 0xb9, 0x3d, 0xb2, 0x9b, 0xda, 0x8e,
+	0x2c, 0x6c, 0x81, 0xa1, 0xb1, 0x51, 0x31, 0x75, 0xac, 0x12, 0xeb, 0x90, 0x19, 0xd1, 0xb6, 0x47,
+	0x23, 0x3e, 0x84, 0xc5, 0x30, 0x07, 0xeb, 0xe4, 0xa8, 0x34, 0xed, 0x15, 0x5d, 0x08, 0x16, 0x95,
+	0x4f, 0x8e, 0xc4, 0x1a, 0xac, 0x87, 0xcb, 0x3c, 0x3d, 0xd5, 0x1d, 0x4d, 0x39, 0x53, 0x87, 0x13,
+	0x1a, 0x5e, 0xb1, 0xd5, 0x3e, 0x46, 0xa2, 0x43, 0xa4, 0x11, 0xf7, 0xe0, 0x4e, 0x98, 0x05, 0x6d,
+	0xbe, 0xa3, 0x8d, 0x48, 0x5f, 0x3e, 0xb1, 0xe8, 0xdb, 0x2b, 0x65, 0x74, 0x2b, 0xc8, 0x88, 0x68,
+	0xa0, 0xe7, 0x53, 0x8a, 0x37, 0x01, 0x46, 0x3a, 0xce, 0xb5, 0x58, 0xda, 0xc0, 0x26, 0xae, 0x99,
+	0x96, 0xf3, 0x23, 0xdd, 0xd8, 0x23, 0x00, 0x82, 0x56, 0x9f, 0xb9, 0x68, 0x60, 0x68, 0xf5, 0x19,
+	0x43, 0x97, 0x60, 0x46, 0x3b, 0x3e, 0xd6, 0xfa, 0x8e, 0x5d, 0x2a, 0x90, 0x4c, 0xe9, 0x3e, 0x26,
+	0x25, 0xf4, 0xe2, 0x65, 0x09, 0x7d, 0xee, 0xe2, 0x84, 0x3e, 0x7f, 0xb5, 0xb7, 0x01, 0xf1, 0xbc,
+	0x3a, 0xef, 0x8f, 0x65, 0x5a, 0xcd, 0x47, 0xbb, 0xbd, 0xf2, 0xef, 0x65, 0x40, 0x24, 0x3e, 0xfa,
+	0x6a, 0xe9, 0xf9, 0x16, 0x00, 0xe7, 0x20, 0x74, 0x38, 0xc6, 0x41, 0xc4, 0x37, 0x00, 0x7c, 0x37,
+	0x64, 0x1d, 0x0f, 0xef, 0x85, 0xf9, 0xbe, 0xfb, 0x93, 0x4e, 0x69, 0x20, 0x29, 0xc7, 0x90, 0xaa,
+	0x76, 0x9e, 0xc0, 0x39, 0x27, 0x13, 0x20, 0x63, 0xb1, 0x44, 0x97, 0x96, 0xf1, 0x27, 0x4a, 0x77,
+	0x62, 0x69, 0x9a, 0x3b, 0xaa, 0xa1, 0x0f, 0xa8, 0x3e, 0x7c, 0xc3, 0x27, 0xde, 0x97, 0x96, 0xc9,
+	0x6f, 0xa4, 0x24, 0xce, 0xc5, 0x06, 0x33, 0xf4, 0x01, 0xc7, 0xf7, 0xf1, 0xfe, 0x92, 0x96, 0x85,
+	0x7e, 0x8c, 0x77, 0xf4, 0xcd, 0xe1, 0x80, 0x3a, 0x29, 0x49, 0x1e, 0x69, 0xd2, 0x90, 0x01, 0x71,
+	0x48, 0x44, 0x3f, 0x55, 0xad, 0x11, 0x43, 0x17, 0x29, 0x1a, 0x21, 0x14, 0xbd, 0x02, 0xd3, 0xd4,
+	0x1d, 0xd8, 0x3c, 0x09, 0x7b, 0xc2, 0xc1, 0x53, 0x29, 0x64, 0x1d, 0x4c, 0x28, 0xff, 0x66, 0x06,
+	0x5f, 0xc4, 0x4f, 0x4e, 0x9d, 0xcf, 0xa3, 0xdf, 0xb8, 0x4f, 0x67, 0x81, 0x38, 0x8d, 0x4f, 0x79,
+	0xa9, 0x90, 0xd3, 0x77, 0xd0, 0xca, 0xd9, 0x88, 0x95, 0xd9, 0x64, 0x12, 0x67, 0xe9, 0x15, 0x2f,
+	0xdd, 0xd7, 0x39, 0x03, 0xf3, 0xbe, 0xb0, 0x7a, 0x91, 0x2f, 0x7c, 0x1d, 0x96, 0x7c, 0x86, 0x5c,
+	0xd5, 0x4b, 0x84, 0xad, 0xe8, 0xb2, 0xdd, 0xe6, 0x1d, 0x2d, 0xea, 0x3d, 0xcb, 0xf1, 0xde, 0xb3,
+	0x0a, 0x38, 0xb0, 0xf5, 0xd3, 0x92, 0x3c, 0x7d, 0xaa, 0x92, 0x3c, 0xc4, 0xdc, 0x6a, 0x26, 0xc6,
+	0xad, 0x72, 0x71, 0x6e, 0x95, 0xe7, 0xdc, 0x8a, 0x29, 0x9f, 0xda, 0x1c, 0x3c, 0xe5, 0x53, 0x93,
+	0x7b, 0x3e, 0x57, 0xe0, 0x7d, 0xee, 0x21, 0x2c, 0xfb, 0x8d, 0xe4, 0xfd, 0x8e, 0x76, 0x47, 0x8b,
+	0x6e, 0x2b, 0x79, 0xd7, 0x8b, 0xf5, 0xd3, 0x62, 0x82, 0x9f, 0xfa, 0x66, 0x71, 0x7d, 0xb5, 0xc4,
+	0x9b, 0xc5, 0x77, 0x57, 0x8e, 0xe2, 0x7a, 0xd8, 0x9b, 0x19, 0x13, 0xce, 0xa3, 0xd7, 0x3c, 0x26,
+	0x8f, 0x3d, 0xa7, 0x0e, 0xfa, 0xfc, 0x7a, 0xd8, 0xe7, 0x59, 0x53, 0x1d, 0x4b, 0x35, 0xe8, 0xdb,
+	0x86, 0x32, 0xd4, 0x8c, 0x13, 0xe7, 0xb4, 0x34, 0xe7, 0x35, 0xb5, 0xe7, 0xe1, 0x5a, 0x04, 0x85,
+	0x4d, 0x8d, 0xd2, 0xd3, 0x79, 0x3b, 0xc1, 0x09, 0x13, 0x3f, 0x00, 0x7c, 0x97, 0xc2, 0xb9, 0x30,
+	0xfb, 0xd4, 0xa5, 0x15, 0xbc, 0xb1, 0xcb, 0x0e, 0x82, 0x19, 0xe5, 0x1d, 0x98, 0x0d, 0x50, 0xd1,
+	0x89, 0xb3, 0xc2, 0x31, 0x47, 0x72, 0x13, 0x00, 0x99, 0xb1, 0x28, 0x15, 0x09, 0x1b, 0xb4, 0xae,
+	0x44, 0x00, 0x5c, 0x00, 0x2f, 0x86, 0x03, 0x78, 0xc3, 0x7f, 0xfb, 0xf1, 0x02, 0xf8, 0x9f, 0x4f,
+	0xc1, 0x1a, 0x3f, 0x22, 0xf8, 0x82, 0xa7, 0xb6, 0xdc, 0x2e, 0x24, 0xcb, 0x75, 0x21, 0x15, 0x58,
+	0x9c, 0x18, 0xba, 0xa3, 0x98, 0xc7, 0xca, 0x48, 0x53, 0xed, 0x89, 0xa5, 0x8d, 0x34, 0xc3, 0x61,
+	0x13, 0xc4, 0x0b, 0x88, 0xea, 0x1c, 0xef, 0xf9, 0x08, 0x34, 0x85, 0xda, 0xef, 0x4f, 0x2c, 0xec,
+	0x5f, 0x07, 0x5a, 0x5f, 0x1f, 0xa9, 0x43, 0x9b, 0x84, 0x49, 0x56, 0x16, 0x5c, 0x44, 0x83, 0xc1,
+	0x89, 0x82, 0x4d, 0xab, 0xaf, 0x29, 0x93, 0xf1, 0x00, 0x13, 0x0e, 0x1d, 0xf9, 0x15, 0x08, 0xec,
+	0x80, 0x80, 0x22, 0x73, 0x1f, 0xf9, 0xe8, 0xdc, 0xc7, 0x43, 0x28, 0x90, 0x14, 0xc5, 0x28, 0x80,
+	0x4d, 0x0d, 0x70, 0x53, 0x55, 0x84, 0x4e, 0x06, 0xdb, 0xfb, 0x2d, 0x36, 0x61, 0x85, 0x0d, 0x02,
+	0x86, 0xaa, 0xed, 0x28, 0x74, 0xf0, 0xe6, 0x3c, 0x1f, 0xbb, 0x1d, 0xcf, 0x12, 0x2b, 0xde, 0x52,
+	0x6d, 0x1c, 0x92, 0x69, 0x4e, 0xef, 0xf9, 0x58, 0x93, 0xd9, 0x78, 0x25, 0x00, 0x4c, 0xea, 0xa8,
+	0x67, 0x93, 0x3a, 0xea, 0x98, 0x4e, 0xb9, 0x78, 0xb5, 0x4e, 0x79, 0xf1, 0xbc, 0x2a, 0x90, 0xb4,
+	0x0f, 0xe8, 0x35, 0x6c, 0x6e, 0xee, 0xff, 0x87, 0xc5, 0x57, 0x98, 0x94, 0x4b, 0xbf, 0xd4, 0xa4,
+	0xdc, 0xca, 0x79, 0xf5, 0x7a, 0xb8, 0x62, 0xf4, 0xd7, 0xff, 0x98, 0x0e, 0xf9, 0xeb, 0x53, 0xdd,
+	0xe9, 0x9f, 0x7e, 0xa9, 0xfe, 0x1a, 0x99, 0xa2, 0x9b, 0x8e, 0x99, 0xa2, 0x7b, 0xd9, 0x99, 0xd6,
+	0x18, 0x93, 0xe5, 0xae, 0xf6, 0x62, 0x7c, 0xb9, 0xfb, 0xa2, 0x55, 0x17, 0x38, 0xe5, 0x3e, 0x6e,
+	0xf6, 0xea, 0xbb, 0xe5, 0x03, 0x58, 0xa4, 0xca, 0x7c, 0xa5, 0xb1, 0x16, 0x1a, 0x6c, 0x2d, 0xcc,
+	0x13, 0x0d, 0x76, 0x08, 0x4b, 0x94, 0xed, 0xa5, 0x23, 0x84, 0x44, 0xbe, 0x77, 0x48, 0xde, 0x0a,
+	0xf1, 0xfd, 0xd7, 0x69, 0xb8, 0xc5, 0x3b, 0x42, 0x4f, 0x7b, 0xe6, 0xfc, 0x9f, 0x91, 0xbc, 0xe2,
+	0xec, 0x3c, 0xfd, 0x12, 0x76, 0x9e, 0x79, 0x35, 0x3b, 0x47, 0xa7, 0x68, 0xb7, 0x4a, 0xe7, 0x55,
+	0x91, 0xd8, 0x64, 0x1e, 0x75, 0xd7, 0x93, 0xbe, 0xd7, 0x73, 0x43, 0xf8, 0x37, 0x52, 0xb0, 0xea,
+	0x6b, 0xec, 0xa5, 0x2c, 0x9e, 0x7f, 0xa9, 0x38, 0x5e, 0x3f, 0xaf, 0xae, 0xc7, 0x8a, 0x80, 0x36,
+	0xfc, 0x01, 0x2c, 0x79, 0xeb, 0x2f, 0x2d, 0xf3, 0xc4, 0xf6, 0x97, 0xc5, 0xb3, 0xf4, 0xfd, 0x38,
+	0xc5, 0xe6, 0x03, 0x5a, 0xe6, 0x09, 0x79, 0x3d, 0x96, 0x29, 0x1c, 0xdf, 0x7e, 0x07, 0x93, 0xd1,
+	0x58, 0xe9, 0x9b, 0xc6, 0xb1, 0x7e, 0xc2, 0x1c, 0x06, 0x10, 0x54, 0x27, 0x10, 0x5c, 0xbf, 0xb9,
+	0x81, 0xeb, 0x37, 0xbf, 0x93, 0x82, 0xe5, 0x50, 0x15, 0xac, 0x95, 0x97, 0xd6, 0x21, 0x40, 0xc6,
+	0x51, 0x4f, 0x58, 0x93, 0xf1, 0x27, 0xbe, 0x39, 0x8d, 0x34, 0xdb, 0x56, 0x4f, 0x5c, 0x1f, 0x71,
+	0x1f, 0xe9, 0xca, 0xb6, 0x31, 0x50, 0x8e, 0x55, 0x7d, 0xe8, 0xce, 0x71, 0xe0, 0xca, 0xb6, 0x31,
+	0xd8, 0x21, 0x90, 0xad, 0xd9, 0xf3, 0xea, 0xcd, 0x17, 0xd5, 0xd4, 0x9f, 0xe1, 0x2a, 0xf0, 0xb5,
+	0xf2, 0xd7, 0xe0, 0xbe, 0x27, 0xd4, 0xae, 0x89, 0x9d, 0x1c, 0x5b, 0x12, 0xeb, 0x6a, 0x16, 0x5a,
+	0x8f, 0x5f, 0x86, 0x2a, 0x63, 0x33, 0x6a, 0xb0, 0x1a, 0x47, 0xb7, 0xa7, 0x8e, 0x79, 0x6b, 0xe5,
+	0x3d, 0x6b, 0xd1, 0x57, 0x53, 0x66, 0x2d, 0xf2, 0x50, 0xfe, 0x8d, 0x34, 0xdc, 0x88, 0xe3, 0xe1,
+	0x29, 0xa4, 0x04, 0x33, 0x36, 0x05, 0x31, 0x66, 0xee, 0xa3, 0xf8, 0x16, 0x4c, 0x0d, 0x54, 0x47,
+	0x2d, 0xa5, 0x37, 0x32, 0x0f, 0x0a, 0x0f, 0x4b, 0x95, 0x04, 0x51, 0x64, 0x42, 0x25, 0x7e, 0x08,
+	0x45, 0xfc, 0x4f, 0xc6, 0x80, 0x43, 0xea, 0x16, 0x17, 0x17, 0x9b, 0x45, 0xf2, 0x1e, 0xa3, 0x16,
+	0xdf, 0x83, 0xfc, 0x99, 0x6a, 0xe9, 0x18, 0x26, 0x38, 0xde, 0xbf, 0xb8, 0xa8, 0x4f, 0x8a, 0x13,
+	0xc4, 0xba, 0xad, 0x68, 0x67, 0x38, 0x54, 0xa0, 0xd3, 0xa7, 0x33, 0xba, 0x2d, 0xe1, 0x23, 0xae,
+	0xf8, 0xdd, 0x65, 0x2b, 0x7e, 0x6f, 0xc3, 0xdd, 0x80, 0xe6, 0xbd, 0xc5, 0xc8, 0xc8, 0xf2, 0xdf,
+	0x6b, 0xa8, 0xf7, 0xbf, 0x78, 0x21, 0x39, 0x9f, 0x68, 0x58, 0x10, 0xfb, 0x89, 0x86, 0x02, 0x9a,
+	0x03, 0xf1, 0x06, 0xe4, 0x55, 0xc7, 0xb1, 0xf4, 0xa3, 0x89, 0x17, 0x40, 0x3e, 0x00, 0x73, 0x88,
+	0x69, 0xf4, 0xdd, 0xd8, 0x21, 0xbf, 0xb1, 0xf6, 0xd7, 0x71, 0x91, 0xf3, 0x19, 0xac, 0xbd, 0x6a,
+	0xa5, 0xf1, 0x11, 0x1b, 0x10, 0x25, 0x13, 0x12, 0x05, 0xd5, 0xf4, 0x80, 0x6d, 0x98, 0x28, 0xc1,
+	0xdc, 0x23, 0xcd, 0xc1, 0x05, 0x7a, 0x4e, 0x23, 0xf7, 0x70, 0x47, 0x42, 0x0d, 0xe6, 0x3d, 0x8c,
+	0xbf, 0xe1, 0x40, 0x1b, 0x9b, 0xfd, 0x53, 0xc5, 0xd6, 0xfa, 0xa6, 0x31, 0xb0, 0x59, 0xe6, 0x98,
+	0x25, 0xc0, 0x2e, 0x85, 0x21, 0xf3, 0xfb, 0x6c, 0x0f, 0xc4, 0x63, 0xb8, 0x11, 0x1c, 0x71, 0x52,
+	0x9f, 0xaf, 0x59, 0x27, 0x13, 0x32, 0x9e, 0x73, 0xf3, 0x70, 0x8a, 0xcb, 0xc3, 0x77, 0x61, 0x8a,
+	0x0c, 0x95, 0xd2, 0x2c, 0x37, 0xb2, 0x42, 0x35, 0xeb, 0x84, 0x8c, 0x92, 0x08, 0xb2, 0xfc, 0x6b,
+	0xf1, 0x8c, 0x3d, 0x41, 0xe3, 0x18, 0x47, 0xbb, 0x81, 0x6f, 0xc0, 0x94, 0x6a, 0x9d, 0xd8, 0xcc,
+	0x6d, 0x6f, 0x56, 0x2e, 0x92, 0x55, 0x26, 0xa4, 0xa8, 0x9c, 0x37, 0xd0, 0x60, 0x3f, 0x4e, 0xc3,
+	0x8a, 0xf4, 0x4c, 0xeb, 0x4f, 0x1c, 0xcd, 0x17, 0x90, 0x36, 0x6a, 0x11, 0xb2, 0x47, 0xa6, 0x39,
+	0x54, 0xd8, 0x6e, 0x8c, 0x29, 0x7c, 0xc0, 0xa1, 0x3c, 0x1b, 0x12, 0xea, 0x86, 0x43, 0x64, 0xc8,
+	0xca, 0x79, 0x0a, 0x69, 0x1a, 0x8e, 0xb8, 0x0c, 0xd3, 0xc7, 0x43, 0x53, 0x75, 0x14, 0x36, 0x75,
+	0x91, 0x25, 0x4f, 0xf8, 0x86, 0x68, 0x3b, 0x16, 0xa6, 0x5d, 0xd6, 0x23, 0x4d, 0xd3, 0x47, 0x71,
+	0x01, 0xa6, 0x74, 0xc3, 0x51, 0x88, 0xfb, 0x2f, 0xc8, 0x19, 0xdd, 0xc0, 0x75, 0x3c, 0x20, 0xd5,
+	0xaa, 0x96, 0xa5, 0x3e, 0x2f, 0x4d, 0x6f, 0x64, 0x70, 0x8a, 0x49, 0xb8, 0x26, 0xe7, 0x11, 0x5a,
+	0x43, 0x20, 0xce, 0xce, 0x62, 0x29, 0x4a, 0x31, 0xb3, 0x91, 0x79, 0xb0, 0x40, 0x28, 0x72, 0xba,
+	0xe1, 0x50, 0x82, 0xbb, 0x50, 0xa0, 0x62, 0x50, 0x92, 0xdc, 0x46, 0xe6, 0x41, 0x9a, 0x90, 0x00,
+	0x01, 0x53, 0xa2, 0x3b, 0x30, 0xcb, 0x84, 0xa2, 0x54, 0x79, 0x32, 0xb5, 0x54, 0xa0, 0x30, 0x42,
+	0x52, 0x56, 0x61, 0x39, 0xa8, 0x9c, 0xe4, 0xa1, 0xc0, 0x9b, 0xcc, 0x06, 0x34, 0xe3, 0xac, 0x56,
+	0xe2, 0x95, 0xca, 0xb4, 0x9f, 0x3f, 0xaf, 0x6e, 0x32, 0xbf, 0xfd, 0xbb, 0xa1, 0xd1, 0x61, 0x5d,
+	0x1d, 0x69, 0x96, 0xfa, 0x85, 0x0d, 0x08, 0x12, 0x3a, 0xff, 0xec, 0x65, 0x13, 0x68, 0xd3, 0x17,
+	0x4f, 0xa0, 0x5d, 0x6d, 0x40, 0xb0, 0x75, 0xfd, 0xbc, 0xfa, 0x26, 0xe9, 0x6a, 0x05, 0xec, 0x6a,
+	0xa5, 0xee, 0xfe, 0x3b, 0x0f, 0x95, 0x7a, 0x6d, 0x4f, 0x92, 0x6b, 0xe5, 0x8f, 0x61, 0x91, 0xaa,
+	0xa2, 0x39, 0x52, 0x4f, 0x2e, 0xea, 0xe9, 0x45, 0x2f, 0xd5, 0xa7, 0x1e, 0xcc, 0xb2, 0x84, 0x8e,
+	0x30, 0xd3, 0xf0, 0x52, 0x14, 0xfe, 0xc6, 0xba, 0xde, 0x4a, 0xa8, 0xeb, 0x08, 0xc4, 0x40, 0x5d,
+	0xd4, 0xc6, 0x2b, 0x30, 0x8d, 0x83, 0x82, 0xa1, 0xc6, 0x02, 0x80, 0x3d, 0x11, 0xb8, 0x63, 0x69,
+	0xea, 0x88, 0x75, 0xe2, 0xec, 0x69, 0xeb, 0xc6, 0x79, 0xf5, 0x6d, 0x32, 0xec, 0x8b, 0x54, 0x80,
+	0x76, 0xfe, 0xb7, 0x00, 0xeb, 0x01, 0x3b, 0x0f, 0xf5, 0x51, 0x28, 0x37, 0x7e, 0xae, 0x86, 0xae,
+	0xc2, 0x0d, 0x7f, 0x26, 0x97, 0x2d, 0xdf, 0xf2, 0x73, 0x1e, 0xd4, 0xe2, 0x6b, 0x2e, 0x4d, 0x9d,
+	0x92, 0xf0, 0xb3, 0x1f, 0xfb, 0x70, 0xdf, 0xe3, 0xe0, 0x3c, 0x35, 0x95, 0xb1, 0x89, 0x41, 0xe8,
+	0xa8, 0xd6, 0x89, 0x16, 0x64, 0x45, 0x47, 0x8e, 0x77, 0x5c, 0xe2, 0xde, 0x53, 0x73, 0x1f, 0x49,
+	0x7b, 0x84, 0x92, 0xe7, 0xf8, 0x4d, 0x98, 0xf7, 0x67, 0xd7, 0xe9, 0xbc, 0xfa, 0x0c, 0x99, 0x57,
+	0x9f, 0xad, 0x30, 0xf5, 0x90, 0x79, 0xac, 0x39, 0x8f, 0x88, 0x4e, 0xaa, 0xbf, 0x0b, 0x2b, 0x67,
+	0xba, 0x3d, 0x51, 0x87, 0xb8, 0x77, 0x2f, 0x66, 0x82, 0x71, 0x89, 0x62, 0xf7, 0x74, 0x83, 0xaf,
+	0x8c, 0x2b, 0xa5, 0x3e, 0x0b, 0x94, 0xca, 0x07, 0x4a, 0xa9, 0xcf, 0xf8, 0x52, 0x75, 0xb8, 0xc5,
+	0x4a, 0x45, 0x1b, 0xaa, 0xd8, 0x8e, 0x36, 0x66, 0x53, 0xaa, 0xeb, 0x94, 0x2a, 0xd2, 0xc6, 0xae,
+	0xa3, 0x8d, 0x71, 0xf6, 0x2d, 0x3c, 0x99, 0xae, 0x3e, 0x55, 0xe9, 0xba, 0x61, 0x4e, 0x16, 0x83,
+	0x73, 0xe7, 0xb5, 0xa7, 0xea, 0x73, 0x5c, 0x39, 0xf2, 0x49, 0xe9, 0x7a, 0x0e, 0x7d, 0x55, 0xf6,
+	0x56, 0x52, 0x6b, 0x04, 0x2a, 0xfe, 0x22, 0xf8, 0xeb, 0x0e, 0xca, 0xb1, 0x6a, 0x30, 0x35, 0x16,
+	0x89, 0x1a, 0xe7, 0x5d, 0x35, 0xee, 0xa8, 0x06, 0xd1, 0xe4, 0x82, 0x47, 0xcb, 0x20, 0xb6, 0x28,
+	0x05, 0x96, 0x51, 0x9f, 0x62, 0x3a, 0xa4, 0x2c, 0xe6, 0x08, 0x8b, 0x05, 0x97, 0x45, 0x17, 0x51,
+	0xa1, 0x85, 0x0e, 0x0f, 0x66, 0x8b, 0x1f, 0xc0, 0x9a, 0xcf, 0xa6, 0x3f, 0xb1, 0x1d, 0x73, 0xc4,
+	0x89, 0x33, 0x4f, 0x52, 0xeb, 0xaa, 0x47, 0x51, 0x27, 0x04, 0x9e, 0x0c, 0x1f, 0xc0, 0x42, 0x78,
+	0x61, 0xd4, 0x2e, 0x09, 0xa4, 0xfe, 0x39, 0xb7, 0x7e, 0xba, 0x90, 0x24, 0x0b, 0xa1, 0x35, 0x52,
+	0x5b, 0x7c, 0x1f, 0x4a, 0x91, 0x9a, 0x5d, 0x1e, 0x0b, 0xa4, 0xde, 0x95, 0x50, 0xbd, 0x6e, 0xc9,
+	0x84, 0xdc, 0x27, 0x5e, 0x96, 0xfb, 0x16, 0x2f, 0xce, 0x7d, 0x4b, 0x57, 0x7b, 0x19, 0x92, 0xe0,
+	0x36, 0xf3, 0xac, 0x98, 0x70, 0xa4, 0xae, 0x45, 0xe7, 0x5b, 0x6f, 0x50, 0xb2, 0x68, 0x44, 0x12,
+	0xdf, 0xda, 0x82, 0xeb, 0x91, 0xb8, 0x3e, 0x9d, 0x8c, 0xf4, 0x81, 0xee, 0x3c, 0x67, 0xb3, 0xc6,
+	0xab, 0xa1, 0xa0, 0xde, 0x65, 0x68, 0x4e, 0x75, 0xb6, 0xeb, 0xde, 0x5e, 0xd1, 0x55, 0x52, 0x74,
+	0xc5, 0x0b, 0x62, 0x82, 0xf6, 0x4a, 0x56, 0x60, 0x91, 0x0b, 0x41, 0xaf, 0x50, 0x89, 0x08, 0xbc,
+	0xe0, 0xc5, 0x5f, 0x1c, 0xbd, 0xfa, 0xcc, 0xa7, 0xbf, 0x1e, 0xa0, 0x57, 0x9f, 0xb9, 0xf4, 0x5b,
+	0x4b, 0xe7, 0xd5, 0x0a, 0x49, 0xd6, 0x05, 0xb2, 0xf1, 0xa1, 0xd5, 0xdc, 0xab, 0xf5, 0xa4, 0xf2,
+	0x4f, 0xb2, 0xb0, 0xe4, 0xba, 0xe3, 0x25, 0xef, 0x7f, 0x1b, 0x30, 0x45, 0x66, 0xc5, 0xe9, 0xe8,
+	0x2b, 0x98, 0x4f, 0x08, 0x46, 0xfc, 0x1a, 0x2c, 0xc6, 0xe5, 0x41, 0x3a, 0x6e, 0x11, 0xfb, 0xd1,
+	0xfc, 0xf7, 0x36, 0x88, 0x31, 0xc9, 0x8e, 0xae, 0x99, 0x2c, 0x38, 0x91, 0xe4, 0xf6, 0x2e, 0xac,
+	0x44, 0xc9, 0x95, 0xa1, 0xf9, 0x94, 0xcd, 0xf7, 0x2f, 0x45, 0x8a, 0xb4, 0xcc, 0xa7, 0xe2, 0x7b,
+	0xb0, 0x1a, 0x53, 0xea, 0x54, 0x3f, 0x39, 0x65, 0x8b, 0x2e, 0xcb, 0x91, 0x62, 0xbb, 0xfa, 0xc9,
+	0x29, 0xbe, 0xce, 0xb1, 0x14, 0x43, 0x32, 0x0b, 0x9d, 0xa4, 0x61, 0x43, 0x35, 0x92, 0x51, 0x5e,
+	0x83, 0x69, 0xd5, 0x5f, 0x39, 0xe6, 0x02, 0x8b, 0x26, 0x12, 0x99, 0x61, 0xc5, 0x4d, 0xc8, 0xb9,
+	0x71, 0xeb, 0x6d, 0x8a, 0x0b, 0x65, 0x91, 0x99, 0x63, 0xfa, 0x43, 0xfc, 0x3a, 0x80, 0x9f, 0x31,
+	0xbc, 0x29, 0xc5, 0x48, 0xc2, 0xc8, 0xdb, 0xee, 0x4f, 0x5c, 0x2b, 0x0e, 0x25, 0x07, 0x92, 0x04,
+	0xf3, 0x72, 0xb1, 0xcf, 0xa7, 0x04, 0x94, 0x96, 0xc6, 0x70, 0x69, 0x36, 0x28, 0x2d, 0x4b, 0x03,
+	0x0c, 0x8b, 0x03, 0xf9, 0x40, 0xc8, 0xbb, 0x1b, 0x46, 0xfb, 0x5c, 0xa0, 0x93, 0xa5, 0x8c, 0x70,
+	0x64, 0xcc, 0xb1, 0xa5, 0x8c, 0x50, 0x44, 0xbc, 0x0e, 0xf3, 0xe1, 0x40, 0x98, 0x27, 0x94, 0x73,
+	0x4e, 0x20, 0x00, 0xb6, 0x56, 0xcf, 0xab, 0x5f, 0x8b, 0x38, 0x28, 0xf6, 0xf3, 0x3f, 0xcc, 0xc1,
+	0x32, 0x93, 0xf5, 0xd2, 0xe9, 0x23, 0xb6, 0x43, 0xc8, 0x73, 0x54, 0xba, 0x43, 0x88, 0x28, 0xc0,
+	0xf5, 0xdf, 0x4c, 0xa2, 0xff, 0xbe, 0x0b, 0x2b, 0x58, 0x38, 0xc1, 0x25, 0x73, 0x32, 0x2e, 0xf8,
+	0x44, 0xbb, 0xdc, 0x78, 0x27, 0xce, 0x26, 0x39, 0xf1, 0x07, 0xb0, 0x16, 0x5f, 0x09, 0x71, 0x64,
+	0xda, 0xd1, 0xaf, 0xc6, 0x55, 0x84, 0xbe, 0x9c, 0x1c, 0x01, 0x33, 0x17, 0x44, 0xc0, 0x87, 0xb0,
+	0x9e, 0x50, 0x25, 0x89, 0x02, 0x3a, 0xfb, 0x5d, 0x8a, 0xab, 0x93, 0x04, 0xc2, 0x05, 0x01, 0x94,
+	0xbf, 0x28, 0x80, 0xd8, 0x2e, 0x06, 0x3e, 0x88, 0xfc, 0xed, 0x10, 0x2d, 0x3f, 0x8e, 0x42, 0x81,
+	0x56, 0x88, 0x04, 0xda, 0x06, 0xdd, 0x35, 0xe6, 0xf9, 0x37, 0xed, 0xb7, 0x71, 0x01, 0xc4, 0x75,
+	0x6e, 0x3e, 0xc4, 0x8a, 0x97, 0x84, 0x18, 0x5b, 0x2d, 0xe2, 0xc2, 0x6c, 0xce, 0x5b, 0x2d, 0xf2,
+	0x22, 0x2c, 0x14, 0x88, 0xf3, 0x57, 0x08, 0xc4, 0xb7, 0x01, 0xd7, 0x88, 0xc2, 0x3d, 0x35, 0x5b,
+	0xe2, 0xc1, 0xa5, 0x9f, 0x7a, 0x28, 0x1e, 0x23, 0x71, 0xbb, 0x10, 0x17, 0xb7, 0x6c, 0xa5, 0x87,
+	0x05, 0xa3, 0xbf, 0xd2, 0xc3, 0x22, 0xd1, 0x0f, 0xeb, 0xc5, 0x0b, 0xc3, 0x7a, 0x13, 0x16, 0x38,
+	0xe9, 0x58, 0x11, 0xba, 0x56, 0x39, 0xef, 0xc9, 0xb6, 0x9f, 0x90, 0x02, 0x96, 0x63, 0x52, 0x40,
+	0x85, 0x36, 0x37, 0x1c, 0xdb, 0xb4, 0x7f, 0x5c, 0xf0, 0x9c, 0xe9, 0xa2, 0x3c, 0xb0, 0x9a, 0x94,
+	0x07, 0xbe, 0x4e, 0x06, 0xfd, 0xe1, 0x3c, 0xf0, 0x27, 0x99, 0xe0, 0x7b, 0x5d, 0x7b, 0x32, 0x3a,
+	0xd2, 0xbe, 0xdc, 0x89, 0xde, 0x75, 0xc0, 0x2d, 0x17, 0x6c, 0x13, 0x08, 0xed, 0x4d, 0x72, 0x23,
+	0xdd, 0xa0, 0x1b, 0x3e, 0x10, 0xa9, 0x3e, 0xe3, 0x76, 0x88, 0x20, 0x52, 0x7d, 0x46, 0x91, 0x64,
+	0x6f, 0xa2, 0x36, 0x66, 0xe3, 0x6b, 0xf2, 0xfb, 0xa5, 0xf7, 0xd2, 0xc5, 0x8c, 0x94, 0xe0, 0x6a,
+	0x23, 0xa5, 0x84, 0xd5, 0xb5, 0x42, 0xd2, 0xea, 0xda, 0x6d, 0x98, 0xf2, 0x22, 0x6f, 0xee, 0x61,
+	0xa1, 0x42, 0x55, 0xcf, 0xa5, 0xce, 0xf0, 0x3c, 0x74, 0x31, 0x76, 0xbd, 0xe1, 0x1b, 0xfe, 0xda,
+	0x40, 0xfb, 0x60, 0x6f, 0x5b, 0x22, 0xab, 0x48, 0x94, 0xd7, 0xe7, 0xbb, 0x8a, 0xf4, 0x30, 0x5c,
+	0x31, 0x5b, 0x94, 0xa0, 0x75, 0xbf, 0xdc, 0xa2, 0x44, 0x9a, 0x5b, 0x94, 0x78, 0xc7, 0x5f, 0x94,
+	0xf0, 0xf9, 0xfe, 0x61, 0x78, 0x75, 0x4a, 0x1b, 0xf2, 0x87, 0x8e, 0xbe, 0x0c, 0x3f, 0x2d, 0xc1,
+	0x0c, 0x3b, 0x84, 0x46, 0xa6, 0x7f, 0xf2, 0xb2, 0xfb, 0xf8, 0xc5, 0x2d, 0x49, 0xa1, 0xfd, 0xdf,
+	0xe5, 0x17, 0xf3, 0x5a, 0x52, 0xbd, 0x47, 0x57, 0x11, 0x51, 0x3d, 0x9f, 0xe3, 0xea, 0xc3, 0xca,
+	0x79, 0xf5, 0x9b, 0xe1, 0x8a, 0xdd, 0x45, 0x29, 0x52, 0xf7, 0xcb, 0xd9, 0x3f, 0xcf, 0xd9, 0xff,
+	0x3d, 0x6e, 0x51, 0xca, 0xe3, 0xfb, 0xd3, 0x74, 0x70, 0x7f, 0x5d, 0x57, 0xb7, 0x34, 0xe3, 0xff,
+	0xaa, 0xf5, 0xa8, 0x25, 0xc8, 0x3a, 0xa6, 0xc1, 0xe6, 0x0e, 0xf2, 0x32, 0x7d, 0x08, 0x1c, 0x30,
+	0x18, 0x4c, 0xd8, 0x2e, 0xf5, 0x5c, 0xf0, 0x80, 0x41, 0x83, 0xc1, 0x03, 0xaf, 0xdb, 0x67, 0xe6,
+	0x70, 0x32, 0xd2, 0x4a, 0xf9, 0xe0, 0xeb, 0xf6, 0x21, 0x81, 0xbe, 0x7a, 0x12, 0xc3, 0xbd, 0x62,
+	0xdf, 0xf2, 0x77, 0x23, 0x75, 0x9b, 0xb2, 0xd4, 0x2e, 0x77, 0x41, 0x24, 0xea, 0x7e, 0xb5, 0xe5,
+	0xcb, 0xe5, 0xf3, 0xea, 0xfb, 0x21, 0x8e, 0x68, 0xd0, 0xdf, 0x4d, 0xc3, 0x22, 0xe1, 0xfa, 0x79,
+	0xec, 0x6f, 0x72, 0xf7, 0xb4, 0xe3, 0xe4, 0xda, 0x94, 0xbf, 0xa7, 0xdd, 0x34, 0x48, 0x1f, 0x41,
+	0xc0, 0xcc, 0x94, 0xf8, 0xdb, 0xdd, 0x41, 0xef, 0xe9, 0x7f, 0xda, 0xdb, 0x41, 0xef, 0xa9, 0x7e,
+	0x0d, 0x72, 0x1e, 0x7a, 0x86, 0x6c, 0x1d, 0xf1, 0x9e, 0xdd, 0xd1, 0x04, 0xb3, 0x48, 0xce, 0x1b,
+	0x4d, 0x30, 0x63, 0xac, 0xc0, 0x34, 0x67, 0xac, 0xb4, 0xcc, 0x9e, 0x50, 0x31, 0xbf, 0xe0, 0xef,
+	0x1b, 0xf1, 0x14, 0xf3, 0xe3, 0x4c, 0xf0, 0x88, 0x55, 0xcb, 0xec, 0x7f, 0xf2, 0x15, 0x59, 0x78,
+	0x8d, 0xac, 0xf7, 0xe7, 0x62, 0xd6, 0xfb, 0xf9, 0xf3, 0x29, 0xe6, 0x58, 0x33, 0x4a, 0xf9, 0xe0,
+	0xf9, 0x94, 0xce, 0x58, 0x23, 0x3b, 0x07, 0x2c, 0xed, 0xd3, 0x89, 0x6e, 0x69, 0xb8, 0x0f, 0x6a,
+	0xe0, 0x6e, 0xcd, 0x9a, 0x75, 0x81, 0x75, 0xb6, 0xd3, 0x17, 0x71, 0xca, 0xb1, 0x69, 0x8d, 0x54,
+	0xb7, 0xa3, 0x06, 0x04, 0xed, 0x10, 0xc8, 0xd6, 0xc2, 0x79, 0x75, 0x8b, 0xb8, 0x6e, 0x8e, 0xec,
+	0xec, 0xe9, 0xd4, 0x3f, 0x2a, 0x7f, 0x1f, 0x16, 0xd0, 0x20, 0x97, 0xbf, 0xd7, 0x67, 0xf9, 0xb3,
+	0x2d, 0x50, 0xf1, 0x0b, 0xb1, 0xb0, 0x58, 0x3a, 0xaf, 0x7e, 0x10, 0xe4, 0x8d, 0xc6, 0xff, 0x9d,
+	0x14, 0x88, 0x48, 0x7a, 0x69, 0x50, 0xbc, 0x06, 0x33, 0xfc, 0x99, 0x12, 0x7c, 0xf7, 0xe2, 0xcb,
+	0xb9, 0x48, 0x37, 0x12, 0xfa, 0xee, 0x4b, 0x1a, 0x8d, 0x04, 0xd2, 0x7c, 0x11, 0xa6, 0x08, 0x98,
+	0x9d, 0x09, 0xc6, 0xdf, 0x28, 0xd5, 0xb7, 0x89, 0x4f, 0x06, 0xa4, 0xfa, 0xe3, 0x50, 0xe7, 0xbb,
+	0x3d, 0x71, 0x1c, 0xd3, 0xf8, 0xf9, 0x6e, 0x00, 0x6e, 0x14, 0xf6, 0xa1, 0xdf, 0x19, 0x6e, 0x1f,
+	0xf4, 0x7a, 0x9d, 0x76, 0xb9, 0x0a, 0x4b, 0x54, 0x4f, 0x97, 0xd9, 0x12, 0xfb, 0xbc, 0xef, 0xf8,
+	0x7d, 0x1e, 0x2d, 0x8e, 0x6a, 0xff, 0x77, 0x29, 0x58, 0xdb, 0xd3, 0x06, 0xba, 0xba, 0x3f, 0x54,
+	0x9f, 0x6b, 0x56, 0xd7, 0x9b, 0x14, 0x25, 0xde, 0x89, 0x79, 0x85, 0x79, 0x2e, 0xd5, 0x39, 0x7b,
+	0x22, 0x4b, 0xe6, 0xea, 0x68, 0x3c, 0xd4, 0x14, 0xcb, 0xf5, 0xc0, 0xa2, 0x0c, 0x14, 0x24, 0xb3,
+	0x6d, 0x58, 0xc6, 0x64, 0xa4, 0xf4, 0x4f, 0x55, 0xc3, 0xd0, 0x86, 0x36, 0x3b, 0xc9, 0x5e, 0x30,
+	0x26, 0xa3, 0x3a, 0x03, 0x89, 0xef, 0xc0, 0xcc, 0x78, 0x62, 0x8d, 0x4d, 0x5b, 0x63, 0xc7, 0x2b,
+	0xae, 0x57, 0x38, 0x49, 0xa8, 0x00, 0xfb, 0x94, 0x40, 0x76, 0x29, 0xb7, 0x16, 0xd9, 0x9a, 0xc2,
+	0x9e, 0xd4, 0x68, 0xd6, 0x94, 0xfd, 0x56, 0xed, 0x89, 0x24, 0x97, 0x7f, 0x94, 0x81, 0xdb, 0xbc,
+	0xef, 0x70, 0x6c, 0xbe, 0x22, 0x0e, 0xc4, 0x9f, 0x2b, 0x1a, 0xab, 0x13, 0xdb, 0x4d, 0x6b, 0x5e,
+	0x1a, 0xdb, 0x47, 0xa0, 0xb8, 0xcb, 0xcf, 0x2e, 0x53, 0x53, 0xda, 0x64, 0xb1, 0xaf, 0xf0, 0x70,
+	0xbd, 0x92, 0xec, 0x06, 0xdc, 0x54, 0x33, 0x05, 0xd8, 0xb8, 0x84, 0xf4, 0x8b, 0xfe, 0x12, 0x52,
+	0xc0, 0x1a, 0x3f, 0x49, 0x41, 0x89, 0xe7, 0x75, 0x49, 0x1a, 0x7b, 0x3d, 0x98, 0xc6, 0x16, 0x2a,
+	0x91, 0xb2, 0x14, 0xcf, 0xf5, 0x71, 0x19, 0xbe, 0x8f, 0x23, 0x87, 0xd0, 0x27, 0x8e, 0xb7, 0x71,
+	0x83, 0x3e, 0xe0, 0x12, 0x54, 0x35, 0x5e, 0x40, 0x72, 0x5e, 0x26, 0x0d, 0xd7, 0xb9, 0x7a, 0x2e,
+	0x4d, 0x85, 0xb7, 0xa1, 0x40, 0x53, 0x1c, 0x7f, 0xc4, 0x0e, 0x48, 0x96, 0x23, 0x10, 0xf1, 0x6d,
+	0x3f, 0x57, 0xba, 0xa7, 0xea, 0x62, 0xf8, 0xbb, 0x34, 0xa1, 0xee, 0x7c, 0x2a, 0xb9, 0x3b, 0xcf,
+	0x06, 0x9a, 0x5a, 0xa6, 0x47, 0x5f, 0x46, 0xc8, 0x59, 0x99, 0x58, 0x43, 0x6e, 0x14, 0x41, 0x6a,
+	0x3b, 0xb0, 0x86, 0xe4, 0xed, 0xd5, 0xc3, 0xd3, 0xe3, 0x43, 0xb9, 0x91, 0x8b, 0x7c, 0x83, 0xee,
+	0x65, 0x55, 0x0d, 0xc3, 0x9c, 0x18, 0x7d, 0xfa, 0xf2, 0x98, 0xf3, 0x26, 0x13, 0x6a, 0x1c, 0x18,
+	0x0f, 0xa9, 0x07, 0xc8, 0x58, 0x17, 0xc8, 0xc3, 0x50, 0xc9, 0x35, 0x7f, 0x9d, 0x2f, 0xac, 0x64,
+	0x05, 0xde, 0xf0, 0xf6, 0x5f, 0x78, 0x67, 0xe9, 0x5b, 0x52, 0x6d, 0x70, 0xa6, 0x59, 0x8e, 0x6e,
+	0x13, 0x16, 0xde, 0xae, 0xa1, 0x25, 0xc8, 0xd2, 0xd3, 0xe6, 0xf4, 0xaa, 0x0a, 0xfa, 0x80, 0x15,
+	0x6c, 0x93, 0x0a, 0x16, 0x49, 0xda, 0x6a, 0x1d, 0x48, 0xbd, 0x4e, 0xa7, 0xb7, 0xab, 0xec, 0xcb,
+	0x9d, 0xef, 0x3d, 0x29, 0xf7, 0x61, 0xc9, 0xe3, 0xcb, 0x56, 0x97, 0x1b, 0x6c, 0xcd, 0x73, 0x32,
+	0xf1, 0xc2, 0x9c, 0xfc, 0xc6, 0x75, 0x70, 0x36, 0x59, 0xe5, 0xed, 0x86, 0x29, 0x92, 0xf3, 0x1a,
+	0x6c, 0xc2, 0xca, 0x2d, 0x48, 0xb0, 0x19, 0x7f, 0x01, 0xb5, 0xfc, 0xef, 0xd3, 0xb0, 0x91, 0x24,
+	0x3d, 0xbf, 0xfd, 0xc6, 0xbd, 0x79, 0x01, 0x2b, 0x9d, 0x92, 0xdd, 0xc7, 0xc0, 0x5d, 0x0d, 0xb3,
+	0x2c, 0x91, 0x88, 0x30, 0x65, 0xd9, 0xb6, 0x4e, 0xaa, 0x59, 0x90, 0xc9, 0x6f, 0x32, 0xe4, 0xa0,
+	0x4d, 0x50, 0x50, 0x5e, 0xba, 0x7b, 0x26, 0x2f, 0xcf, 0x32, 0xe0, 0x01, 0xc2, 0xc4, 0xf7, 0xc1,
+	0x7d, 0xa6, 0xad, 0xc8, 0x92, 0xd0, 0x5d, 0xae, 0xc4, 0x69, 0x41, 0x2e, 0xd8, 0xfe, 0x83, 0xb8,
+	0x0d, 0x0b, 0xfc, 0xf5, 0x02, 0xb4, 0xf8, 0xf4, 0x45, 0xc5, 0x05, 0x9e, 0x9e, 0xf0, 0xb8, 0x03,
+	0xb3, 0xac, 0x55, 0x74, 0xe7, 0x2b, 0x1d, 0xa3, 0x16, 0x18, 0x0c, 0xb7, 0x72, 0x6c, 0xdd, 0x3e,
+	0xaf, 0xd6, 0x49, 0xd4, 0xc5, 0xd9, 0x0b, 0x7d, 0xe2, 0x3f, 0xa5, 0x60, 0xc5, 0xab, 0x8e, 0xde,
+	0x7f, 0xe1, 0x7a, 0x40, 0xb2, 0x0e, 0xbf, 0x03, 0x64, 0x50, 0xa5, 0xd9, 0x6c, 0xcb, 0x2d, 0xcd,
+	0x14, 0xeb, 0x95, 0x78, 0x46, 0x28, 0x88, 0x5c, 0xb0, 0xfc, 0x07, 0x77, 0x07, 0x77, 0x40, 0xf8,
+	0x8c, 0xb7, 0x83, 0xbb, 0xe6, 0xcb, 0x1f, 0x69, 0xe2, 0x54, 0xb4, 0x89, 0x37, 0xce, 0xab, 0x8d,
+	0x64, 0x97, 0xfc, 0x49, 0x0a, 0xee, 0x84, 0xc4, 0x62, 0x97, 0x97, 0xe8, 0xdc, 0x68, 0x26, 0xb9,
+	0xa9, 0x37, 0x20, 0xcf, 0x2e, 0x20, 0xd1, 0xdc, 0x34, 0xe3, 0x03, 0x30, 0x31, 0x8d, 0x9c, 0x09,
+	0xeb, 0x4c, 0xf1, 0x27, 0x86, 0x8d, 0x66, 0x59, 0xa6, 0x45, 0x24, 0xcd, 0xca, 0xf4, 0x01, 0x65,
+	0x94, 0x12, 0xcd, 0x50, 0x7e, 0x02, 0xb7, 0x3d, 0x11, 0x1f, 0xd5, 0x7a, 0xbd, 0x47, 0x5a, 0x78,
+	0xeb, 0x5a, 0xb2, 0x80, 0xc8, 0x7a, 0x27, 0xb9, 0xf9, 0x12, 0xac, 0x06, 0x58, 0x37, 0x34, 0x0c,
+	0xff, 0xb1, 0x63, 0x5a, 0x5c, 0x50, 0x66, 0x1e, 0x4c, 0xb1, 0xa0, 0x5c, 0x81, 0xe9, 0x53, 0xd5,
+	0x18, 0x0c, 0xdd, 0x11, 0x04, 0x7b, 0x2a, 0xff, 0x7e, 0x0a, 0xd6, 0x03, 0x7c, 0xea, 0xa7, 0xaa,
+	0xa5, 0xf6, 0x1d, 0xcd, 0xd2, 0x6d, 0x47, 0xef, 0xbf, 0x0c, 0x2f, 0x3c, 0x60, 0x32, 0xb6, 0xf0,
+	0x74, 0x36, 0x8e, 0x0c, 0x98, 0xea, 0x38, 0x88, 0xb8, 0x05, 0x85, 0x81, 0x27, 0xa5, 0xbf, 0x69,
+	0x2d, 0xa1, 0x19, 0x32, 0x4f, 0x5c, 0xfe, 0xed, 0x14, 0x2c, 0x05, 0x08, 0x99, 0x1e, 0x5f, 0x4a,
+	0xc0, 0x1d, 0x98, 0xef, 0x07, 0x9a, 0xe7, 0xee, 0x5e, 0xba, 0x51, 0xb9, 0x40, 0x07, 0x72, 0xb8,
+	0x50, 0xf9, 0x6f, 0xa4, 0x60, 0x23, 0x50, 0x20, 0x60, 0xd7, 0x4b, 0x3d, 0xef, 0x1b, 0x90, 0x63,
+	0x09, 0xc3, 0xdd, 0xb9, 0xb3, 0x5c, 0x89, 0x6b, 0x9b, 0xec, 0x91, 0xa1, 0x2f, 0x3c, 0x4a, 0x76,
+	0xb3, 0x5f, 0x85, 0x7b, 0x49, 0xe2, 0xf0, 0x57, 0x7a, 0x5c, 0xec, 0x6b, 0xbb, 0xc9, 0xfc, 0x3f,
+	0x86, 0x52, 0x80, 0xbf, 0xac, 0xa9, 0x83, 0xcb, 0x73, 0x49, 0x82, 0x15, 0xb0, 0xae, 0x66, 0xb2,
+	0x5f, 0xff, 0x1a, 0x5c, 0x8f, 0xa9, 0xeb, 0x52, 0x9d, 0x26, 0x99, 0x3c, 0xa6, 0x9f, 0x41, 0x01,
+	0x7e, 0x29, 0xb9, 0xb1, 0x3f, 0x4e, 0x85, 0x24, 0x78, 0x6c, 0xe9, 0x8e, 0xf6, 0xca, 0xcd, 0xc5,
+	0xb9, 0x06, 0x8b, 0xc9, 0xcf, 0x52, 0xa1, 0xf7, 0xec, 0x49, 0x37, 0x15, 0x94, 0xee, 0xa3, 0x64,
+	0xf5, 0x38, 0x50, 0x8e, 0xa8, 0x87, 0x8b, 0x99, 0x9f, 0xc5, 0x28, 0xad, 0xe4, 0x5a, 0x7f, 0x2b,
+	0x05, 0x77, 0xa3, 0x3a, 0xf9, 0x0c, 0xea, 0x4d, 0xb2, 0xcf, 0x5e, 0xb2, 0x2c, 0xbf, 0x99, 0x82,
+	0xb5, 0x80, 0x2c, 0x6d, 0xd3, 0xd1, 0x8f, 0x9f, 0xbf, 0xba, 0x08, 0x78, 0x12, 0xc8, 0xc0, 0xf7,
+	0x03, 0x66, 0x1e, 0xf6, 0x84, 0x62, 0xb4, 0x93, 0xc5, 0xf8, 0x61, 0x0a, 0x6e, 0xc7, 0x88, 0x41,
+	0x3a, 0xf4, 0xcf, 0xdc, 0x5d, 0x3b, 0xc9, 0xee, 0xba, 0x0b, 0x0f, 0xa2, 0x43, 0x3f, 0xbf, 0x23,
+	0xb4, 0x77, 0x2c, 0xcd, 0xdb, 0x9c, 0x7a, 0xe3, 0xbc, 0xba, 0x9f, 0xdc, 0xa2, 0xbf, 0xce, 0x67,
+	0xb5, 0x08, 0x07, 0xdf, 0x63, 0x8f, 0x2d, 0x4d, 0x63, 0x63, 0x47, 0xf2, 0x1b, 0x7b, 0xc6, 0xa1,
+	0x3e, 0xd2, 0x1d, 0xd6, 0x16, 0xfa, 0x40, 0x36, 0xd1, 0x0e, 0x87, 0x26, 0xbe, 0x5f, 0x0d, 0x48,
+	0x9a, 0x9d, 0x92, 0x7d, 0x00, 0x8a, 0xf2, 0xdd, 0xe4, 0x46, 0xfd, 0x7a, 0xd8, 0xc6, 0x12, 0x76,
+	0xb6, 0x3f, 0x83, 0x5e, 0xbd, 0xce, 0x3b, 0x13, 0xea, 0xbc, 0xe5, 0x64, 0x21, 0x86, 0xb0, 0x16,
+	0xf5, 0xf9, 0x57, 0x97, 0x01, 0x6b, 0xeb, 0x26, 0xd7, 0x36, 0x82, 0xf5, 0x18, 0x77, 0xfa, 0xd9,
+	0xaa, 0xeb, 0x25, 0x57, 0xf7, 0x57, 0x53, 0x70, 0x2b, 0x34, 0x7a, 0xda, 0x57, 0x75, 0x8b, 0xbb,
+	0x5c, 0xed, 0xe2, 0x2a, 0xc7, 0x2a, 0x9e, 0x92, 0x76, 0x37, 0x23, 0xd2, 0xa7, 0x64, 0x2d, 0x1f,
+	0x24, 0x0b, 0xf2, 0xdb, 0xbc, 0xd7, 0x51, 0x41, 0x0e, 0x8c, 0xf1, 0x95, 0x45, 0xc1, 0xdd, 0xf8,
+	0x93, 0x7e, 0x1f, 0x31, 0x6c, 0x33, 0x03, 0x7b, 0x4c, 0x16, 0xe6, 0x30, 0x59, 0x98, 0x8f, 0xe0,
+	0xcd, 0x03, 0xc3, 0xbe, 0xea, 0x9b, 0x14, 0x32, 0x7b, 0x9c, 0x1c, 0x4f, 0x3f, 0x8a, 0x19, 0xa0,
+	0x0e, 0x35, 0xd5, 0xaa, 0xab, 0xfd, 0x53, 0xed, 0xf3, 0x69, 0xda, 0xf7, 0x2e, 0x0c, 0xa9, 0x75,
+	0xae, 0x41, 0xb2, 0xfa, 0x34, 0xd0, 0xa6, 0x8b, 0xdf, 0xab, 0xc8, 0x3b, 0x54, 0x9a, 0x7b, 0x87,
+	0x0a, 0x8f, 0xde, 0x33, 0x91, 0xd1, 0x7b, 0x5c, 0xdf, 0x86, 0x03, 0xa7, 0x7b, 0x17, 0x08, 0xe1,
+	0x0f, 0x9e, 0x1a, 0x30, 0xa7, 0x06, 0x30, 0xa5, 0x54, 0x78, 0xa0, 0x16, 0x2d, 0x2e, 0x87, 0xca,
+	0xe0, 0x3b, 0xd2, 0xf7, 0x2f, 0x7c, 0x47, 0xb2, 0xe0, 0x96, 0x97, 0x3c, 0x83, 0x57, 0xb6, 0xb9,
+	0xdd, 0xc9, 0x0d, 0xc8, 0x7b, 0xfe, 0xc0, 0xb6, 0xe4, 0xfa, 0x00, 0xff, 0x55, 0x3a, 0x1d, 0x7a,
+	0x95, 0x7e, 0xe2, 0xbb, 0xc5, 0x61, 0xa7, 0x59, 0x97, 0x94, 0x5a, 0xb7, 0xdb, 0xec, 0xf6, 0x6a,
+	0xed, 0x5e, 0xf9, 0x1f, 0xa5, 0x60, 0x3d, 0x58, 0x57, 0x6d, 0x32, 0xd0, 0xcd, 0xae, 0xe6, 0xe0,
+	0x9d, 0x1b, 0x36, 0xee, 0x25, 0x31, 0x4c, 0xdd, 0xd6, 0xc8, 0xb6, 0x4d, 0xd4, 0x26, 0x3d, 0x38,
+	0xeb, 0x1e, 0xc1, 0x29, 0xca, 0xcb, 0x04, 0xdd, 0xf5, 0xb1, 0xf4, 0x46, 0x8c, 0x75, 0xc8, 0xab,
+	0x13, 0xc7, 0x54, 0x4e, 0x54, 0xdd, 0x60, 0xf2, 0xe4, 0x10, 0xf0, 0x48, 0xd5, 0xf1, 0x92, 0x8e,
+	0x05, 0x3a, 0xb1, 0xa1, 0x8c, 0x26, 0x43, 0x47, 0x1f, 0x0f, 0x75, 0xcd, 0x62, 0x93, 0x3b, 0x02,
+	0x45, 0xec, 0x79, 0xf0, 0xf2, 0x4f, 0x53, 0xb0, 0x1c, 0xaf, 0x0d, 0xba, 0x3e, 0x63, 0x39, 0x4c,
+	0x13, 0xf4, 0x01, 0x17, 0xb2, 0xfa, 0xa6, 0x81, 0xf7, 0xe0, 0xd1, 0x7b, 0x0c, 0xf5, 0x01, 0x5b,
+	0x05, 0x9c, 0xe3, 0xc1, 0xf4, 0xb4, 0x05, 0x55, 0x57, 0x86, 0x53, 0x97, 0x58, 0x87, 0x39, 0x15,
+	0x35, 0xa0, 0xd8, 0x4c, 0x05, 0xc4, 0x65, 0xd0, 0xd6, 0x17, 0xa8, 0x49, 0x2e, 0xaa, 0xfc, 0x23,
+	0xbe, 0x78, 0x3e, 0x55, 0x3f, 0xd1, 0x14, 0xbc, 0xb5, 0x51, 0x19, 0x9f, 0x5a, 0xaa, 0xed, 0x2e,
+	0x0a, 0xcd, 0x21, 0xfc, 0xb1, 0x69, 0x0d, 0xf6, 0x09, 0x14, 0xad, 0xf3, 0xcb, 0xbe, 0x53, 0x84,
+	0xad, 0xf3, 0x03, 0x58, 0x09, 0x37, 0xdd, 0xef, 0xf9, 0xc6, 0x26, 0x6b, 0x7a, 0x51, 0x26, 0xbf,
+	0xfd, 0x40, 0x64, 0x6b, 0x64, 0x5e, 0x20, 0xfe, 0x7f, 0xc9, 0xf6, 0xaf, 0xc3, 0x6a, 0xb0, 0x06,
+	0x72, 0xf8, 0xc6, 0x9d, 0x14, 0x89, 0x1c, 0xbc, 0x88, 0x3f, 0xa5, 0xf4, 0x7b, 0x11, 0x27, 0x22,
+	0x5c, 0x3c, 0x61, 0xdf, 0x01, 0x20, 0x47, 0x7c, 0x68, 0x74, 0xa6, 0xd8, 0xc1, 0xd9, 0xb8, 0x12,
+	0x79, 0x42, 0x47, 0x22, 0x36, 0x7c, 0x7e, 0x29, 0x41, 0x4c, 0x7f, 0x58, 0xf2, 0x2b, 0xc9, 0xad,
+	0x7c, 0x02, 0x8b, 0x31, 0xd6, 0xf3, 0x92, 0x42, 0x8a, 0xdb, 0x37, 0x2f, 0x40, 0x46, 0xf3, 0x66,
+	0xfa, 0xf0, 0x27, 0xb2, 0xfe, 0xf8, 0x45, 0xf5, 0x5a, 0x02, 0xeb, 0xbf, 0x99, 0x86, 0x8d, 0x20,
+	0x6f, 0x3c, 0x66, 0x63, 0x05, 0x15, 0xf0, 0x7e, 0x8c, 0x02, 0xae, 0x57, 0x12, 0x8b, 0x71, 0x5a,
+	0xb8, 0x0e, 0x39, 0x07, 0x11, 0xbe, 0x1b, 0xcf, 0x90, 0xe7, 0xe6, 0x20, 0x76, 0xaa, 0xfa, 0x2e,
+	0x14, 0x1d, 0xd3, 0x51, 0x87, 0xde, 0xa9, 0x1e, 0x3a, 0x91, 0x31, 0x4b, 0x80, 0xec, 0x54, 0x0f,
+	0x39, 0xf5, 0x41, 0x7f, 0x2a, 0x43, 0xed, 0x98, 0x9e, 0x74, 0x28, 0xca, 0x05, 0x06, 0x6b, 0x69,
+	0xc7, 0x0e, 0x86, 0xaf, 0x4e, 0xb7, 0x5d, 0x9f, 0xb9, 0x9b, 0xd9, 0x73, 0x3a, 0xd9, 0x70, 0x7d,
+	0x46, 0x7c, 0xd6, 0x4e, 0xd6, 0xf5, 0x3f, 0xcb, 0xc0, 0x7d, 0x7e, 0x52, 0xbe, 0x36, 0x54, 0xad,
+	0x51, 0xdd, 0x34, 0x1c, 0xcb, 0x1c, 0xee, 0xab, 0x86, 0x36, 0xfc, 0x8a, 0x4c, 0xcd, 0xfb, 0x77,
+	0x79, 0xe1, 0x9c, 0x3b, 0xbd, 0x35, 0x93, 0xae, 0xf0, 0x14, 0xf9, 0x4d, 0xe8, 0x0c, 0x11, 0x5d,
+	0x55, 0xcc, 0xc7, 0xac, 0x2a, 0x7e, 0x0d, 0x96, 0x02, 0x44, 0x8a, 0x63, 0x2a, 0xaa, 0x35, 0x62,
+	0x2b, 0x90, 0x0b, 0x3c, 0x6d, 0xcf, 0xac, 0x59, 0xa3, 0xad, 0x8d, 0xf3, 0xea, 0xaf, 0x92, 0xf4,
+	0xb2, 0x8a, 0xa6, 0xaa, 0xb5, 0x6a, 0xf2, 0x9e, 0x52, 0xef, 0xb4, 0x7b, 0x72, 0xa7, 0xa5, 0xec,
+	0xd7, 0xda, 0x52, 0x8b, 0x0c, 0xbd, 0x22, 0x26, 0xba, 0x6c, 0xee, 0xfe, 0xed, 0xe0, 0xdc, 0xfd,
+	0x6a, 0x25, 0x81, 0x03, 0x5b, 0x8f, 0xbc, 0x7b, 0x5e, 0x55, 0x2e, 0x94, 0x02, 0x7b, 0xbf, 0x7f,
+	0x9c, 0x82, 0xdb, 0x11, 0x36, 0x97, 0x4e, 0xd0, 0xbf, 0x1f, 0x5e, 0xab, 0xbc, 0x95, 0x20, 0x4b,
+	0x64, 0x2a, 0xde, 0x5d, 0xa2, 0xcc, 0x70, 0x4b, 0x94, 0x77, 0xcf, 0xab, 0x3f, 0x20, 0x9e, 0x7d,
+	0x91, 0xa0, 0xbf, 0x1e, 0x5a, 0x44, 0xc7, 0x13, 0xb9, 0x5f, 0x11, 0x9f, 0x66, 0x77, 0x18, 0xb1,
+	0x6b, 0x2e, 0xa8, 0x2f, 0xe3, 0x86, 0x3a, 0xff, 0x92, 0x0b, 0xdc, 0x40, 0xc7, 0xd0, 0x79, 0x86,
+	0x56, 0x9f, 0x31, 0x74, 0x09, 0x66, 0xc6, 0xaa, 0xe3, 0x68, 0x96, 0x7b, 0x9b, 0x9c, 0xfb, 0x28,
+	0xde, 0x64, 0xdb, 0xd5, 0x0a, 0xec, 0x54, 0x2f, 0xaa, 0xd4, 0xdf, 0xac, 0x86, 0x6b, 0xe5, 0xaa,
+	0xbf, 0x9e, 0x8d, 0xc7, 0x91, 0xcb, 0x67, 0xb0, 0x80, 0x44, 0x9f, 0xe3, 0x2e, 0xa4, 0xa5, 0xf3,
+	0xea, 0x51, 0xb0, 0x52, 0x34, 0xbe, 0x0c, 0x22, 0xd6, 0xfb, 0x8a, 0x3b, 0x90, 0x96, 0xce, 0xab,
+	0x7d, 0x7f, 0x09, 0xdc, 0xe5, 0xf9, 0x77, 0xd2, 0xa1, 0x8b, 0x6f, 0xbf, 0xc8, 0x43, 0x51, 0x5f,
+	0xaa, 0x43, 0xe1, 0x65, 0xb2, 0x03, 0xff, 0x16, 0xd7, 0x46, 0xad, 0x27, 0xf5, 0x9a, 0x7b, 0xf4,
+	0x07, 0x4e, 0x06, 0x2f, 0x34, 0xae, 0x70, 0xce, 0x21, 0x62, 0xcd, 0x74, 0xd4, 0x9a, 0xd8, 0xcc,
+	0xe7, 0x9a, 0xea, 0xde, 0xac, 0x4e, 0x7e, 0xd3, 0x5b, 0xac, 0x0d, 0xe7, 0x94, 0x75, 0x92, 0xf4,
+	0x01, 0x2b, 0x18, 0xa8, 0xcf, 0x59, 0xa7, 0x88, 0x3f, 0xf1, 0xaa, 0x59, 0x2d, 0x41, 0x48, 0x34,
+	0xdf, 0x5f, 0x49, 0x81, 0xd8, 0xb8, 0xca, 0x5e, 0x77, 0x57, 0x86, 0x74, 0x9c, 0x0c, 0x99, 0x18,
+	0x19, 0xa6, 0x02, 0x32, 0x1c, 0x13, 0xcf, 0x89, 0x97, 0x21, 0xec, 0x42, 0x81, 0xa3, 0xbe, 0xff,
+	0xcf, 0xbb, 0xd0, 0x49, 0x8c, 0x75, 0xf0, 0x4f, 0xf9, 0x8f, 0x52, 0xb0, 0x80, 0xaa, 0xf8, 0xac,
+	0x5c, 0xe8, 0xd4, 0x9c, 0x78, 0x2e, 0x84, 0xbf, 0x71, 0xee, 0x61, 0xa4, 0x1b, 0x78, 0x30, 0x9b,
+	0xda, 0x8a, 0x3d, 0x21, 0x9c, 0x0e, 0xa7, 0x98, 0x1f, 0xb1, 0x27, 0x34, 0xe3, 0x69, 0x82, 0xb0,
+	0x68, 0xc6, 0xdf, 0x4a, 0x81, 0x88, 0xf2, 0x5e, 0xc5, 0x95, 0x88, 0x2c, 0xe9, 0x58, 0x59, 0x32,
+	0x09, 0xb2, 0x4c, 0x85, 0x65, 0xd1, 0x63, 0x5c, 0xca, 0x95, 0xe5, 0x4f, 0x42, 0xbb, 0x22, 0x83,
+	0x23, 0xda, 0xaf, 0xfa, 0xbe, 0x1c, 0xdc, 0x19, 0xe0, 0x0f, 0xe9, 0x6d, 0x76, 0x72, 0x1a, 0xbc,
+	0x81, 0xbb, 0x8d, 0xbb, 0x1d, 0x3f, 0xf1, 0xf7, 0x26, 0x4a, 0x87, 0x52, 0xbb, 0x57, 0xee, 0x41,
+	0x31, 0xa8, 0xc6, 0xa8, 0x65, 0x6f, 0x06, 0x5e, 0x15, 0xd8, 0xcd, 0x03, 0x1e, 0x5b, 0xe4, 0x3a,
+	0x0c, 0x73, 0xfd, 0x83, 0xd0, 0x5d, 0xd7, 0x87, 0xea, 0xf0, 0x4c, 0xfb, 0xb9, 0xa5, 0x5c, 0x4b,
+	0x45, 0x76, 0xfe, 0xe5, 0xaf, 0x7a, 0x19, 0x37, 0x5c, 0xe1, 0x32, 0x6e, 0x72, 0x61, 0x71, 0x21,
+	0xfe, 0x1a, 0xeb, 0x91, 0x6f, 0xa9, 0xc3, 0x5a, 0xeb, 0x50, 0x2a, 0xff, 0xbd, 0x14, 0x88, 0xc4,
+	0x3a, 0x97, 0x25, 0x24, 0xfe, 0x52, 0xe5, 0x74, 0xe8, 0x52, 0xe5, 0xd8, 0xcb, 0xa7, 0x33, 0x4c,
+	0x5d, 0x84, 0xfb, 0x25, 0x97, 0x4f, 0x1b, 0x21, 0xb1, 0x30, 0xda, 0xff, 0x56, 0x0a, 0x16, 0x49,
+	0xd9, 0x4b, 0x53, 0x4f, 0xf8, 0x4a, 0xe8, 0xf4, 0xc5, 0x57, 0x42, 0xc7, 0x5c, 0xa7, 0x4d, 0x54,
+	0x36, 0x15, 0xbc, 0xe3, 0xd9, 0xf4, 0x77, 0xab, 0x7a, 0x32, 0xfd, 0xc3, 0x74, 0xf0, 0x6a, 0x08,
+	0xec, 0x64, 0xbf, 0x42, 0x1d, 0xdb, 0xad, 0xf3, 0xea, 0x98, 0x58, 0x6a, 0x39, 0xd2, 0xe5, 0x93,
+	0xce, 0xed, 0x77, 0x53, 0xb0, 0xec, 0xaa, 0xe4, 0x33, 0xe9, 0xe0, 0x22, 0x57, 0x82, 0x64, 0x62,
+	0xae, 0x04, 0xb9, 0x73, 0x5e, 0xfd, 0xf4, 0x02, 0xa9, 0xe8, 0x3c, 0xe6, 0x8a, 0x2b, 0xd7, 0xa5,
+	0xde, 0x14, 0xa9, 0x33, 0x1d, 0x5f, 0xa7, 0x45, 0xfc, 0x23, 0xb9, 0xce, 0xf0, 0x36, 0x52, 0x7a,
+	0x2b, 0xdc, 0xcf, 0x93, 0x20, 0xb7, 0x8d, 0xd4, 0xf1, 0xb7, 0x91, 0x1e, 0xec, 0x93, 0x11, 0xf6,
+	0xff, 0x4c, 0xc3, 0x22, 0x55, 0xd4, 0x67, 0xe2, 0x3f, 0xb7, 0xa1, 0xa0, 0x1b, 0xf8, 0x69, 0x94,
+	0x13, 0xef, 0x53, 0x34, 0x39, 0x19, 0x74, 0x63, 0x9f, 0x41, 0xbc, 0xd4, 0xe1, 0x52, 0x70, 0xb7,
+	0xc9, 0xbb, 0x24, 0x98, 0x3a, 0x5c, 0xb4, 0x7b, 0x9b, 0xbc, 0x8b, 0xc3, 0x99, 0x5d, 0x96, 0xf8,
+	0xdc, 0xcf, 0x9c, 0x4c, 0xb3, 0x99, 0x5d, 0x0a, 0xe6, 0x3e, 0x0b, 0x33, 0x54, 0x1d, 0xcd, 0xf6,
+	0xe9, 0xe8, 0x7e, 0xb8, 0x22, 0x85, 0xba, 0x64, 0x78, 0x6a, 0x42, 0x77, 0x86, 0xee, 0x27, 0x68,
+	0xe8, 0x03, 0xd6, 0x62, 0x69, 0x43, 0x4d, 0x25, 0x73, 0xde, 0xa3, 0x91, 0xca, 0xbe, 0x8c, 0x91,
+	0x97, 0xe7, 0x18, 0xb8, 0x4b, 0xa1, 0xd8, 0x5c, 0x97, 0x10, 0xb7, 0xdc, 0xd1, 0x57, 0x5a, 0x60,
+	0xa0, 0x03, 0x6b, 0x88, 0x7b, 0x6f, 0x27, 0x61, 0x9d, 0xa3, 0xaf, 0x7e, 0x0c, 0x4b, 0x54, 0xeb,
+	0x97, 0x46, 0xc7, 0x83, 0xf0, 0xec, 0xc6, 0x5c, 0x25, 0x58, 0xd2, 0x45, 0x63, 0x5d, 0x67, 0xfe,
+	0x3e, 0x5f, 0xaf, 0xae, 0xcd, 0x63, 0x98, 0x0b, 0x3a, 0x8f, 0x58, 0x82, 0x25, 0xa9, 0xdd, 0x6b,
+	0xf6, 0x9e, 0x28, 0xf5, 0x5a, 0x4f, 0x7a, 0xd4, 0x91, 0x9f, 0x28, 0xed, 0x4e, 0x5b, 0x12, 0xae,
+	0x89, 0x6b, 0xb0, 0x12, 0xc6, 0xd4, 0x3b, 0xed, 0x9d, 0xe6, 0x23, 0x21, 0x25, 0xde, 0x82, 0xb5,
+	0x30, 0xae, 0xd1, 0xac, 0x3d, 0x6a, 0x77, 0xba, 0xbd, 0x66, 0x5d, 0x48, 0x6f, 0xb6, 0x41, 0x08,
+	0x7f, 0x62, 0x41, 0x5c, 0x87, 0xd5, 0x96, 0xf4, 0xa8, 0x56, 0x7f, 0x42, 0xaf, 0xed, 0x57, 0xba,
+	0xbd, 0x5a, 0x4f, 0x52, 0x3a, 0xfb, 0x52, 0x5b, 0xb8, 0x26, 0xde, 0x84, 0xeb, 0x31, 0xc8, 0x7a,
+	0xab, 0xd3, 0x95, 0x1a, 0x42, 0x6a, 0xf3, 0x63, 0x98, 0x0b, 0x7e, 0x47, 0x01, 0xe5, 0xa6, 0x94,
+	0x9d, 0x7d, 0x49, 0xae, 0xf5, 0x9a, 0x9d, 0xb6, 0xd2, 0x6c, 0xb4, 0x50, 0xee, 0x5b, 0xb0, 0x16,
+	0xc1, 0x74, 0x49, 0x3d, 0xcd, 0x36, 0x93, 0x3d, 0x06, 0x8f, 0x55, 0x21, 0x3e, 0xbd, 0x69, 0x81,
+	0x18, 0xfd, 0x12, 0x42, 0x44, 0xc0, 0x7a, 0x67, 0x6f, 0xaf, 0xd6, 0x6e, 0xb8, 0xf2, 0xdf, 0x82,
+	0xb5, 0x58, 0x34, 0x69, 0x81, 0x90, 0x4a, 0x2c, 0xde, 0xed, 0x75, 0xf6, 0x85, 0xf4, 0xe6, 0x23,
+	0xc8, 0xb9, 0xd7, 0xa5, 0x8b, 0x0b, 0x50, 0xdc, 0xa9, 0xb5, 0x95, 0xee, 0xbe, 0x24, 0x35, 0x94,
+	0x56, 0xe7, 0xb1, 0x70, 0x4d, 0x5c, 0x02, 0xc1, 0x07, 0xe1, 0xfe, 0xca, 0x83, 0x3d, 0x21, 0x25,
+	0x8a, 0x30, 0xe7, 0x43, 0x77, 0x9b, 0x8f, 0x76, 0x85, 0xf4, 0x66, 0x03, 0x66, 0xf9, 0xeb, 0xcd,
+	0xc5, 0xeb, 0xb0, 0x8c, 0x34, 0x8d, 0xa6, 0x2c, 0xd5, 0x49, 0x53, 0x77, 0x3a, 0xf2, 0xe3, 0x9a,
+	0xdc, 0x10, 0xae, 0x45, 0x51, 0xb2, 0x74, 0x28, 0xc9, 0x28, 0xed, 0xe6, 0xef, 0xa7, 0x21, 0xef,
+	0xdf, 0xaa, 0xbb, 0x02, 0x62, 0xbd, 0xd3, 0xea, 0xc8, 0xca, 0x5e, 0xa7, 0x21, 0x29, 0x07, 0xed,
+	0x8f, 0xda, 0x9d, 0xc7, 0xd8, 0xe6, 0x65, 0x58, 0xe0, 0xe0, 0x9d, 0xb6, 0xd2, 0xd9, 0xd9, 0x11,
+	0x52, 0xc8, 0x97, 0x03, 0x6f, 0xcb, 0x78, 0x4b, 0x69, 0x5b, 0xea, 0x76, 0x85, 0x0c, 0xb6, 0x83,
+	0x43, 0x3d, 0xde, 0x6d, 0xf6, 0x24, 0x61, 0x46, 0xdc, 0x80, 0x1b, 0x1c, 0x94, 0xfe, 0xec, 0x49,
+	0x7b, 0xc4, 0x3a, 0x07, 0xb2, 0x24, 0x14, 0xa8, 0xc9, 0x78, 0x8a, 0x86, 0xf2, 0x18, 0x27, 0xe3,
+	0x28, 0x87, 0x45, 0xd4, 0x04, 0x87, 0x97, 0x1f, 0x6d, 0x0b, 0x77, 0xa9, 0x83, 0xf0, 0x30, 0x46,
+	0xfd, 0xba, 0x78, 0x0f, 0x36, 0x42, 0x98, 0x68, 0x9d, 0x5f, 0x13, 0xcb, 0x70, 0x2b, 0x4a, 0x15,
+	0xa8, 0xf7, 0x9d, 0xcd, 0xbf, 0x04, 0x42, 0xf8, 0xba, 0x50, 0x6c, 0xa3, 0xeb, 0xbc, 0xb5, 0x6e,
+	0xd7, 0x0d, 0xa6, 0x75, 0x58, 0xe5, 0xa1, 0x7b, 0x52, 0xad, 0x7b, 0x20, 0x4b, 0x7b, 0x52, 0xbb,
+	0x27, 0xa4, 0x50, 0x01, 0x3c, 0xb2, 0xd7, 0xe9, 0xd5, 0x5a, 0x4a, 0xb3, 0x5d, 0x97, 0xa5, 0x1a,
+	0xf5, 0x49, 0x54, 0x75, 0x84, 0x42, 0xc8, 0x6c, 0x1e, 0xb8, 0x97, 0x78, 0x06, 0xaf, 0x16, 0x5d,
+	0x84, 0xf9, 0x56, 0xad, 0xdb, 0x53, 0x64, 0xa9, 0x2b, 0xf5, 0x5c, 0x09, 0x96, 0x40, 0xe0, 0x81,
+	0x68, 0x6b, 0x21, 0x15, 0x22, 0xad, 0x1d, 0xf4, 0x3a, 0x42, 0x7a, 0xf3, 0x5f, 0xa5, 0x20, 0xe7,
+	0xde, 0xa4, 0x87, 0xba, 0x6d, 0x75, 0x1e, 0x29, 0x2d, 0xe9, 0x50, 0x6a, 0xb9, 0xbc, 0xb0, 0x94,
+	0x07, 0x93, 0x64, 0xb9, 0x23, 0x53, 0x77, 0xf4, 0x81, 0x8f, 0x6b, 0x72, 0x5b, 0x48, 0x07, 0x61,
+	0xcd, 0xf6, 0x4e, 0x87, 0x3a, 0x81, 0x0f, 0x63, 0x19, 0x65, 0x2a, 0xc8, 0xb2, 0x21, 0x6d, 0x1f,
+	0x3c, 0x12, 0xb2, 0xd8, 0x6c, 0x1f, 0x78, 0x28, 0xc9, 0xdb, 0x18, 0x4c, 0xd3, 0x98, 0x99, 0x02,
+	0xe0, 0x27, 0x1e, 0x6e, 0x66, 0xf3, 0x37, 0xd3, 0x30, 0x17, 0xbc, 0x58, 0x0c, 0x1d, 0xb2, 0x2b,
+	0xc9, 0x87, 0x64, 0x5d, 0x42, 0x7e, 0xa4, 0xf4, 0x9e, 0xec, 0x4b, 0xca, 0x76, 0xa7, 0xd3, 0x12,
+	0xae, 0xa1, 0x93, 0x44, 0x50, 0x4d, 0x62, 0x93, 0x35, 0x58, 0x89, 0x60, 0x76, 0x5a, 0x9d, 0x5a,
+	0x4f, 0x48, 0x13, 0x63, 0x86, 0x71, 0xdd, 0x9e, 0x8c, 0xa6, 0xca, 0x88, 0xb7, 0x61, 0x3d, 0xb6,
+	0x36, 0xa5, 0x26, 0xcb, 0xb5, 0x27, 0xc2, 0x14, 0x3a, 0x73, 0x5c, 0x9d, 0x0c, 0x9f, 0x25, 0xde,
+	0x10, 0x5b, 0x33, 0xa3, 0x98, 0x16, 0xef, 0xc0, 0xcd, 0x84, 0xfa, 0x19, 0xc9, 0xcc, 0xe6, 0xbf,
+	0x4c, 0x41, 0x81, 0xbb, 0x21, 0x81, 0x44, 0x1e, 0x3d, 0x8a, 0xcd, 0xa2, 0x75, 0x67, 0x87, 0xa6,
+	0xf8, 0x00, 0x74, 0x57, 0xaa, 0xf5, 0x94, 0x3a, 0xaa, 0x26, 0x45, 0xa2, 0x9b, 0xc7, 0x11, 0x70,
+	0x3a, 0x02, 0xc6, 0x22, 0x42, 0x86, 0x04, 0x3d, 0x0f, 0xc6, 0xcc, 0xd2, 0x69, 0xb7, 0xb0, 0xbd,
+	0xe1, 0xaa, 0x1b, 0xf2, 0x13, 0x6a, 0xda, 0x00, 0x94, 0xb8, 0xde, 0xf4, 0xe6, 0x7f, 0x4f, 0xc1,
+	0x5c, 0xf0, 0xe4, 0x3f, 0x09, 0x6e, 0x46, 0x49, 0xb9, 0x52, 0x07, 0x0c, 0xc0, 0x48, 0xe2, 0xe1,
+	0x2a, 0x42, 0x20, 0x75, 0xe6, 0x30, 0x29, 0x26, 0xd4, 0x0c, 0x49, 0x69, 0x1c, 0x90, 0xa5, 0xd4,
+	0xa9, 0x30, 0x0b, 0x92, 0x54, 0xb3, 0x11, 0xea, 0x66, 0x03, 0x7b, 0x9a, 0x69, 0xbe, 0x0d, 0x08,
+	0xdf, 0xe9, 0xd4, 0x0f, 0xba, 0xc2, 0x8c, 0xb8, 0x0a, 0x8b, 0x3c, 0xb8, 0xd1, 0xdc, 0xd9, 0x39,
+	0xe8, 0x4a, 0x42, 0x2e, 0x4c, 0xff, 0xdd, 0x83, 0xa6, 0xd4, 0x13, 0xf2, 0x9b, 0x7f, 0x01, 0x84,
+	0xf0, 0xc5, 0x04, 0x3c, 0x69, 0xf7, 0x31, 0x1a, 0x96, 0x1a, 0x8c, 0x93, 0x84, 0x82, 0xb7, 0x3b,
+	0xbd, 0x5d, 0xea, 0xad, 0x41, 0xf8, 0xa1, 0x24, 0xf7, 0x9a, 0xf5, 0x1a, 0x5a, 0xec, 0x06, 0x94,
+	0x82, 0xb8, 0xdd, 0x8e, 0xdc, 0xfc, 0xe5, 0x4e, 0x9b, 0xa6, 0x90, 0x7f, 0x9a, 0x82, 0x62, 0xe0,
+	0xde, 0x13, 0xbe, 0x8e, 0x1a, 0xed, 0x18, 0x22, 0xce, 0xc2, 0xe0, 0xe8, 0x12, 0x34, 0x3f, 0x45,
+	0x71, 0xe8, 0x17, 0x34, 0x20, 0x38, 0x75, 0xd4, 0xea, 0x7e, 0x47, 0x3d, 0xc5, 0xfb, 0x0c, 0x43,
+	0x34, 0xe4, 0x27, 0x58, 0x26, 0x1b, 0x23, 0xc3, 0x4e, 0xad, 0x2d, 0x4c, 0x6f, 0xfe, 0x67, 0x5f,
+	0x5a, 0x76, 0x8f, 0x01, 0xc7, 0x7d, 0x3f, 0x90, 0xef, 0xa2, 0x88, 0xdd, 0xce, 0x1e, 0x76, 0xc5,
+	0x51, 0x44, 0xed, 0x71, 0xed, 0x89, 0x90, 0x26, 0x3d, 0x46, 0x10, 0xb1, 0xdd, 0xe9, 0x74, 0xd1,
+	0xbb, 0xb9, 0xe6, 0x31, 0x4c, 0xbd, 0xb3, 0xb7, 0xd3, 0x91, 0x7b, 0xc2, 0x14, 0x2f, 0x2a, 0xc3,
+	0x49, 0xf5, 0x8e, 0x90, 0x8d, 0xe1, 0xd6, 0x6d, 0x49, 0xd2, 0xbe, 0x30, 0x8d, 0xe9, 0x23, 0x2c,
+	0x40, 0xbd, 0xd7, 0x3c, 0x6c, 0xf6, 0x30, 0x70, 0x3b, 0x00, 0xfe, 0xe9, 0x7c, 0xf4, 0x47, 0x7a,
+	0x30, 0x9d, 0x0b, 0x12, 0xe2, 0xfd, 0x3c, 0x74, 0xbb, 0xf3, 0x3d, 0x21, 0x85, 0x72, 0xf0, 0xc0,
+	0x6e, 0xab, 0xd9, 0x90, 0x64, 0x21, 0xbd, 0xf9, 0x0f, 0x52, 0x90, 0xf7, 0x8e, 0x04, 0xd2, 0x34,
+	0x5b, 0xff, 0x88, 0x8d, 0xaf, 0x98, 0xaa, 0x48, 0x9a, 0xf5, 0x80, 0xf8, 0x53, 0x6a, 0x50, 0x45,
+	0x71, 0xe0, 0x83, 0x36, 0x43, 0xa4, 0x43, 0xf4, 0xbf, 0x54, 0xdb, 0xdb, 0x93, 0x1a, 0x34, 0xa8,
+	0x42, 0x6c, 0xd0, 0x98, 0x53, 0xa8, 0x89, 0x08, 0x1f, 0x62, 0xe6, 0xcd, 0x6f, 0x43, 0x81, 0x3b,
+	0x4a, 0x28, 0xce, 0x43, 0x81, 0x10, 0x52, 0x12, 0xe1, 0x9a, 0x58, 0x84, 0x3c, 0x01, 0x90, 0xc7,
+	0x94, 0xf7, 0x48, 0xc6, 0x5c, 0xe9, 0xcd, 0x1f, 0xa5, 0x40, 0x08, 0x9f, 0x12, 0x42, 0xe5, 0xf2,
+	0x07, 0x50, 0x82, 0x0d, 0x8d, 0x47, 0x12, 0x77, 0x24, 0xe3, 0xc2, 0x18, 0x24, 0x3e, 0x50, 0x1f,
+	0xbf, 0x09, 0xd7, 0xe3, 0xf0, 0xb5, 0x03, 0x1c, 0xa2, 0x66, 0x36, 0xff, 0x38, 0x05, 0x62, 0xf4,
+	0xac, 0x4f, 0xa4, 0x94, 0x3b, 0xf0, 0xc3, 0x47, 0x3a, 0x6e, 0x8c, 0x47, 0xd7, 0x0e, 0xdc, 0x71,
+	0x63, 0x2c, 0x9e, 0x8e, 0x1b, 0x13, 0xd1, 0x7b, 0x07, 0x3d, 0x89, 0xf6, 0x45, 0xb1, 0xe8, 0x83,
+	0x36, 0x21, 0x98, 0xda, 0xfc, 0x34, 0x70, 0x46, 0x2b, 0x70, 0xd8, 0x4e, 0x7c, 0x1d, 0xee, 0x06,
+	0x0a, 0xef, 0x74, 0xe4, 0xbd, 0x5a, 0x4f, 0xd9, 0x3f, 0x90, 0xf7, 0x3b, 0xf8, 0xde, 0x2d, 0xed,
+	0xd4, 0x0e, 0x5a, 0x3d, 0xe1, 0x9a, 0xf8, 0x16, 0x3c, 0xb8, 0x88, 0xb0, 0xd6, 0x6e, 0x77, 0x0e,
+	0xda, 0x75, 0x36, 0xd8, 0xd9, 0xfc, 0xaf, 0x69, 0x58, 0x0b, 0xed, 0x3c, 0xe3, 0x4e, 0x6c, 0x88,
+	0x6f, 0xc0, 0x7d, 0x7f, 0x2f, 0x54, 0x43, 0x22, 0x9d, 0x9c, 0x2c, 0x7d, 0xf7, 0x40, 0xea, 0xf6,
+	0x68, 0x47, 0x57, 0xef, 0xb4, 0xdb, 0x52, 0x9d, 0xd5, 0x7b, 0x31, 0x69, 0xa3, 0xd9, 0x75, 0xa9,
+	0x53, 0xe2, 0x6b, 0x50, 0xbe, 0x98, 0x7a, 0xbf, 0xd6, 0x94, 0x85, 0xb4, 0xf8, 0x00, 0xee, 0x5d,
+	0x4c, 0x77, 0xd0, 0x26, 0x94, 0x19, 0xf1, 0x5b, 0xf0, 0xce, 0x95, 0x44, 0x55, 0x0e, 0xdf, 0x51,
+	0x1e, 0x37, 0x7b, 0xbb, 0x4a, 0xbd, 0x56, 0xdf, 0xc5, 0xc4, 0xb7, 0x05, 0xef, 0xbd, 0x54, 0xc1,
+	0xce, 0x41, 0x8f, 0x95, 0xcd, 0x8a, 0x6f, 0xc3, 0x1b, 0x97, 0x94, 0x6d, 0x49, 0x35, 0x99, 0x91,
+	0x4f, 0x6f, 0xfe, 0x79, 0x26, 0xbc, 0xd7, 0x85, 0xcc, 0x38, 0x63, 0xee, 0x0d, 0xed, 0xd4, 0x60,
+	0xe3, 0x38, 0xf2, 0x2a, 0x16, 0x46, 0xc9, 0x07, 0x6d, 0x74, 0x77, 0x19, 0xf5, 0xb8, 0x0e, 0xab,
+	0x71, 0x68, 0xa9, 0xdd, 0xa0, 0xfe, 0x18, 0x46, 0x76, 0x7b, 0x3d, 0x56, 0x36, 0x13, 0x57, 0x16,
+	0xd1, 0x58, 0x76, 0x0a, 0xc7, 0x3d, 0x61, 0x64, 0xb3, 0xdd, 0x93, 0xda, 0x6e, 0xf1, 0x2c, 0x06,
+	0x4b, 0x02, 0x05, 0x72, 0x98, 0x8e, 0xab, 0xbd, 0xd7, 0xeb, 0xb2, 0xe2, 0x33, 0x71, 0xb5, 0x23,
+	0x1a, 0xcb, 0xe6, 0xc4, 0xbb, 0x70, 0x3b, 0x8c, 0x7c, 0x5c, 0xfb, 0x48, 0x52, 0x1e, 0x77, 0xe4,
+	0x06, 0xe3, 0x90, 0xc7, 0x81, 0x57, 0x32, 0x11, 0xf2, 0x81, 0x38, 0x12, 0x6c, 0xe2, 0x61, 0xcd,
+	0xe5, 0x52, 0xc0, 0xa8, 0x4c, 0x22, 0x41, 0x1e, 0xb3, 0xf8, 0x82, 0x12, 0xdf, 0x0e, 0x59, 0xaa,
+	0xed, 0x31, 0x36, 0x47, 0xf8, 0x82, 0x72, 0x01, 0x15, 0x72, 0xea, 0x6f, 0xfe, 0x61, 0x0a, 0x4a,
+	0x49, 0xfb, 0x8a, 0xe2, 0x44, 0xc5, 0xf9, 0x33, 0x99, 0xd6, 0x20, 0xe1, 0x3b, 0x62, 0x22, 0x09,
+	0x9d, 0x52, 0xc0, 0x5e, 0x22, 0x46, 0x71, 0x94, 0xa4, 0x5e, 0x6b, 0xd7, 0xa5, 0x56, 0x8b, 0xf4,
+	0x18, 0x71, 0xb2, 0x12, 0xa2, 0x9d, 0x66, 0xbb, 0xd9, 0xdd, 0x25, 0x09, 0xf4, 0x5f, 0xa4, 0x61,
+	0x25, 0x7e, 0xb3, 0x06, 0xf6, 0x20, 0x74, 0xf7, 0x05, 0xcd, 0xb9, 0x8d, 0x66, 0xb7, 0x26, 0xef,
+	0x11, 0x01, 0xd7, 0x60, 0x85, 0xc7, 0x10, 0xb0, 0xdb, 0xd1, 0xc7, 0xe2, 0x58, 0x5f, 0xbf, 0x0e,
+	0xab, 0x51, 0x5c, 0x1b, 0x5f, 0x55, 0x85, 0x0c, 0xfa, 0x59, 0x14, 0x79, 0x58, 0xab, 0x93, 0xc9,
+	0x02, 0x61, 0x0a, 0x9b, 0x1c, 0xc5, 0xd7, 0x0f, 0xba, 0xbd, 0xce, 0x9e, 0xb2, 0xfd, 0x64, 0xbf,
+	0xd6, 0xed, 0x0a, 0x59, 0xec, 0x3d, 0x79, 0xa2, 0x7d, 0xa9, 0xdd, 0xc0, 0x7e, 0x64, 0x1a, 0xbb,
+	0xc9, 0x50, 0x69, 0x84, 0xcf, 0x60, 0x48, 0x46, 0x1b, 0x89, 0xa8, 0x5c, 0x18, 0xd5, 0x93, 0x9b,
+	0x8f, 0x1e, 0x49, 0xb2, 0xd4, 0x10, 0xf2, 0x9b, 0x7f, 0x3f, 0x0d, 0x37, 0x2f, 0xdc, 0xe2, 0xe2,
+	0xb7, 0x26, 0xb0, 0x75, 0x85, 0xf1, 0x17, 0xae, 0x61, 0xdc, 0xc5, 0xe1, 0x11, 0x42, 0x94, 0x95,
+	0xba, 0x88, 0x82, 0xa8, 0x3a, 0x8d, 0x7e, 0x92, 0x44, 0xe1, 0x2a, 0xf5, 0x1e, 0x6c, 0x24, 0x91,
+	0x70, 0xaa, 0x7d, 0x03, 0xee, 0x27, 0x51, 0x85, 0x15, 0x7c, 0x1b, 0xd6, 0xe3, 0x48, 0x99, 0x72,
+	0x84, 0xe9, 0xcd, 0xf7, 0x20, 0xe7, 0xee, 0x1c, 0xc1, 0x97, 0x08, 0x72, 0x6f, 0x3d, 0x19, 0x1b,
+	0xe1, 0x2f, 0x3a, 0x98, 0xf6, 0x61, 0xc8, 0x14, 0xc3, 0x98, 0x4e, 0x36, 0x05, 0xd7, 0x4d, 0xd0,
+	0xff, 0xc8, 0x32, 0x44, 0xec, 0x64, 0x53, 0x04, 0x13, 0x9a, 0x6c, 0x8a, 0xc1, 0xfb, 0x93, 0x4d,
+	0xdf, 0x87, 0x62, 0x60, 0x0a, 0x0f, 0xdd, 0x86, 0xc6, 0x96, 0xd7, 0x59, 0xb3, 0xb1, 0xcb, 0x75,
+	0x58, 0x0e, 0x21, 0xe8, 0xa3, 0x90, 0x42, 0xf1, 0x42, 0xa8, 0xfa, 0xae, 0x54, 0xff, 0x48, 0x48,
+	0x3f, 0xfc, 0xd3, 0x45, 0x28, 0xd6, 0xf6, 0x9b, 0xfe, 0x81, 0x09, 0xb1, 0x02, 0xd9, 0x53, 0xfc,
+	0x50, 0xbc, 0x58, 0xac, 0xf0, 0x5f, 0xb1, 0x5f, 0x9b, 0xab, 0x04, 0xbe, 0x1f, 0x5f, 0x9e, 0xfe,
+	0x6f, 0xd5, 0x6b, 0x7f, 0x56, 0xbd, 0x26, 0xbe, 0x8b, 0x73, 0x8e, 0xa4, 0xb4, 0x88, 0xdf, 0x32,
+	0xe5, 0x3f, 0x9a, 0xbe, 0x26, 0x54, 0x42, 0x5f, 0x64, 0xf7, 0x4a, 0x7d, 0x08, 0x30, 0xf0, 0xbe,
+	0xa1, 0x2e, 0x8a, 0x95, 0xc8, 0x07, 0xd7, 0xd7, 0x16, 0x2b, 0xd1, 0x8f, 0xac, 0x7b, 0xc5, 0xdf,
+	0x84, 0xa9, 0x31, 0x5e, 0x86, 0x3d, 0x5b, 0xe1, 0x3e, 0xb4, 0xbe, 0x56, 0xac, 0xf0, 0xdf, 0x57,
+	0xf7, 0x88, 0x3f, 0x00, 0x36, 0xb5, 0x4d, 0xbe, 0x48, 0x8f, 0x95, 0x85, 0xbf, 0xb6, 0xbe, 0xb6,
+	0x18, 0x80, 0xb1, 0xf2, 0x19, 0x2c, 0x5c, 0x81, 0xe2, 0x50, 0xb7, 0x1d, 0x45, 0x63, 0x0b, 0x05,
+	0xe2, 0x52, 0x25, 0xe6, 0x9b, 0xd4, 0x6b, 0xd9, 0xca, 0x99, 0xa9, 0x0f, 0xca, 0xa8, 0x0e, 0xc1,
+	0xdb, 0x61, 0x4d, 0xe7, 0xbe, 0x6d, 0x71, 0xb5, 0x12, 0xff, 0x01, 0x6a, 0xbf, 0xd4, 0xd7, 0xf1,
+	0x5c, 0xbe, 0x5b, 0x6a, 0x68, 0x9e, 0xd8, 0xe2, 0x72, 0x25, 0xee, 0xa3, 0x09, 0x7e, 0x89, 0x2e,
+	0x6c, 0xf8, 0x25, 0x4e, 0xf9, 0x9b, 0xf3, 0x15, 0xf7, 0xdc, 0x9d, 0xf8, 0x5a, 0xe5, 0x4a, 0x1f,
+	0x20, 0xf0, 0x99, 0xee, 0xc3, 0xed, 0x20, 0x53, 0xee, 0x5b, 0xdf, 0xac, 0x2d, 0xf7, 0x2a, 0x57,
+	0xb8, 0x5a, 0xdf, 0xe7, 0xf8, 0x10, 0x72, 0xe4, 0x62, 0x41, 0x7d, 0xa4, 0x89, 0xf3, 0x95, 0xe0,
+	0x75, 0xf3, 0x6b, 0x42, 0x25, 0x74, 0xcb, 0x3c, 0x55, 0xf9, 0x43, 0x98, 0xd7, 0xe8, 0x55, 0xe0,
+	0x6e, 0x53, 0xc4, 0x95, 0x4a, 0xec, 0xa5, 0xe2, 0x7e, 0x3d, 0x15, 0x28, 0xf6, 0x71, 0x36, 0xd6,
+	0x3d, 0x7a, 0x2f, 0x2e, 0x55, 0x62, 0xbe, 0x8d, 0xeb, 0xd3, 0x6f, 0x42, 0x01, 0xaf, 0xe5, 0x73,
+	0xa9, 0xc5, 0x4a, 0xe4, 0xab, 0x98, 0x01, 0xde, 0x43, 0xfc, 0x72, 0x17, 0xc7, 0x3b, 0xe6, 0x6b,
+	0x68, 0x41, 0x63, 0x92, 0x8f, 0xa1, 0x78, 0x05, 0x96, 0x2b, 0x71, 0x5f, 0x47, 0xf1, 0x4b, 0xbc,
+	0x05, 0xb3, 0x7d, 0x72, 0x9b, 0xb6, 0xa2, 0xe3, 0x75, 0xda, 0xe2, 0x62, 0x25, 0x7a, 0xb9, 0x36,
+	0xaf, 0xd3, 0xf9, 0x3e, 0x7d, 0xc5, 0xf5, 0x2a, 0x58, 0xa9, 0xc4, 0x5e, 0xa0, 0x19, 0x90, 0xc9,
+	0x20, 0x6f, 0x8d, 0x9c, 0x4c, 0x71, 0x97, 0xa3, 0x05, 0x64, 0x72, 0xb4, 0x67, 0x7e, 0xa3, 0x17,
+	0x2b, 0xd1, 0x8d, 0x6c, 0xc1, 0x36, 0x93, 0xbb, 0xb6, 0xf8, 0x36, 0xc7, 0x5c, 0xbe, 0x15, 0x28,
+	0x71, 0x44, 0xee, 0x24, 0xe1, 0x4a, 0xc4, 0x5d, 0x52, 0x12, 0x90, 0x68, 0x68, 0xf6, 0x3f, 0xe1,
+	0x24, 0x8a, 0x5e, 0x4f, 0x13, 0xb0, 0xda, 0x19, 0x26, 0x69, 0xce, 0x6a, 0x31, 0x0b, 0xd6, 0x3e,
+	0xfd, 0x07, 0xb0, 0x44, 0xef, 0x43, 0x18, 0x93, 0x57, 0x1d, 0xaf, 0xd8, 0x5a, 0x25, 0xf1, 0x02,
+	0x88, 0x80, 0x68, 0x03, 0xde, 0x1e, 0x8b, 0x95, 0xc6, 0x05, 0xc6, 0x40, 0xd5, 0xea, 0x23, 0x9e,
+	0x3a, 0xba, 0xf6, 0x19, 0xc8, 0x28, 0xc8, 0x3b, 0x50, 0x62, 0xb5, 0x12, 0xbf, 0x62, 0x1a, 0x50,
+	0x2f, 0xfd, 0xf8, 0x15, 0xa7, 0xde, 0xb8, 0x55, 0xa4, 0x80, 0xc2, 0x6c, 0xdd, 0xd2, 0x0c, 0x4e,
+	0x61, 0x31, 0x97, 0x62, 0xf9, 0xf4, 0xbf, 0x82, 0x97, 0x9b, 0xbb, 0xc9, 0xe2, 0xc8, 0x7d, 0x61,
+	0x53, 0x86, 0x9a, 0x12, 0x3c, 0xc8, 0x22, 0x6e, 0x56, 0xae, 0x7c, 0xbd, 0x83, 0xcf, 0xfd, 0xdb,
+	0x50, 0xf2, 0x79, 0xb2, 0xf4, 0xcd, 0xce, 0xea, 0x8b, 0xab, 0x09, 0x27, 0xfa, 0xfd, 0xd2, 0xbb,
+	0xb0, 0xee, 0x97, 0x3e, 0x51, 0x1d, 0x47, 0xc1, 0x2c, 0xe4, 0x25, 0xc6, 0x8d, 0xca, 0x25, 0x07,
+	0xdb, 0x7d, 0x4e, 0xbf, 0x00, 0x8b, 0x21, 0x4e, 0x96, 0xa6, 0x0e, 0xc4, 0xeb, 0x95, 0xa4, 0x33,
+	0xc5, 0x01, 0x8f, 0x0a, 0x15, 0x7d, 0x6a, 0xe9, 0xf8, 0xf5, 0xf0, 0x4a, 0xe2, 0x09, 0x5d, 0xbf,
+	0x70, 0x1b, 0x6e, 0xc5, 0xd4, 0xab, 0xf8, 0xa7, 0xca, 0xc5, 0xbb, 0x95, 0xcb, 0xcf, 0xd2, 0x06,
+	0x52, 0x7b, 0x9c, 0x30, 0x3c, 0xc3, 0x7b, 0x95, 0x2b, 0x9c, 0x92, 0xf5, 0x39, 0x7e, 0x08, 0xcb,
+	0x21, 0x8e, 0x06, 0x39, 0xf5, 0x27, 0xae, 0x57, 0x92, 0x4f, 0xb8, 0xfa, 0xc5, 0xcf, 0xe0, 0x5e,
+	0x9c, 0xfb, 0xf4, 0xfd, 0xa3, 0x9b, 0x0a, 0x39, 0x9f, 0xf9, 0x46, 0xe5, 0xaa, 0x27, 0x44, 0xd7,
+	0xee, 0x54, 0x2e, 0x3b, 0x01, 0x5a, 0xbe, 0x26, 0xfe, 0x00, 0x5e, 0x9f, 0x18, 0x57, 0x73, 0xdc,
+	0xb7, 0x2a, 0x2f, 0x71, 0x9e, 0xce, 0x6f, 0x99, 0x04, 0xd7, 0xbd, 0x52, 0xca, 0x19, 0xbe, 0x5c,
+	0xf9, 0xdd, 0xa8, 0x78, 0xbb, 0x72, 0xf1, 0x99, 0xad, 0x80, 0x0f, 0xab, 0x38, 0x6e, 0x57, 0xfa,
+	0x74, 0xe0, 0xae, 0x8c, 0x71, 0xe4, 0xee, 0x45, 0xe7, 0x46, 0xe5, 0x92, 0xdd, 0xef, 0x1e, 0xa7,
+	0xa3, 0xe9, 0xb1, 0x65, 0x3a, 0xe6, 0x3b, 0xff, 0x7b, 0x00, 0x04, 0xe1, 0x30, 0x6b, 0xb8, 0x88,
+	0x00, 0x00,
 }
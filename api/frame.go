@@ -0,0 +1,59 @@
+package api
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Frame indicators, the first byte of every frame.
+const (
+	plaintextIndicator = 0x00
+	noiseIndicator     = 0x01
+)
+
+// Errors.
+var (
+	ErrEncryptionRequired = errors.New("api: node requires encryption")
+	ErrPlaintext          = errors.New("api: peer does not use encryption")
+)
+
+// FrameHelper reads and writes messages using the framing of a transport.
+//
+// ReadMessage and WriteMessage may be called concurrently, but concurrent calls of the same method must be
+// serialized by the caller.
+type FrameHelper interface {
+	// ReadMessage reads the next message.
+	ReadMessage() (proto.Message, error)
+
+	// WriteMessage writes a message.
+	WriteMessage(message proto.Message) error
+}
+
+type plaintextFrameHelper struct {
+	r *bufio.Reader
+	w io.Writer
+}
+
+// NewPlaintextFrameHelper returns a FrameHelper for the unencrypted transport.
+func NewPlaintextFrameHelper(rw io.ReadWriter) FrameHelper {
+	return &plaintextFrameHelper{
+		r: bufio.NewReader(rw),
+		w: rw,
+	}
+}
+
+func (helper *plaintextFrameHelper) ReadMessage() (proto.Message, error) {
+	return ReadMessage(helper.r)
+}
+
+func (helper *plaintextFrameHelper) WriteMessage(message proto.Message) error {
+	packed, err := Marshal(message)
+	if err != nil {
+		return err
+	}
+	_, err = helper.w.Write(packed)
+	return err
+}
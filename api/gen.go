@@ -0,0 +1,215 @@
+//go:build ignore
+// +build ignore
+
+// Gen generates the message type constants and registry from the message options in api.proto, the options are
+// defined in api_options.proto.
+//
+// Usage:
+//
+//	go run gen.go [-proto api.proto] [-output messages.go]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var (
+	messagePattern = regexp.MustCompile(`^message\s+(\w+)\s*\{`)
+	optionPattern  = regexp.MustCompile(`^option\s+\((\w+)\)\s*=\s*(.+?)\s*;$`)
+)
+
+// typeNames are the names of type constants, that can't be derived from the message name.
+var typeNames = map[string]string{
+	// The enum BluetoothDeviceRequestType is generated from api.proto.
+	"BluetoothDeviceRequest": "BluetoothDeviceRequestMessageType",
+}
+
+// sources maps the APISourceType values to Source constants.
+var sources = map[string]string{
+	"SOURCE_BOTH":   "SourceBoth",
+	"SOURCE_SERVER": "SourceServer",
+	"SOURCE_CLIENT": "SourceClient",
+}
+
+type message struct {
+	Name    string
+	ID      uint64
+	Source  string
+	Ifdef   string
+	Log     bool
+	NoDelay bool
+}
+
+// TypeName is the name of the type constant of the message.
+func (m message) TypeName() string {
+	if name, ok := typeNames[m.Name]; ok {
+		return name
+	}
+	return strings.Replace(m.Name, "Homeassistant", "HomeAssistant", 1) + "Type"
+}
+
+func main() {
+	protoFile := flag.String("proto", "api.proto", "protocol definitions")
+	output := flag.String("output", "messages.go", "output file")
+	flag.Parse()
+
+	messages, err := parse(*protoFile)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	source, err := generate(*protoFile, messages)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err = ioutil.WriteFile(*output, source, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// parse the top-level messages that have an (id) option.
+func parse(name string) ([]message, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		messages []message
+		current  *message
+		depth    int
+		seen     = make(map[uint64]string)
+		s        = bufio.NewScanner(f)
+		line     int
+	)
+	for s.Scan() {
+		line++
+		text := strings.TrimSpace(s.Text())
+		if i := strings.Index(text, "//"); i >= 0 {
+			text = strings.TrimSpace(text[:i])
+		}
+
+		if depth == 0 {
+			if m := messagePattern.FindStringSubmatch(text); m != nil {
+				// Defaults from api_options.proto.
+				current = &message{Name: m[1], Source: sources["SOURCE_BOTH"], Log: true}
+			}
+		} else if depth == 1 && current != nil {
+			if m := optionPattern.FindStringSubmatch(text); m != nil {
+				if err := current.set(m[1], m[2]); err != nil {
+					return nil, fmt.Errorf("%s:%d: %s: %v", name, line, current.Name, err)
+				}
+				if m[1] == "id" {
+					if other, ok := seen[current.ID]; ok {
+						return nil, fmt.Errorf("%s:%d: id %d of %s is also used by %s", name, line, current.ID, current.Name, other)
+					}
+					seen[current.ID] = current.Name
+				}
+			}
+		}
+
+		depth += strings.Count(text, "{") - strings.Count(text, "}")
+		if depth == 0 && current != nil {
+			if current.ID > 0 {
+				messages = append(messages, *current)
+			}
+			current = nil
+		}
+	}
+	if err = s.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+	return messages, nil
+}
+
+// set a message option.
+func (m *message) set(option, value string) (err error) {
+	switch option {
+	case "id":
+		m.ID, err = strconv.ParseUint(value, 10, 64)
+	case "source":
+		var ok bool
+		if m.Source, ok = sources[value]; !ok {
+			err = fmt.Errorf("invalid source %s", value)
+		}
+	case "ifdef":
+		m.Ifdef, err = strconv.Unquote(value)
+	case "log":
+		m.Log, err = strconv.ParseBool(value)
+	case "no_delay":
+		m.NoDelay, err = strconv.ParseBool(value)
+	}
+	return
+}
+
+var output = template.Must(template.New("output").Parse(`// Code generated by gen.go from {{ .Proto }}. DO NOT EDIT.
+
+package api
+
+import "github.com/golang/protobuf/proto"
+
+// API request/response types.
+const (
+	UnknownType = 0
+{{- range .Messages }}
+	{{ .TypeName }} = {{ .ID }}
+{{- end }}
+)
+
+// messages are the known message types.
+var messages = map[uint64]MessageInfo{
+{{- range .Messages }}
+	{{ .TypeName }}: {
+		Type:   {{ .TypeName }},
+		Name:   "{{ .Name }}",
+		Source: {{ .Source }},
+		{{- if .Ifdef }}
+		Ifdef:  "{{ .Ifdef }}",
+		{{- end }}
+		Log:    {{ .Log }},
+		{{- if .NoDelay }}
+		NoDelay: true,
+		{{- end }}
+		new:    func() proto.Message { return new({{ .Name }}) },
+	},
+{{- end }}
+}
+
+// TypeOf returns the message type of a message, or UnknownType.
+func TypeOf(value interface{}) uint64 {
+	switch value.(type) {
+{{- range .Messages }}
+	case {{ .Name }}, *{{ .Name }}:
+		return {{ .TypeName }}
+{{- end }}
+	default:
+		return UnknownType
+	}
+}
+`))
+
+func generate(protoFile string, messages []message) ([]byte, error) {
+	var b bytes.Buffer
+	if err := output.Execute(&b, struct {
+		Proto    string
+		Messages []message
+	}{protoFile, messages}); err != nil {
+		return nil, err
+	}
+	return format.Source(b.Bytes())
+}
@@ -19,6 +19,40 @@ func TestReadMessage(t *testing.T) {
 	t.Logf("%T: %+v", m, m)
 }
 
+func TestReadMessageUnknownType(t *testing.T) {
+	// A message of type 16383 with a 2 byte body, followed by a hello response.
+	var (
+		bb = bytes.NewBuffer(append([]byte{0x00, 0x02, 0xff, 0x7f, 0x08, 0x01}, testHelloResponseBytes...))
+		br = bufio.NewReader(bb)
+	)
+	if _, err := ReadMessage(br); err != (UnknownTypeError{Type: 0x3fff}) {
+		t.Fatalf("expected unknown type error, got %v", err)
+	}
+	m, err := ReadMessage(br)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*HelloResponse); !ok {
+		t.Fatalf("expected hello response, got %T", m)
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	for kind := uint64(1); kind <= UpdateCommandRequestType; kind++ {
+		m := newMessage(kind)
+		if m == nil {
+			t.Errorf("type %d: no message", kind)
+			continue
+		}
+		if v := TypeOf(m); v != kind {
+			t.Errorf("%T: expected type %d, got %d", m, kind, v)
+		}
+	}
+	if v := TypeOf(&ListEntitiesNumberResponse{}); v != 49 {
+		t.Errorf("expected number listing type 49, got %d", v)
+	}
+}
+
 var (
 	testHelloResponseBytes, _ = hex.DecodeString("001f02080110031a1963616d657261302028657370686f6d652076312e31342e3329")
 )
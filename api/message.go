@@ -0,0 +1,69 @@
+package api
+
+import "github.com/golang/protobuf/proto"
+
+// Source is the side of the connection that sends a message.
+type Source uint8
+
+// Message sources, as in the (source) option in api.proto.
+const (
+	SourceBoth Source = iota
+	SourceServer
+	SourceClient
+)
+
+func (source Source) String() string {
+	switch source {
+	case SourceBoth:
+		return "both"
+	case SourceServer:
+		return "server"
+	case SourceClient:
+		return "client"
+	default:
+		return "unknown"
+	}
+}
+
+// MessageInfo describes a message type, as defined by the message options in api.proto.
+type MessageInfo struct {
+	// Type of the message.
+	Type uint64
+
+	// Name of the message.
+	Name string
+
+	// Source is the side of the connection that sends the message.
+	Source Source
+
+	// Ifdef is the define that ESPHome nodes need to support the message, such as USE_LIGHT. It is empty for
+	// messages every node supports.
+	Ifdef string
+
+	// Log indicates if the message should be logged, it is false for messages that are log messages themselves.
+	Log bool
+
+	// NoDelay indicates if the message should be sent immediately, instead of being buffered.
+	NoDelay bool
+
+	new func() proto.Message
+}
+
+// Info returns the MessageInfo for a message type, ok is false if the type is unknown.
+func Info(kind uint64) (info MessageInfo, ok bool) {
+	info, ok = messages[kind]
+	return
+}
+
+// InfoOf returns the MessageInfo for a message, ok is false if the type of the message is unknown.
+func InfoOf(message proto.Message) (MessageInfo, bool) {
+	return Info(TypeOf(message))
+}
+
+// newMessage returns a new message of type kind, or nil if the type is unknown.
+func newMessage(kind uint64) proto.Message {
+	if info, ok := messages[kind]; ok {
+		return info.new()
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package api
+
+import "testing"
+
+func TestInfo(t *testing.T) {
+	tests := []struct {
+		Type    uint64
+		Name    string
+		Source  Source
+		Ifdef   string
+		Log     bool
+		NoDelay bool
+	}{
+		{HelloRequestType, "HelloRequest", SourceClient, "", true, true},
+		{PingRequestType, "PingRequest", SourceBoth, "", true, false},
+		{SubscribeLogsResponseType, "SubscribeLogsResponse", SourceServer, "", false, false},
+		{ListEntitiesLightResponseType, "ListEntitiesLightResponse", SourceServer, "USE_LIGHT", true, false},
+		{NumberCommandRequestType, "NumberCommandRequest", SourceClient, "USE_NUMBER", true, true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			info, ok := Info(test.Type)
+			if !ok {
+				t.Fatal("unknown type")
+			}
+			if info.Name != test.Name || info.Source != test.Source || info.Ifdef != test.Ifdef ||
+				info.Log != test.Log || info.NoDelay != test.NoDelay {
+				t.Fatalf("unexpected info %+v", info)
+			}
+		})
+	}
+
+	if _, ok := Info(UnknownType); ok {
+		t.Error("expected unknown type to have no info")
+	}
+}
+
+func TestInfoOf(t *testing.T) {
+	for kind, info := range messages {
+		if info.Type != kind {
+			t.Errorf("%s: expected type %d, got %d", info.Name, kind, info.Type)
+		}
+		other, ok := InfoOf(info.new())
+		if !ok || other.Name != info.Name {
+			t.Errorf("%s: new message has info %+v", info.Name, other)
+		}
+	}
+}
@@ -0,0 +1,1348 @@
+// Code generated by gen.go from api.proto. DO NOT EDIT.
+
+package api
+
+import "github.com/golang/protobuf/proto"
+
+// API request/response types.
+const (
+	UnknownType                                     = 0
+	HelloRequestType                                = 1
+	HelloResponseType                               = 2
+	ConnectRequestType                              = 3
+	ConnectResponseType                             = 4
+	DisconnectRequestType                           = 5
+	DisconnectResponseType                          = 6
+	PingRequestType                                 = 7
+	PingResponseType                                = 8
+	DeviceInfoRequestType                           = 9
+	DeviceInfoResponseType                          = 10
+	ListEntitiesRequestType                         = 11
+	ListEntitiesBinarySensorResponseType            = 12
+	ListEntitiesCoverResponseType                   = 13
+	ListEntitiesFanResponseType                     = 14
+	ListEntitiesLightResponseType                   = 15
+	ListEntitiesSensorResponseType                  = 16
+	ListEntitiesSwitchResponseType                  = 17
+	ListEntitiesTextSensorResponseType              = 18
+	ListEntitiesDoneResponseType                    = 19
+	SubscribeStatesRequestType                      = 20
+	BinarySensorStateResponseType                   = 21
+	CoverStateResponseType                          = 22
+	FanStateResponseType                            = 23
+	LightStateResponseType                          = 24
+	SensorStateResponseType                         = 25
+	SwitchStateResponseType                         = 26
+	TextSensorStateResponseType                     = 27
+	SubscribeLogsRequestType                        = 28
+	SubscribeLogsResponseType                       = 29
+	CoverCommandRequestType                         = 30
+	FanCommandRequestType                           = 31
+	LightCommandRequestType                         = 32
+	SwitchCommandRequestType                        = 33
+	SubscribeHomeAssistantServicesRequestType       = 34
+	HomeAssistantServiceResponseType                = 35
+	GetTimeRequestType                              = 36
+	GetTimeResponseType                             = 37
+	SubscribeHomeAssistantStatesRequestType         = 38
+	SubscribeHomeAssistantStateResponseType         = 39
+	HomeAssistantStateResponseType                  = 40
+	ListEntitiesServicesResponseType                = 41
+	ExecuteServiceRequestType                       = 42
+	ListEntitiesCameraResponseType                  = 43
+	CameraImageResponseType                         = 44
+	CameraImageRequestType                          = 45
+	ListEntitiesClimateResponseType                 = 46
+	ClimateStateResponseType                        = 47
+	ClimateCommandRequestType                       = 48
+	ListEntitiesNumberResponseType                  = 49
+	NumberStateResponseType                         = 50
+	NumberCommandRequestType                        = 51
+	ListEntitiesSelectResponseType                  = 52
+	SelectStateResponseType                         = 53
+	SelectCommandRequestType                        = 54
+	ListEntitiesSirenResponseType                   = 55
+	SirenStateResponseType                          = 56
+	SirenCommandRequestType                         = 57
+	ListEntitiesLockResponseType                    = 58
+	LockStateResponseType                           = 59
+	LockCommandRequestType                          = 60
+	ListEntitiesButtonResponseType                  = 61
+	ButtonCommandRequestType                        = 62
+	ListEntitiesMediaPlayerResponseType             = 63
+	MediaPlayerStateResponseType                    = 64
+	MediaPlayerCommandRequestType                   = 65
+	SubscribeBluetoothLEAdvertisementsRequestType   = 66
+	BluetoothLEAdvertisementResponseType            = 67
+	BluetoothDeviceRequestMessageType               = 68
+	BluetoothDeviceConnectionResponseType           = 69
+	BluetoothGATTGetServicesRequestType             = 70
+	BluetoothGATTGetServicesResponseType            = 71
+	BluetoothGATTGetServicesDoneResponseType        = 72
+	BluetoothGATTReadRequestType                    = 73
+	BluetoothGATTReadResponseType                   = 74
+	BluetoothGATTWriteRequestType                   = 75
+	BluetoothGATTReadDescriptorRequestType          = 76
+	BluetoothGATTWriteDescriptorRequestType         = 77
+	BluetoothGATTNotifyRequestType                  = 78
+	BluetoothGATTNotifyDataResponseType             = 79
+	SubscribeBluetoothConnectionsFreeRequestType    = 80
+	BluetoothConnectionsFreeResponseType            = 81
+	BluetoothGATTErrorResponseType                  = 82
+	BluetoothGATTWriteResponseType                  = 83
+	BluetoothGATTNotifyResponseType                 = 84
+	BluetoothDevicePairingResponseType              = 85
+	BluetoothDeviceUnpairingResponseType            = 86
+	UnsubscribeBluetoothLEAdvertisementsRequestType = 87
+	BluetoothDeviceClearCacheResponseType           = 88
+	SubscribeVoiceAssistantRequestType              = 89
+	VoiceAssistantRequestType                       = 90
+	VoiceAssistantResponseType                      = 91
+	VoiceAssistantEventResponseType                 = 92
+	BluetoothLERawAdvertisementsResponseType        = 93
+	ListEntitiesAlarmControlPanelResponseType       = 94
+	AlarmControlPanelStateResponseType              = 95
+	AlarmControlPanelCommandRequestType             = 96
+	ListEntitiesTextResponseType                    = 97
+	TextStateResponseType                           = 98
+	TextCommandRequestType                          = 99
+	ListEntitiesDateResponseType                    = 100
+	DateStateResponseType                           = 101
+	DateCommandRequestType                          = 102
+	ListEntitiesTimeResponseType                    = 103
+	TimeStateResponseType                           = 104
+	TimeCommandRequestType                          = 105
+	VoiceAssistantAudioType                         = 106
+	ListEntitiesEventResponseType                   = 107
+	EventResponseType                               = 108
+	ListEntitiesValveResponseType                   = 109
+	ValveStateResponseType                          = 110
+	ValveCommandRequestType                         = 111
+	ListEntitiesDateTimeResponseType                = 112
+	DateTimeStateResponseType                       = 113
+	DateTimeCommandRequestType                      = 114
+	VoiceAssistantTimerEventResponseType            = 115
+	ListEntitiesUpdateResponseType                  = 116
+	UpdateStateResponseType                         = 117
+	UpdateCommandRequestType                        = 118
+)
+
+// messages are the known message types.
+var messages = map[uint64]MessageInfo{
+	HelloRequestType: {
+		Type:    HelloRequestType,
+		Name:    "HelloRequest",
+		Source:  SourceClient,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(HelloRequest) },
+	},
+	HelloResponseType: {
+		Type:    HelloResponseType,
+		Name:    "HelloResponse",
+		Source:  SourceServer,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(HelloResponse) },
+	},
+	ConnectRequestType: {
+		Type:    ConnectRequestType,
+		Name:    "ConnectRequest",
+		Source:  SourceClient,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ConnectRequest) },
+	},
+	ConnectResponseType: {
+		Type:    ConnectResponseType,
+		Name:    "ConnectResponse",
+		Source:  SourceServer,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ConnectResponse) },
+	},
+	DisconnectRequestType: {
+		Type:    DisconnectRequestType,
+		Name:    "DisconnectRequest",
+		Source:  SourceBoth,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(DisconnectRequest) },
+	},
+	DisconnectResponseType: {
+		Type:    DisconnectResponseType,
+		Name:    "DisconnectResponse",
+		Source:  SourceBoth,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(DisconnectResponse) },
+	},
+	PingRequestType: {
+		Type:   PingRequestType,
+		Name:   "PingRequest",
+		Source: SourceBoth,
+		Log:    true,
+		new:    func() proto.Message { return new(PingRequest) },
+	},
+	PingResponseType: {
+		Type:   PingResponseType,
+		Name:   "PingResponse",
+		Source: SourceBoth,
+		Log:    true,
+		new:    func() proto.Message { return new(PingResponse) },
+	},
+	DeviceInfoRequestType: {
+		Type:   DeviceInfoRequestType,
+		Name:   "DeviceInfoRequest",
+		Source: SourceClient,
+		Log:    true,
+		new:    func() proto.Message { return new(DeviceInfoRequest) },
+	},
+	DeviceInfoResponseType: {
+		Type:   DeviceInfoResponseType,
+		Name:   "DeviceInfoResponse",
+		Source: SourceServer,
+		Log:    true,
+		new:    func() proto.Message { return new(DeviceInfoResponse) },
+	},
+	ListEntitiesRequestType: {
+		Type:   ListEntitiesRequestType,
+		Name:   "ListEntitiesRequest",
+		Source: SourceClient,
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesRequest) },
+	},
+	ListEntitiesBinarySensorResponseType: {
+		Type:   ListEntitiesBinarySensorResponseType,
+		Name:   "ListEntitiesBinarySensorResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BINARY_SENSOR",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesBinarySensorResponse) },
+	},
+	ListEntitiesCoverResponseType: {
+		Type:   ListEntitiesCoverResponseType,
+		Name:   "ListEntitiesCoverResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_COVER",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesCoverResponse) },
+	},
+	ListEntitiesFanResponseType: {
+		Type:   ListEntitiesFanResponseType,
+		Name:   "ListEntitiesFanResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_FAN",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesFanResponse) },
+	},
+	ListEntitiesLightResponseType: {
+		Type:   ListEntitiesLightResponseType,
+		Name:   "ListEntitiesLightResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_LIGHT",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesLightResponse) },
+	},
+	ListEntitiesSensorResponseType: {
+		Type:   ListEntitiesSensorResponseType,
+		Name:   "ListEntitiesSensorResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_SENSOR",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesSensorResponse) },
+	},
+	ListEntitiesSwitchResponseType: {
+		Type:   ListEntitiesSwitchResponseType,
+		Name:   "ListEntitiesSwitchResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_SWITCH",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesSwitchResponse) },
+	},
+	ListEntitiesTextSensorResponseType: {
+		Type:   ListEntitiesTextSensorResponseType,
+		Name:   "ListEntitiesTextSensorResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_TEXT_SENSOR",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesTextSensorResponse) },
+	},
+	ListEntitiesDoneResponseType: {
+		Type:    ListEntitiesDoneResponseType,
+		Name:    "ListEntitiesDoneResponse",
+		Source:  SourceServer,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ListEntitiesDoneResponse) },
+	},
+	SubscribeStatesRequestType: {
+		Type:   SubscribeStatesRequestType,
+		Name:   "SubscribeStatesRequest",
+		Source: SourceClient,
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeStatesRequest) },
+	},
+	BinarySensorStateResponseType: {
+		Type:    BinarySensorStateResponseType,
+		Name:    "BinarySensorStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_BINARY_SENSOR",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(BinarySensorStateResponse) },
+	},
+	CoverStateResponseType: {
+		Type:    CoverStateResponseType,
+		Name:    "CoverStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_COVER",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(CoverStateResponse) },
+	},
+	FanStateResponseType: {
+		Type:    FanStateResponseType,
+		Name:    "FanStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_FAN",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(FanStateResponse) },
+	},
+	LightStateResponseType: {
+		Type:    LightStateResponseType,
+		Name:    "LightStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_LIGHT",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(LightStateResponse) },
+	},
+	SensorStateResponseType: {
+		Type:    SensorStateResponseType,
+		Name:    "SensorStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_SENSOR",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SensorStateResponse) },
+	},
+	SwitchStateResponseType: {
+		Type:    SwitchStateResponseType,
+		Name:    "SwitchStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_SWITCH",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SwitchStateResponse) },
+	},
+	TextSensorStateResponseType: {
+		Type:    TextSensorStateResponseType,
+		Name:    "TextSensorStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_TEXT_SENSOR",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(TextSensorStateResponse) },
+	},
+	SubscribeLogsRequestType: {
+		Type:   SubscribeLogsRequestType,
+		Name:   "SubscribeLogsRequest",
+		Source: SourceClient,
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeLogsRequest) },
+	},
+	SubscribeLogsResponseType: {
+		Type:   SubscribeLogsResponseType,
+		Name:   "SubscribeLogsResponse",
+		Source: SourceServer,
+		Log:    false,
+		new:    func() proto.Message { return new(SubscribeLogsResponse) },
+	},
+	CoverCommandRequestType: {
+		Type:    CoverCommandRequestType,
+		Name:    "CoverCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_COVER",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(CoverCommandRequest) },
+	},
+	FanCommandRequestType: {
+		Type:    FanCommandRequestType,
+		Name:    "FanCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_FAN",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(FanCommandRequest) },
+	},
+	LightCommandRequestType: {
+		Type:    LightCommandRequestType,
+		Name:    "LightCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_LIGHT",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(LightCommandRequest) },
+	},
+	SwitchCommandRequestType: {
+		Type:    SwitchCommandRequestType,
+		Name:    "SwitchCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_SWITCH",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SwitchCommandRequest) },
+	},
+	SubscribeHomeAssistantServicesRequestType: {
+		Type:   SubscribeHomeAssistantServicesRequestType,
+		Name:   "SubscribeHomeassistantServicesRequest",
+		Source: SourceClient,
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeHomeassistantServicesRequest) },
+	},
+	HomeAssistantServiceResponseType: {
+		Type:    HomeAssistantServiceResponseType,
+		Name:    "HomeassistantServiceResponse",
+		Source:  SourceServer,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(HomeassistantServiceResponse) },
+	},
+	GetTimeRequestType: {
+		Type:   GetTimeRequestType,
+		Name:   "GetTimeRequest",
+		Source: SourceBoth,
+		Log:    true,
+		new:    func() proto.Message { return new(GetTimeRequest) },
+	},
+	GetTimeResponseType: {
+		Type:    GetTimeResponseType,
+		Name:    "GetTimeResponse",
+		Source:  SourceBoth,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(GetTimeResponse) },
+	},
+	SubscribeHomeAssistantStatesRequestType: {
+		Type:   SubscribeHomeAssistantStatesRequestType,
+		Name:   "SubscribeHomeAssistantStatesRequest",
+		Source: SourceClient,
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeHomeAssistantStatesRequest) },
+	},
+	SubscribeHomeAssistantStateResponseType: {
+		Type:   SubscribeHomeAssistantStateResponseType,
+		Name:   "SubscribeHomeAssistantStateResponse",
+		Source: SourceServer,
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeHomeAssistantStateResponse) },
+	},
+	HomeAssistantStateResponseType: {
+		Type:    HomeAssistantStateResponseType,
+		Name:    "HomeAssistantStateResponse",
+		Source:  SourceClient,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(HomeAssistantStateResponse) },
+	},
+	ListEntitiesServicesResponseType: {
+		Type:   ListEntitiesServicesResponseType,
+		Name:   "ListEntitiesServicesResponse",
+		Source: SourceServer,
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesServicesResponse) },
+	},
+	ExecuteServiceRequestType: {
+		Type:    ExecuteServiceRequestType,
+		Name:    "ExecuteServiceRequest",
+		Source:  SourceClient,
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ExecuteServiceRequest) },
+	},
+	ListEntitiesCameraResponseType: {
+		Type:   ListEntitiesCameraResponseType,
+		Name:   "ListEntitiesCameraResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_ESP32_CAMERA",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesCameraResponse) },
+	},
+	CameraImageResponseType: {
+		Type:   CameraImageResponseType,
+		Name:   "CameraImageResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_ESP32_CAMERA",
+		Log:    true,
+		new:    func() proto.Message { return new(CameraImageResponse) },
+	},
+	CameraImageRequestType: {
+		Type:    CameraImageRequestType,
+		Name:    "CameraImageRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_ESP32_CAMERA",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(CameraImageRequest) },
+	},
+	ListEntitiesClimateResponseType: {
+		Type:   ListEntitiesClimateResponseType,
+		Name:   "ListEntitiesClimateResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_CLIMATE",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesClimateResponse) },
+	},
+	ClimateStateResponseType: {
+		Type:    ClimateStateResponseType,
+		Name:    "ClimateStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_CLIMATE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ClimateStateResponse) },
+	},
+	ClimateCommandRequestType: {
+		Type:    ClimateCommandRequestType,
+		Name:    "ClimateCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_CLIMATE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ClimateCommandRequest) },
+	},
+	ListEntitiesNumberResponseType: {
+		Type:   ListEntitiesNumberResponseType,
+		Name:   "ListEntitiesNumberResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_NUMBER",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesNumberResponse) },
+	},
+	NumberStateResponseType: {
+		Type:    NumberStateResponseType,
+		Name:    "NumberStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_NUMBER",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(NumberStateResponse) },
+	},
+	NumberCommandRequestType: {
+		Type:    NumberCommandRequestType,
+		Name:    "NumberCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_NUMBER",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(NumberCommandRequest) },
+	},
+	ListEntitiesSelectResponseType: {
+		Type:   ListEntitiesSelectResponseType,
+		Name:   "ListEntitiesSelectResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_SELECT",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesSelectResponse) },
+	},
+	SelectStateResponseType: {
+		Type:    SelectStateResponseType,
+		Name:    "SelectStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_SELECT",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SelectStateResponse) },
+	},
+	SelectCommandRequestType: {
+		Type:    SelectCommandRequestType,
+		Name:    "SelectCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_SELECT",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SelectCommandRequest) },
+	},
+	ListEntitiesSirenResponseType: {
+		Type:   ListEntitiesSirenResponseType,
+		Name:   "ListEntitiesSirenResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_SIREN",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesSirenResponse) },
+	},
+	SirenStateResponseType: {
+		Type:    SirenStateResponseType,
+		Name:    "SirenStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_SIREN",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SirenStateResponse) },
+	},
+	SirenCommandRequestType: {
+		Type:    SirenCommandRequestType,
+		Name:    "SirenCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_SIREN",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(SirenCommandRequest) },
+	},
+	ListEntitiesLockResponseType: {
+		Type:   ListEntitiesLockResponseType,
+		Name:   "ListEntitiesLockResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_LOCK",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesLockResponse) },
+	},
+	LockStateResponseType: {
+		Type:    LockStateResponseType,
+		Name:    "LockStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_LOCK",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(LockStateResponse) },
+	},
+	LockCommandRequestType: {
+		Type:    LockCommandRequestType,
+		Name:    "LockCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_LOCK",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(LockCommandRequest) },
+	},
+	ListEntitiesButtonResponseType: {
+		Type:   ListEntitiesButtonResponseType,
+		Name:   "ListEntitiesButtonResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BUTTON",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesButtonResponse) },
+	},
+	ButtonCommandRequestType: {
+		Type:    ButtonCommandRequestType,
+		Name:    "ButtonCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_BUTTON",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ButtonCommandRequest) },
+	},
+	ListEntitiesMediaPlayerResponseType: {
+		Type:   ListEntitiesMediaPlayerResponseType,
+		Name:   "ListEntitiesMediaPlayerResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_MEDIA_PLAYER",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesMediaPlayerResponse) },
+	},
+	MediaPlayerStateResponseType: {
+		Type:    MediaPlayerStateResponseType,
+		Name:    "MediaPlayerStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_MEDIA_PLAYER",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(MediaPlayerStateResponse) },
+	},
+	MediaPlayerCommandRequestType: {
+		Type:    MediaPlayerCommandRequestType,
+		Name:    "MediaPlayerCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_MEDIA_PLAYER",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(MediaPlayerCommandRequest) },
+	},
+	SubscribeBluetoothLEAdvertisementsRequestType: {
+		Type:   SubscribeBluetoothLEAdvertisementsRequestType,
+		Name:   "SubscribeBluetoothLEAdvertisementsRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeBluetoothLEAdvertisementsRequest) },
+	},
+	BluetoothLEAdvertisementResponseType: {
+		Type:    BluetoothLEAdvertisementResponseType,
+		Name:    "BluetoothLEAdvertisementResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_BLUETOOTH_PROXY",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(BluetoothLEAdvertisementResponse) },
+	},
+	BluetoothDeviceRequestMessageType: {
+		Type:   BluetoothDeviceRequestMessageType,
+		Name:   "BluetoothDeviceRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothDeviceRequest) },
+	},
+	BluetoothDeviceConnectionResponseType: {
+		Type:   BluetoothDeviceConnectionResponseType,
+		Name:   "BluetoothDeviceConnectionResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothDeviceConnectionResponse) },
+	},
+	BluetoothGATTGetServicesRequestType: {
+		Type:   BluetoothGATTGetServicesRequestType,
+		Name:   "BluetoothGATTGetServicesRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTGetServicesRequest) },
+	},
+	BluetoothGATTGetServicesResponseType: {
+		Type:   BluetoothGATTGetServicesResponseType,
+		Name:   "BluetoothGATTGetServicesResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTGetServicesResponse) },
+	},
+	BluetoothGATTGetServicesDoneResponseType: {
+		Type:   BluetoothGATTGetServicesDoneResponseType,
+		Name:   "BluetoothGATTGetServicesDoneResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTGetServicesDoneResponse) },
+	},
+	BluetoothGATTReadRequestType: {
+		Type:   BluetoothGATTReadRequestType,
+		Name:   "BluetoothGATTReadRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTReadRequest) },
+	},
+	BluetoothGATTReadResponseType: {
+		Type:   BluetoothGATTReadResponseType,
+		Name:   "BluetoothGATTReadResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTReadResponse) },
+	},
+	BluetoothGATTWriteRequestType: {
+		Type:   BluetoothGATTWriteRequestType,
+		Name:   "BluetoothGATTWriteRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTWriteRequest) },
+	},
+	BluetoothGATTReadDescriptorRequestType: {
+		Type:   BluetoothGATTReadDescriptorRequestType,
+		Name:   "BluetoothGATTReadDescriptorRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTReadDescriptorRequest) },
+	},
+	BluetoothGATTWriteDescriptorRequestType: {
+		Type:   BluetoothGATTWriteDescriptorRequestType,
+		Name:   "BluetoothGATTWriteDescriptorRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTWriteDescriptorRequest) },
+	},
+	BluetoothGATTNotifyRequestType: {
+		Type:   BluetoothGATTNotifyRequestType,
+		Name:   "BluetoothGATTNotifyRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTNotifyRequest) },
+	},
+	BluetoothGATTNotifyDataResponseType: {
+		Type:   BluetoothGATTNotifyDataResponseType,
+		Name:   "BluetoothGATTNotifyDataResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTNotifyDataResponse) },
+	},
+	SubscribeBluetoothConnectionsFreeRequestType: {
+		Type:   SubscribeBluetoothConnectionsFreeRequestType,
+		Name:   "SubscribeBluetoothConnectionsFreeRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeBluetoothConnectionsFreeRequest) },
+	},
+	BluetoothConnectionsFreeResponseType: {
+		Type:   BluetoothConnectionsFreeResponseType,
+		Name:   "BluetoothConnectionsFreeResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothConnectionsFreeResponse) },
+	},
+	BluetoothGATTErrorResponseType: {
+		Type:   BluetoothGATTErrorResponseType,
+		Name:   "BluetoothGATTErrorResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTErrorResponse) },
+	},
+	BluetoothGATTWriteResponseType: {
+		Type:   BluetoothGATTWriteResponseType,
+		Name:   "BluetoothGATTWriteResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTWriteResponse) },
+	},
+	BluetoothGATTNotifyResponseType: {
+		Type:   BluetoothGATTNotifyResponseType,
+		Name:   "BluetoothGATTNotifyResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothGATTNotifyResponse) },
+	},
+	BluetoothDevicePairingResponseType: {
+		Type:   BluetoothDevicePairingResponseType,
+		Name:   "BluetoothDevicePairingResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothDevicePairingResponse) },
+	},
+	BluetoothDeviceUnpairingResponseType: {
+		Type:   BluetoothDeviceUnpairingResponseType,
+		Name:   "BluetoothDeviceUnpairingResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothDeviceUnpairingResponse) },
+	},
+	UnsubscribeBluetoothLEAdvertisementsRequestType: {
+		Type:   UnsubscribeBluetoothLEAdvertisementsRequestType,
+		Name:   "UnsubscribeBluetoothLEAdvertisementsRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(UnsubscribeBluetoothLEAdvertisementsRequest) },
+	},
+	BluetoothDeviceClearCacheResponseType: {
+		Type:   BluetoothDeviceClearCacheResponseType,
+		Name:   "BluetoothDeviceClearCacheResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_BLUETOOTH_PROXY",
+		Log:    true,
+		new:    func() proto.Message { return new(BluetoothDeviceClearCacheResponse) },
+	},
+	SubscribeVoiceAssistantRequestType: {
+		Type:   SubscribeVoiceAssistantRequestType,
+		Name:   "SubscribeVoiceAssistantRequest",
+		Source: SourceClient,
+		Ifdef:  "USE_VOICE_ASSISTANT",
+		Log:    true,
+		new:    func() proto.Message { return new(SubscribeVoiceAssistantRequest) },
+	},
+	VoiceAssistantRequestType: {
+		Type:   VoiceAssistantRequestType,
+		Name:   "VoiceAssistantRequest",
+		Source: SourceServer,
+		Ifdef:  "USE_VOICE_ASSISTANT",
+		Log:    true,
+		new:    func() proto.Message { return new(VoiceAssistantRequest) },
+	},
+	VoiceAssistantResponseType: {
+		Type:   VoiceAssistantResponseType,
+		Name:   "VoiceAssistantResponse",
+		Source: SourceClient,
+		Ifdef:  "USE_VOICE_ASSISTANT",
+		Log:    true,
+		new:    func() proto.Message { return new(VoiceAssistantResponse) },
+	},
+	VoiceAssistantEventResponseType: {
+		Type:   VoiceAssistantEventResponseType,
+		Name:   "VoiceAssistantEventResponse",
+		Source: SourceClient,
+		Ifdef:  "USE_VOICE_ASSISTANT",
+		Log:    true,
+		new:    func() proto.Message { return new(VoiceAssistantEventResponse) },
+	},
+	BluetoothLERawAdvertisementsResponseType: {
+		Type:    BluetoothLERawAdvertisementsResponseType,
+		Name:    "BluetoothLERawAdvertisementsResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_BLUETOOTH_PROXY",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(BluetoothLERawAdvertisementsResponse) },
+	},
+	ListEntitiesAlarmControlPanelResponseType: {
+		Type:   ListEntitiesAlarmControlPanelResponseType,
+		Name:   "ListEntitiesAlarmControlPanelResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_ALARM_CONTROL_PANEL",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesAlarmControlPanelResponse) },
+	},
+	AlarmControlPanelStateResponseType: {
+		Type:    AlarmControlPanelStateResponseType,
+		Name:    "AlarmControlPanelStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_ALARM_CONTROL_PANEL",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(AlarmControlPanelStateResponse) },
+	},
+	AlarmControlPanelCommandRequestType: {
+		Type:    AlarmControlPanelCommandRequestType,
+		Name:    "AlarmControlPanelCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_ALARM_CONTROL_PANEL",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(AlarmControlPanelCommandRequest) },
+	},
+	ListEntitiesTextResponseType: {
+		Type:   ListEntitiesTextResponseType,
+		Name:   "ListEntitiesTextResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_TEXT",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesTextResponse) },
+	},
+	TextStateResponseType: {
+		Type:    TextStateResponseType,
+		Name:    "TextStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_TEXT",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(TextStateResponse) },
+	},
+	TextCommandRequestType: {
+		Type:    TextCommandRequestType,
+		Name:    "TextCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_TEXT",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(TextCommandRequest) },
+	},
+	ListEntitiesDateResponseType: {
+		Type:   ListEntitiesDateResponseType,
+		Name:   "ListEntitiesDateResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_DATETIME_DATE",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesDateResponse) },
+	},
+	DateStateResponseType: {
+		Type:    DateStateResponseType,
+		Name:    "DateStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_DATETIME_DATE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(DateStateResponse) },
+	},
+	DateCommandRequestType: {
+		Type:    DateCommandRequestType,
+		Name:    "DateCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_DATETIME_DATE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(DateCommandRequest) },
+	},
+	ListEntitiesTimeResponseType: {
+		Type:   ListEntitiesTimeResponseType,
+		Name:   "ListEntitiesTimeResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_DATETIME_TIME",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesTimeResponse) },
+	},
+	TimeStateResponseType: {
+		Type:    TimeStateResponseType,
+		Name:    "TimeStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_DATETIME_TIME",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(TimeStateResponse) },
+	},
+	TimeCommandRequestType: {
+		Type:    TimeCommandRequestType,
+		Name:    "TimeCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_DATETIME_TIME",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(TimeCommandRequest) },
+	},
+	VoiceAssistantAudioType: {
+		Type:   VoiceAssistantAudioType,
+		Name:   "VoiceAssistantAudio",
+		Source: SourceBoth,
+		Ifdef:  "USE_VOICE_ASSISTANT",
+		Log:    true,
+		new:    func() proto.Message { return new(VoiceAssistantAudio) },
+	},
+	ListEntitiesEventResponseType: {
+		Type:   ListEntitiesEventResponseType,
+		Name:   "ListEntitiesEventResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_EVENT",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesEventResponse) },
+	},
+	EventResponseType: {
+		Type:   EventResponseType,
+		Name:   "EventResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_EVENT",
+		Log:    true,
+		new:    func() proto.Message { return new(EventResponse) },
+	},
+	ListEntitiesValveResponseType: {
+		Type:   ListEntitiesValveResponseType,
+		Name:   "ListEntitiesValveResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_VALVE",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesValveResponse) },
+	},
+	ValveStateResponseType: {
+		Type:    ValveStateResponseType,
+		Name:    "ValveStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_VALVE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ValveStateResponse) },
+	},
+	ValveCommandRequestType: {
+		Type:    ValveCommandRequestType,
+		Name:    "ValveCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_VALVE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(ValveCommandRequest) },
+	},
+	ListEntitiesDateTimeResponseType: {
+		Type:   ListEntitiesDateTimeResponseType,
+		Name:   "ListEntitiesDateTimeResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_DATETIME_DATETIME",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesDateTimeResponse) },
+	},
+	DateTimeStateResponseType: {
+		Type:    DateTimeStateResponseType,
+		Name:    "DateTimeStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_DATETIME_DATETIME",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(DateTimeStateResponse) },
+	},
+	DateTimeCommandRequestType: {
+		Type:    DateTimeCommandRequestType,
+		Name:    "DateTimeCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_DATETIME_DATETIME",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(DateTimeCommandRequest) },
+	},
+	VoiceAssistantTimerEventResponseType: {
+		Type:   VoiceAssistantTimerEventResponseType,
+		Name:   "VoiceAssistantTimerEventResponse",
+		Source: SourceClient,
+		Ifdef:  "USE_VOICE_ASSISTANT",
+		Log:    true,
+		new:    func() proto.Message { return new(VoiceAssistantTimerEventResponse) },
+	},
+	ListEntitiesUpdateResponseType: {
+		Type:   ListEntitiesUpdateResponseType,
+		Name:   "ListEntitiesUpdateResponse",
+		Source: SourceServer,
+		Ifdef:  "USE_UPDATE",
+		Log:    true,
+		new:    func() proto.Message { return new(ListEntitiesUpdateResponse) },
+	},
+	UpdateStateResponseType: {
+		Type:    UpdateStateResponseType,
+		Name:    "UpdateStateResponse",
+		Source:  SourceServer,
+		Ifdef:   "USE_UPDATE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(UpdateStateResponse) },
+	},
+	UpdateCommandRequestType: {
+		Type:    UpdateCommandRequestType,
+		Name:    "UpdateCommandRequest",
+		Source:  SourceClient,
+		Ifdef:   "USE_UPDATE",
+		Log:     true,
+		NoDelay: true,
+		new:     func() proto.Message { return new(UpdateCommandRequest) },
+	},
+}
+
+// TypeOf returns the message type of a message, or UnknownType.
+func TypeOf(value interface{}) uint64 {
+	switch value.(type) {
+	case HelloRequest, *HelloRequest:
+		return HelloRequestType
+	case HelloResponse, *HelloResponse:
+		return HelloResponseType
+	case ConnectRequest, *ConnectRequest:
+		return ConnectRequestType
+	case ConnectResponse, *ConnectResponse:
+		return ConnectResponseType
+	case DisconnectRequest, *DisconnectRequest:
+		return DisconnectRequestType
+	case DisconnectResponse, *DisconnectResponse:
+		return DisconnectResponseType
+	case PingRequest, *PingRequest:
+		return PingRequestType
+	case PingResponse, *PingResponse:
+		return PingResponseType
+	case DeviceInfoRequest, *DeviceInfoRequest:
+		return DeviceInfoRequestType
+	case DeviceInfoResponse, *DeviceInfoResponse:
+		return DeviceInfoResponseType
+	case ListEntitiesRequest, *ListEntitiesRequest:
+		return ListEntitiesRequestType
+	case ListEntitiesBinarySensorResponse, *ListEntitiesBinarySensorResponse:
+		return ListEntitiesBinarySensorResponseType
+	case ListEntitiesCoverResponse, *ListEntitiesCoverResponse:
+		return ListEntitiesCoverResponseType
+	case ListEntitiesFanResponse, *ListEntitiesFanResponse:
+		return ListEntitiesFanResponseType
+	case ListEntitiesLightResponse, *ListEntitiesLightResponse:
+		return ListEntitiesLightResponseType
+	case ListEntitiesSensorResponse, *ListEntitiesSensorResponse:
+		return ListEntitiesSensorResponseType
+	case ListEntitiesSwitchResponse, *ListEntitiesSwitchResponse:
+		return ListEntitiesSwitchResponseType
+	case ListEntitiesTextSensorResponse, *ListEntitiesTextSensorResponse:
+		return ListEntitiesTextSensorResponseType
+	case ListEntitiesDoneResponse, *ListEntitiesDoneResponse:
+		return ListEntitiesDoneResponseType
+	case SubscribeStatesRequest, *SubscribeStatesRequest:
+		return SubscribeStatesRequestType
+	case BinarySensorStateResponse, *BinarySensorStateResponse:
+		return BinarySensorStateResponseType
+	case CoverStateResponse, *CoverStateResponse:
+		return CoverStateResponseType
+	case FanStateResponse, *FanStateResponse:
+		return FanStateResponseType
+	case LightStateResponse, *LightStateResponse:
+		return LightStateResponseType
+	case SensorStateResponse, *SensorStateResponse:
+		return SensorStateResponseType
+	case SwitchStateResponse, *SwitchStateResponse:
+		return SwitchStateResponseType
+	case TextSensorStateResponse, *TextSensorStateResponse:
+		return TextSensorStateResponseType
+	case SubscribeLogsRequest, *SubscribeLogsRequest:
+		return SubscribeLogsRequestType
+	case SubscribeLogsResponse, *SubscribeLogsResponse:
+		return SubscribeLogsResponseType
+	case CoverCommandRequest, *CoverCommandRequest:
+		return CoverCommandRequestType
+	case FanCommandRequest, *FanCommandRequest:
+		return FanCommandRequestType
+	case LightCommandRequest, *LightCommandRequest:
+		return LightCommandRequestType
+	case SwitchCommandRequest, *SwitchCommandRequest:
+		return SwitchCommandRequestType
+	case SubscribeHomeassistantServicesRequest, *SubscribeHomeassistantServicesRequest:
+		return SubscribeHomeAssistantServicesRequestType
+	case HomeassistantServiceResponse, *HomeassistantServiceResponse:
+		return HomeAssistantServiceResponseType
+	case GetTimeRequest, *GetTimeRequest:
+		return GetTimeRequestType
+	case GetTimeResponse, *GetTimeResponse:
+		return GetTimeResponseType
+	case SubscribeHomeAssistantStatesRequest, *SubscribeHomeAssistantStatesRequest:
+		return SubscribeHomeAssistantStatesRequestType
+	case SubscribeHomeAssistantStateResponse, *SubscribeHomeAssistantStateResponse:
+		return SubscribeHomeAssistantStateResponseType
+	case HomeAssistantStateResponse, *HomeAssistantStateResponse:
+		return HomeAssistantStateResponseType
+	case ListEntitiesServicesResponse, *ListEntitiesServicesResponse:
+		return ListEntitiesServicesResponseType
+	case ExecuteServiceRequest, *ExecuteServiceRequest:
+		return ExecuteServiceRequestType
+	case ListEntitiesCameraResponse, *ListEntitiesCameraResponse:
+		return ListEntitiesCameraResponseType
+	case CameraImageResponse, *CameraImageResponse:
+		return CameraImageResponseType
+	case CameraImageRequest, *CameraImageRequest:
+		return CameraImageRequestType
+	case ListEntitiesClimateResponse, *ListEntitiesClimateResponse:
+		return ListEntitiesClimateResponseType
+	case ClimateStateResponse, *ClimateStateResponse:
+		return ClimateStateResponseType
+	case ClimateCommandRequest, *ClimateCommandRequest:
+		return ClimateCommandRequestType
+	case ListEntitiesNumberResponse, *ListEntitiesNumberResponse:
+		return ListEntitiesNumberResponseType
+	case NumberStateResponse, *NumberStateResponse:
+		return NumberStateResponseType
+	case NumberCommandRequest, *NumberCommandRequest:
+		return NumberCommandRequestType
+	case ListEntitiesSelectResponse, *ListEntitiesSelectResponse:
+		return ListEntitiesSelectResponseType
+	case SelectStateResponse, *SelectStateResponse:
+		return SelectStateResponseType
+	case SelectCommandRequest, *SelectCommandRequest:
+		return SelectCommandRequestType
+	case ListEntitiesSirenResponse, *ListEntitiesSirenResponse:
+		return ListEntitiesSirenResponseType
+	case SirenStateResponse, *SirenStateResponse:
+		return SirenStateResponseType
+	case SirenCommandRequest, *SirenCommandRequest:
+		return SirenCommandRequestType
+	case ListEntitiesLockResponse, *ListEntitiesLockResponse:
+		return ListEntitiesLockResponseType
+	case LockStateResponse, *LockStateResponse:
+		return LockStateResponseType
+	case LockCommandRequest, *LockCommandRequest:
+		return LockCommandRequestType
+	case ListEntitiesButtonResponse, *ListEntitiesButtonResponse:
+		return ListEntitiesButtonResponseType
+	case ButtonCommandRequest, *ButtonCommandRequest:
+		return ButtonCommandRequestType
+	case ListEntitiesMediaPlayerResponse, *ListEntitiesMediaPlayerResponse:
+		return ListEntitiesMediaPlayerResponseType
+	case MediaPlayerStateResponse, *MediaPlayerStateResponse:
+		return MediaPlayerStateResponseType
+	case MediaPlayerCommandRequest, *MediaPlayerCommandRequest:
+		return MediaPlayerCommandRequestType
+	case SubscribeBluetoothLEAdvertisementsRequest, *SubscribeBluetoothLEAdvertisementsRequest:
+		return SubscribeBluetoothLEAdvertisementsRequestType
+	case BluetoothLEAdvertisementResponse, *BluetoothLEAdvertisementResponse:
+		return BluetoothLEAdvertisementResponseType
+	case BluetoothDeviceRequest, *BluetoothDeviceRequest:
+		return BluetoothDeviceRequestMessageType
+	case BluetoothDeviceConnectionResponse, *BluetoothDeviceConnectionResponse:
+		return BluetoothDeviceConnectionResponseType
+	case BluetoothGATTGetServicesRequest, *BluetoothGATTGetServicesRequest:
+		return BluetoothGATTGetServicesRequestType
+	case BluetoothGATTGetServicesResponse, *BluetoothGATTGetServicesResponse:
+		return BluetoothGATTGetServicesResponseType
+	case BluetoothGATTGetServicesDoneResponse, *BluetoothGATTGetServicesDoneResponse:
+		return BluetoothGATTGetServicesDoneResponseType
+	case BluetoothGATTReadRequest, *BluetoothGATTReadRequest:
+		return BluetoothGATTReadRequestType
+	case BluetoothGATTReadResponse, *BluetoothGATTReadResponse:
+		return BluetoothGATTReadResponseType
+	case BluetoothGATTWriteRequest, *BluetoothGATTWriteRequest:
+		return BluetoothGATTWriteRequestType
+	case BluetoothGATTReadDescriptorRequest, *BluetoothGATTReadDescriptorRequest:
+		return BluetoothGATTReadDescriptorRequestType
+	case BluetoothGATTWriteDescriptorRequest, *BluetoothGATTWriteDescriptorRequest:
+		return BluetoothGATTWriteDescriptorRequestType
+	case BluetoothGATTNotifyRequest, *BluetoothGATTNotifyRequest:
+		return BluetoothGATTNotifyRequestType
+	case BluetoothGATTNotifyDataResponse, *BluetoothGATTNotifyDataResponse:
+		return BluetoothGATTNotifyDataResponseType
+	case SubscribeBluetoothConnectionsFreeRequest, *SubscribeBluetoothConnectionsFreeRequest:
+		return SubscribeBluetoothConnectionsFreeRequestType
+	case BluetoothConnectionsFreeResponse, *BluetoothConnectionsFreeResponse:
+		return BluetoothConnectionsFreeResponseType
+	case BluetoothGATTErrorResponse, *BluetoothGATTErrorResponse:
+		return BluetoothGATTErrorResponseType
+	case BluetoothGATTWriteResponse, *BluetoothGATTWriteResponse:
+		return BluetoothGATTWriteResponseType
+	case BluetoothGATTNotifyResponse, *BluetoothGATTNotifyResponse:
+		return BluetoothGATTNotifyResponseType
+	case BluetoothDevicePairingResponse, *BluetoothDevicePairingResponse:
+		return BluetoothDevicePairingResponseType
+	case BluetoothDeviceUnpairingResponse, *BluetoothDeviceUnpairingResponse:
+		return BluetoothDeviceUnpairingResponseType
+	case UnsubscribeBluetoothLEAdvertisementsRequest, *UnsubscribeBluetoothLEAdvertisementsRequest:
+		return UnsubscribeBluetoothLEAdvertisementsRequestType
+	case BluetoothDeviceClearCacheResponse, *BluetoothDeviceClearCacheResponse:
+		return BluetoothDeviceClearCacheResponseType
+	case SubscribeVoiceAssistantRequest, *SubscribeVoiceAssistantRequest:
+		return SubscribeVoiceAssistantRequestType
+	case VoiceAssistantRequest, *VoiceAssistantRequest:
+		return VoiceAssistantRequestType
+	case VoiceAssistantResponse, *VoiceAssistantResponse:
+		return VoiceAssistantResponseType
+	case VoiceAssistantEventResponse, *VoiceAssistantEventResponse:
+		return VoiceAssistantEventResponseType
+	case BluetoothLERawAdvertisementsResponse, *BluetoothLERawAdvertisementsResponse:
+		return BluetoothLERawAdvertisementsResponseType
+	case ListEntitiesAlarmControlPanelResponse, *ListEntitiesAlarmControlPanelResponse:
+		return ListEntitiesAlarmControlPanelResponseType
+	case AlarmControlPanelStateResponse, *AlarmControlPanelStateResponse:
+		return AlarmControlPanelStateResponseType
+	case AlarmControlPanelCommandRequest, *AlarmControlPanelCommandRequest:
+		return AlarmControlPanelCommandRequestType
+	case ListEntitiesTextResponse, *ListEntitiesTextResponse:
+		return ListEntitiesTextResponseType
+	case TextStateResponse, *TextStateResponse:
+		return TextStateResponseType
+	case TextCommandRequest, *TextCommandRequest:
+		return TextCommandRequestType
+	case ListEntitiesDateResponse, *ListEntitiesDateResponse:
+		return ListEntitiesDateResponseType
+	case DateStateResponse, *DateStateResponse:
+		return DateStateResponseType
+	case DateCommandRequest, *DateCommandRequest:
+		return DateCommandRequestType
+	case ListEntitiesTimeResponse, *ListEntitiesTimeResponse:
+		return ListEntitiesTimeResponseType
+	case TimeStateResponse, *TimeStateResponse:
+		return TimeStateResponseType
+	case TimeCommandRequest, *TimeCommandRequest:
+		return TimeCommandRequestType
+	case VoiceAssistantAudio, *VoiceAssistantAudio:
+		return VoiceAssistantAudioType
+	case ListEntitiesEventResponse, *ListEntitiesEventResponse:
+		return ListEntitiesEventResponseType
+	case EventResponse, *EventResponse:
+		return EventResponseType
+	case ListEntitiesValveResponse, *ListEntitiesValveResponse:
+		return ListEntitiesValveResponseType
+	case ValveStateResponse, *ValveStateResponse:
+		return ValveStateResponseType
+	case ValveCommandRequest, *ValveCommandRequest:
+		return ValveCommandRequestType
+	case ListEntitiesDateTimeResponse, *ListEntitiesDateTimeResponse:
+		return ListEntitiesDateTimeResponseType
+	case DateTimeStateResponse, *DateTimeStateResponse:
+		return DateTimeStateResponseType
+	case DateTimeCommandRequest, *DateTimeCommandRequest:
+		return DateTimeCommandRequestType
+	case VoiceAssistantTimerEventResponse, *VoiceAssistantTimerEventResponse:
+		return VoiceAssistantTimerEventResponseType
+	case ListEntitiesUpdateResponse, *ListEntitiesUpdateResponse:
+		return ListEntitiesUpdateResponseType
+	case UpdateStateResponse, *UpdateStateResponse:
+		return UpdateStateResponseType
+	case UpdateCommandRequest, *UpdateCommandRequest:
+		return UpdateCommandRequestType
+	default:
+		return UnknownType
+	}
+}
@@ -0,0 +1,393 @@
+package api
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+)
+
+// Noise protocol parameters used by ESPHome.
+const (
+	noiseProtocol = "Noise_NNpsk0_25519_ChaChaPoly_SHA256"
+	noisePrologue = "NoiseAPIInit\x00\x00"
+	noiseKeySize  = 32
+	noiseMaxFrame = 0xffff
+)
+
+// Errors.
+var (
+	ErrHandshake = errors.New("api: noise handshake failed")
+	ErrPSK       = errors.New("api: noise pre-shared key must be 32 bytes")
+)
+
+// noiseCipher is a Noise CipherState.
+type noiseCipher struct {
+	aead cipher.AEAD
+	n    uint64
+}
+
+func newNoiseCipher(key []byte) *noiseCipher {
+	// The key size is always correct, so this can't fail.
+	aead, _ := chacha20poly1305.New(key[:noiseKeySize])
+	return &noiseCipher{aead: aead}
+}
+
+func (c *noiseCipher) nonce() []byte {
+	var nonce [chacha20poly1305.NonceSize]byte
+	binary.LittleEndian.PutUint64(nonce[4:], c.n)
+	return nonce[:]
+}
+
+func (c *noiseCipher) encrypt(ad, plaintext []byte) []byte {
+	ciphertext := c.aead.Seal(nil, c.nonce(), plaintext, ad)
+	c.n++
+	return ciphertext
+}
+
+func (c *noiseCipher) decrypt(ad, ciphertext []byte) ([]byte, error) {
+	plaintext, err := c.aead.Open(nil, c.nonce(), ciphertext, ad)
+	if err != nil {
+		return nil, err
+	}
+	c.n++
+	return plaintext, nil
+}
+
+// noiseHandshake is the Noise HandshakeState for the NNpsk0 pattern:
+//
+//	-> psk, e
+//	<- e, ee
+type noiseHandshake struct {
+	ck, h  [sha256.Size]byte
+	cipher *noiseCipher
+	psk    []byte
+	e      []byte // local ephemeral private key
+	re     []byte // remote ephemeral public key
+}
+
+func newNoiseHandshake(psk []byte) (*noiseHandshake, error) {
+	if len(psk) != noiseKeySize {
+		return nil, ErrPSK
+	}
+	hs := &noiseHandshake{
+		// The protocol name is longer than the hash, so it is hashed.
+		h:   sha256.Sum256([]byte(noiseProtocol)),
+		psk: psk,
+	}
+	hs.ck = hs.h
+	hs.mixHash([]byte(noisePrologue))
+	return hs, nil
+}
+
+func noiseHKDF(ck, ikm []byte, n int) [][]byte {
+	mac := hmac.New(sha256.New, ck)
+	mac.Write(ikm)
+	key := mac.Sum(nil)
+
+	var (
+		outputs = make([][]byte, n)
+		prev    []byte
+	)
+	for i := range outputs {
+		mac = hmac.New(sha256.New, key)
+		mac.Write(prev)
+		mac.Write([]byte{byte(i + 1)})
+		prev = mac.Sum(nil)
+		outputs[i] = prev
+	}
+	return outputs
+}
+
+func (hs *noiseHandshake) mixHash(data []byte) {
+	hash := sha256.New()
+	hash.Write(hs.h[:])
+	hash.Write(data)
+	hash.Sum(hs.h[:0])
+}
+
+func (hs *noiseHandshake) mixKey(ikm []byte) {
+	outputs := noiseHKDF(hs.ck[:], ikm, 2)
+	copy(hs.ck[:], outputs[0])
+	hs.cipher = newNoiseCipher(outputs[1])
+}
+
+func (hs *noiseHandshake) mixKeyAndHash(ikm []byte) {
+	outputs := noiseHKDF(hs.ck[:], ikm, 3)
+	copy(hs.ck[:], outputs[0])
+	hs.mixHash(outputs[1])
+	hs.cipher = newNoiseCipher(outputs[2])
+}
+
+func (hs *noiseHandshake) encryptAndHash(plaintext []byte) []byte {
+	ciphertext := hs.cipher.encrypt(hs.h[:], plaintext)
+	hs.mixHash(ciphertext)
+	return ciphertext
+}
+
+func (hs *noiseHandshake) decryptAndHash(ciphertext []byte) ([]byte, error) {
+	plaintext, err := hs.cipher.decrypt(hs.h[:], ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	hs.mixHash(ciphertext)
+	return plaintext, nil
+}
+
+// writeEphemeral generates the local ephemeral key and returns its public key.
+func (hs *noiseHandshake) writeEphemeral() ([]byte, error) {
+	hs.e = make([]byte, curve25519.ScalarSize)
+	if _, err := io.ReadFull(rand.Reader, hs.e); err != nil {
+		return nil, err
+	}
+	public, err := curve25519.X25519(hs.e, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	hs.mixHash(public)
+	// In PSK handshakes, ephemeral keys are also mixed into the key.
+	hs.mixKey(public)
+	return public, nil
+}
+
+// readEphemeral reads the remote ephemeral key, it returns the remainder of the message.
+func (hs *noiseHandshake) readEphemeral(message []byte) ([]byte, error) {
+	if len(message) < curve25519.PointSize {
+		return nil, ErrHandshake
+	}
+	hs.re = message[:curve25519.PointSize]
+	hs.mixHash(hs.re)
+	hs.mixKey(hs.re)
+	return message[curve25519.PointSize:], nil
+}
+
+func (hs *noiseHandshake) mixDH() error {
+	shared, err := curve25519.X25519(hs.e, hs.re)
+	if err != nil {
+		return err
+	}
+	hs.mixKey(shared)
+	return nil
+}
+
+// split returns the ciphers for the initiator and responder to send with.
+func (hs *noiseHandshake) split() (initiator, responder *noiseCipher) {
+	outputs := noiseHKDF(hs.ck[:], nil, 2)
+	return newNoiseCipher(outputs[0]), newNoiseCipher(outputs[1])
+}
+
+// readNoiseFrame reads a frame with the noise framing: an indicator, a 16-bit big-endian length and the payload.
+func readNoiseFrame(r *bufio.Reader) ([]byte, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch b {
+	case noiseIndicator:
+	case plaintextIndicator:
+		return nil, ErrPlaintext
+	default:
+		return nil, fmt.Errorf("api: protocol error: invalid frame indicator %#02x", b)
+	}
+
+	var size [2]byte
+	if _, err = io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	frame := make([]byte, binary.BigEndian.Uint16(size[:]))
+	if _, err = io.ReadFull(r, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+// appendNoiseFrame appends a frame to b.
+func appendNoiseFrame(b, payload []byte) []byte {
+	return append(append(b, noiseIndicator, byte(len(payload)>>8), byte(len(payload))), payload...)
+}
+
+type noiseFrameHelper struct {
+	r          *bufio.Reader
+	w          io.Writer
+	send, recv *noiseCipher
+}
+
+// NewNoiseClientFrameHelper performs the Noise handshake as a client, and returns a FrameHelper for the encrypted
+// transport. The psk is the 32 byte pre-shared key configured on the node.
+//
+// If the node doesn't use encryption, ErrPlaintext is returned. If the handshake fails, which usually means the
+// pre-shared key is incorrect, the error wraps ErrHandshake.
+func NewNoiseClientFrameHelper(rw io.ReadWriter, psk []byte) (FrameHelper, error) {
+	hs, err := newNoiseHandshake(psk)
+	if err != nil {
+		return nil, err
+	}
+
+	// -> psk, e
+	hs.mixKeyAndHash(hs.psk)
+	public, err := hs.writeEphemeral()
+	if err != nil {
+		return nil, err
+	}
+	message := append(public, hs.encryptAndHash(nil)...)
+
+	// Send the client hello (an empty frame) and the handshake frame, prefixed with a zero byte.
+	var frames []byte
+	frames = appendNoiseFrame(frames, nil)
+	frames = appendNoiseFrame(frames, append([]byte{0x00}, message...))
+	if _, err = rw.Write(frames); err != nil {
+		return nil, err
+	}
+
+	// The server hello starts with the chosen protocol, followed by the node name.
+	r := bufio.NewReader(rw)
+	hello, err := readNoiseFrame(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(hello) == 0 || hello[0] != 0x01 {
+		return nil, fmt.Errorf("api: unsupported noise protocol")
+	}
+
+	// <- e, ee
+	response, err := readNoiseFrame(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 {
+		return nil, ErrHandshake
+	} else if response[0] != 0x00 {
+		return nil, fmt.Errorf("%w: %s", ErrHandshake, response[1:])
+	}
+	payload, err := hs.readEphemeral(response[1:])
+	if err != nil {
+		return nil, err
+	}
+	if err = hs.mixDH(); err != nil {
+		return nil, err
+	}
+	if _, err = hs.decryptAndHash(payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrHandshake, err)
+	}
+
+	send, recv := hs.split()
+	return &noiseFrameHelper{
+		r:    r,
+		w:    rw,
+		send: send,
+		recv: recv,
+	}, nil
+}
+
+// NewNoiseServerFrameHelper performs the Noise handshake as a node, and returns a FrameHelper for the encrypted
+// transport. The name is sent to the client in the server hello.
+//
+// If the client doesn't use encryption, it is notified and ErrPlaintext is returned.
+func NewNoiseServerFrameHelper(rw io.ReadWriter, psk []byte, name string) (FrameHelper, error) {
+	hs, err := newNoiseHandshake(psk)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(rw)
+	_, err = readNoiseFrame(r)
+
+	// Send the server hello, a plaintext client reads the indicator and knows encryption is required.
+	hello := append(append([]byte{0x01}, name...), 0x00)
+	if _, writeErr := rw.Write(appendNoiseFrame(nil, hello)); err == nil {
+		err = writeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// -> psk, e
+	request, err := readNoiseFrame(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(request) == 0 || request[0] != 0x00 {
+		return nil, ErrHandshake
+	}
+	hs.mixKeyAndHash(hs.psk)
+	payload, err := hs.readEphemeral(request[1:])
+	if err == nil {
+		_, err = hs.decryptAndHash(payload)
+	}
+	if err != nil {
+		_, _ = rw.Write(appendNoiseFrame(nil, append([]byte{0x01}, "Handshake MAC failure"...)))
+		return nil, ErrHandshake
+	}
+
+	// <- e, ee
+	public, err := hs.writeEphemeral()
+	if err != nil {
+		return nil, err
+	}
+	if err = hs.mixDH(); err != nil {
+		return nil, err
+	}
+	message := append(public, hs.encryptAndHash(nil)...)
+	if _, err = rw.Write(appendNoiseFrame(nil, append([]byte{0x00}, message...))); err != nil {
+		return nil, err
+	}
+
+	recv, send := hs.split()
+	return &noiseFrameHelper{
+		r:    r,
+		w:    rw,
+		send: send,
+		recv: recv,
+	}, nil
+}
+
+func (helper *noiseFrameHelper) ReadMessage() (proto.Message, error) {
+	frame, err := readNoiseFrame(helper.r)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := helper.recv.decrypt(nil, frame)
+	if err != nil {
+		return nil, err
+	}
+
+	// The decrypted frame has a 16-bit message type and length, followed by the encoded message.
+	if len(plaintext) < 4 {
+		return nil, errors.New("api: protocol error: short frame")
+	}
+	var (
+		kind   = binary.BigEndian.Uint16(plaintext[0:])
+		length = int(binary.BigEndian.Uint16(plaintext[2:]))
+	)
+	if len(plaintext) < 4+length {
+		return nil, errors.New("api: protocol error: short frame")
+	}
+	return decode(uint64(kind), plaintext[4:4+length])
+}
+
+func (helper *noiseFrameHelper) WriteMessage(message proto.Message) error {
+	encoded, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if len(encoded) > noiseMaxFrame-4-helper.send.aead.Overhead() {
+		return fmt.Errorf("api: message of %d bytes too large for noise frame", len(encoded))
+	}
+
+	plaintext := make([]byte, 4, 4+len(encoded))
+	binary.BigEndian.PutUint16(plaintext[0:], uint16(TypeOf(message)))
+	binary.BigEndian.PutUint16(plaintext[2:], uint16(len(encoded)))
+	plaintext = append(plaintext, encoded...)
+
+	_, err = helper.w.Write(appendNoiseFrame(nil, helper.send.encrypt(nil, plaintext)))
+	return err
+}
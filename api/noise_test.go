@@ -0,0 +1,92 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+)
+
+var testPSK = bytes.Repeat([]byte{0x2a}, 32)
+
+// testNoisePeer runs the server side of a handshake on a pipe.
+func testNoisePeer(t *testing.T, psk []byte) (client net.Conn, server <-chan FrameHelper, serverErr <-chan error) {
+	t.Helper()
+	client, peer := net.Pipe()
+	var (
+		helpers = make(chan FrameHelper, 1)
+		errs    = make(chan error, 1)
+	)
+	go func() {
+		helper, err := NewNoiseServerFrameHelper(peer, psk, "test")
+		if err != nil {
+			_ = peer.Close()
+			errs <- err
+			return
+		}
+		helpers <- helper
+	}()
+	return client, helpers, errs
+}
+
+func TestNoiseFrameHelper(t *testing.T) {
+	conn, server, _ := testNoisePeer(t, testPSK)
+	defer conn.Close()
+
+	client, err := NewNoiseClientFrameHelper(conn, testPSK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := <-server
+
+	for i := 0; i < 3; i++ {
+		go func() {
+			_ = client.WriteMessage(&HelloRequest{ClientInfo: "test"})
+		}()
+		message, err := peer.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hello, ok := message.(*HelloRequest); !ok || hello.ClientInfo != "test" {
+			t.Fatalf("unexpected message %+v", message)
+		}
+
+		go func() {
+			_ = peer.WriteMessage(&HelloResponse{ApiVersionMajor: 1, ApiVersionMinor: 3})
+		}()
+		if message, err = client.ReadMessage(); err != nil {
+			t.Fatal(err)
+		}
+		if hello, ok := message.(*HelloResponse); !ok || hello.ApiVersionMinor != 3 {
+			t.Fatalf("unexpected message %+v", message)
+		}
+	}
+}
+
+func TestNoiseFrameHelperWrongPSK(t *testing.T) {
+	conn, _, serverErr := testNoisePeer(t, testPSK)
+	defer conn.Close()
+
+	if _, err := NewNoiseClientFrameHelper(conn, bytes.Repeat([]byte{0x01}, 32)); !errors.Is(err, ErrHandshake) {
+		t.Fatalf("expected %v, got %v", ErrHandshake, err)
+	}
+	if err := <-serverErr; err != ErrHandshake {
+		t.Fatalf("expected server %v, got %v", ErrHandshake, err)
+	}
+}
+
+func TestNoiseFrameHelperPlaintextClient(t *testing.T) {
+	conn, _, serverErr := testNoisePeer(t, testPSK)
+	defer conn.Close()
+
+	client := NewPlaintextFrameHelper(conn)
+	go func() {
+		_ = client.WriteMessage(&HelloRequest{})
+	}()
+	if _, err := client.ReadMessage(); err != ErrEncryptionRequired {
+		t.Fatalf("expected %v, got %v", ErrEncryptionRequired, err)
+	}
+	if err := <-serverErr; err != ErrPlaintext {
+		t.Fatalf("expected server %v, got %v", ErrPlaintext, err)
+	}
+}
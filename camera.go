@@ -2,11 +2,11 @@ package esphome
 
 import (
 	"bytes"
+	"context"
 	"image"
 	"image/jpeg"
 	"time"
 
-	"github.com/golang/protobuf/proto"
 	"maze.io/x/esphome/api"
 )
 
@@ -28,95 +28,124 @@ func newCamera(client *Client, entity *api.ListEntitiesCameraResponse) *Camera {
 	}
 }
 
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Camera) rebind(listing *Camera) {
+	entity.Entity.rebind(&listing.Entity)
+}
+
 // Image grabs one image frame from the camera.
 func (entity *Camera) Image() (image.Image, error) {
-	if err := entity.client.sendTimeout(&api.CameraImageRequest{
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	i, err := entity.ImageContext(ctx)
+	return i, timeoutError(err)
+}
+
+// ImageContext is like Image with a context.
+func (entity *Camera) ImageContext(ctx context.Context) (image.Image, error) {
+	frames := entity.client.dispatcher.subscribe(streamBuffer, api.CameraImageResponseType)
+	defer entity.client.dispatcher.cancel(frames)
+
+	_, connDone := entity.client.connection()
+	if err := entity.client.sendContext(ctx, &api.CameraImageRequest{
 		Stream: true,
-	}, entity.client.Timeout); err != nil {
+	}); err != nil {
 		return nil, err
 	}
 
-	var (
-		in  = make(chan proto.Message, 1)
-		out = make(chan []byte)
-	)
-	entity.client.waitMutex.Lock()
-	entity.client.wait[api.CameraImageResponseType] = in
-	entity.client.waitMutex.Unlock()
-
-	go func(in <-chan proto.Message, out chan []byte) {
-		for message := range in {
-			if message, ok := message.(*api.CameraImageResponse); ok {
-				out <- message.Data
-				if message.Done {
-					close(out)
-					entity.lastFrame = time.Now()
-					return
-				}
+	var buffer = new(bytes.Buffer)
+	for {
+		message, err := entity.client.waitMessageContext(ctx, frames, connDone)
+		if err != nil {
+			return nil, err
+		}
+		frame := message.(*api.CameraImageResponse)
+		buffer.Write(frame.Data)
+		if frame.Done {
+			if frames.Dropped() > 0 {
+				// Part of the image was lost.
+				return nil, ErrOverflow
 			}
+			entity.setLastFrame(time.Now())
+			return jpeg.Decode(buffer)
 		}
-	}(in, out)
-
-	var buffer = new(bytes.Buffer)
-	for chunk := range out {
-		buffer.Write(chunk)
 	}
-
-	entity.client.waitMutex.Lock()
-	delete(entity.client.wait, api.CameraImageResponseType)
-	entity.client.waitMutex.Unlock()
-
-	return jpeg.Decode(buffer)
 }
 
 // Stream returns a channel with raw image frame buffers.
 func (entity *Camera) Stream() (<-chan *bytes.Buffer, error) {
-	if err := entity.client.sendTimeout(&api.CameraImageRequest{
-		Stream: true,
-	}, entity.client.Timeout); err != nil {
+	return entity.StreamContext(context.Background())
+}
+
+// StreamContext is like Stream with a context. The returned channel is closed once the context is done or the
+// client is closed.
+func (entity *Camera) StreamContext(ctx context.Context) (<-chan *bytes.Buffer, error) {
+	frames := entity.client.dispatcher.subscribe(streamBuffer, api.CameraImageResponseType)
+
+	if err := entity.request(ctx); err != nil {
+		entity.client.dispatcher.cancel(frames)
 		return nil, err
 	}
 
-	in := make(chan proto.Message, 1)
-	entity.client.waitMutex.Lock()
-	entity.client.wait[api.CameraImageResponseType] = in
-	entity.client.waitMutex.Unlock()
-
 	out := make(chan *bytes.Buffer)
-	go func(in <-chan proto.Message, out chan<- *bytes.Buffer) {
+	go func(frames *subscription, out chan<- *bytes.Buffer) {
 		var (
 			ticker = time.NewTicker(time.Second)
 			buffer = new(bytes.Buffer)
 		)
 		defer ticker.Stop()
+		defer close(out)
+		defer entity.client.dispatcher.cancel(frames)
 		for {
 			select {
-			case message := <-in:
+			case message := <-frames.C:
 				frame := message.(*api.CameraImageResponse)
 				buffer.Write(frame.Data)
 				if frame.Done {
-					out <- buffer
+					select {
+					case out <- buffer:
+					case <-ctx.Done():
+						return
+					case <-entity.client.done:
+						return
+					}
 					buffer = new(bytes.Buffer)
-					entity.lastFrame = time.Now()
+					entity.setLastFrame(time.Now())
 				}
 
 			case <-ticker.C:
-				if err := entity.client.sendTimeout(&api.CameraImageRequest{
-					Stream: true,
-				}, entity.client.Timeout); err != nil {
-					close(out)
-					return
-				}
+				// Errors are ignored, we retry on the next tick; the stream ends when the client is done.
+				_ = entity.request(ctx)
+
+			case <-ctx.Done():
+				return
+
+			case <-entity.client.done:
+				return
 			}
 		}
-	}(in, out)
+	}(frames, out)
 
 	return out, nil
 }
 
+// request a camera image stream, the device streams for a limited time after each request.
+func (entity *Camera) request(ctx context.Context) error {
+	ctx, cancel := timeoutContext(ctx, entity.client.Timeout)
+	defer cancel()
+	return entity.client.sendContext(ctx, &api.CameraImageRequest{
+		Stream: true,
+	})
+}
+
 // ImageStream is like Stream, returning decoded frame images.
 func (entity *Camera) ImageStream() (<-chan image.Image, error) {
-	in, err := entity.Stream()
+	return entity.ImageStreamContext(context.Background())
+}
+
+// ImageStreamContext is like ImageStream with a context.
+func (entity *Camera) ImageStreamContext(ctx context.Context) (<-chan image.Image, error) {
+	in, err := entity.StreamContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +155,13 @@ func (entity *Camera) ImageStream() (<-chan image.Image, error) {
 		defer close(out)
 		for frame := range in {
 			if i, err := jpeg.Decode(frame); err == nil {
-				out <- i
+				select {
+				case out <- i:
+				case <-ctx.Done():
+					return
+				case <-entity.client.done:
+					return
+				}
 			}
 		}
 	}(in, out)
@@ -136,5 +171,13 @@ func (entity *Camera) ImageStream() (<-chan image.Image, error) {
 
 // LastFrame returns the time of the last camera frame received.
 func (entity *Camera) LastFrame() time.Time {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
 	return entity.lastFrame
 }
+
+func (entity *Camera) setLastFrame(t time.Time) {
+	entity.client.mu.Lock()
+	entity.lastFrame = t
+	entity.client.mu.Unlock()
+}
@@ -1,8 +1,8 @@
 package esphome
 
 import (
-	"bufio"
-	"fmt"
+	"context"
+	"encoding/base64"
 	"net"
 	"sync"
 	"time"
@@ -30,41 +30,176 @@ type Client struct {
 	// Clock returns the current time.
 	Clock func() time.Time
 
-	conn        net.Conn
-	br          *bufio.Reader
-	entities    clientEntities
-	err         error
-	in          chan proto.Message
-	stop        chan struct{}
-	waitMutex   sync.RWMutex
-	wait        map[uint64]chan proto.Message
-	lastMessage time.Time
+	addr          string
+	password      string
+	psk           []byte
+	mu            sync.RWMutex
+	conn          net.Conn
+	frames        api.FrameHelper
+	connDone      chan struct{}
+	entities      clientEntities
+	apiVersion    apiVersion
+	err           error
+	dispatcher    *dispatcher
+	events        eventSubscribers
+	done          chan struct{}
+	doneOnce      sync.Once
+	reconnect     *ReconnectOptions
+	reconnecting  bool
+	subscriptions map[*subscription]proto.Message
+	writeMutex    sync.Mutex
+	lastMessage   time.Time
+	unknown       uint64
+}
+
+// apiVersion is the native API version of the node.
+type apiVersion struct {
+	major, minor uint32
+}
+
+// atLeast checks if the version is equal to or newer than major.minor.
+func (version apiVersion) atLeast(major, minor uint32) bool {
+	return version.major > major || (version.major == major && version.minor >= minor)
 }
 
 type clientEntities struct {
-	binarySensor map[uint32]*BinarySensor
-	camera       map[uint32]*Camera
-	climate      map[uint32]*Climate
-	cover        map[uint32]*Cover
-	fan          map[uint32]*Fan
-	light        map[uint32]*Light
-	sensor       map[uint32]*Sensor
-	switches     map[uint32]*Switch
-	textSensor   map[uint32]*TextSensor
+	alarmControlPanel map[uint32]*AlarmControlPanel
+	binarySensor      map[uint32]*BinarySensor
+	button            map[uint32]*Button
+	camera            map[uint32]*Camera
+	climate           map[uint32]*Climate
+	cover             map[uint32]*Cover
+	date              map[uint32]*Date
+	dateTime          map[uint32]*DateTime
+	eventEntity       map[uint32]*EventEntity
+	fan               map[uint32]*Fan
+	light             map[uint32]*Light
+	lock              map[uint32]*Lock
+	mediaPlayer       map[uint32]*MediaPlayer
+	number            map[uint32]*Number
+	selects           map[uint32]*Select
+	sensor            map[uint32]*Sensor
+	service           map[uint32]*Service
+	siren             map[uint32]*Siren
+	switches          map[uint32]*Switch
+	text              map[uint32]*Text
+	textSensor        map[uint32]*TextSensor
+	time              map[uint32]*Time
+	update            map[uint32]*Update
+	valve             map[uint32]*Valve
 }
 
 func newClientEntities() clientEntities {
 	return clientEntities{
-		binarySensor: make(map[uint32]*BinarySensor),
-		camera:       make(map[uint32]*Camera),
-		climate:      make(map[uint32]*Climate),
-		cover:        make(map[uint32]*Cover),
-		fan:          make(map[uint32]*Fan),
-		light:        make(map[uint32]*Light),
-		sensor:       make(map[uint32]*Sensor),
-		switches:     make(map[uint32]*Switch),
-		textSensor:   make(map[uint32]*TextSensor),
+		alarmControlPanel: make(map[uint32]*AlarmControlPanel),
+		binarySensor:      make(map[uint32]*BinarySensor),
+		button:            make(map[uint32]*Button),
+		camera:            make(map[uint32]*Camera),
+		climate:           make(map[uint32]*Climate),
+		cover:             make(map[uint32]*Cover),
+		date:              make(map[uint32]*Date),
+		dateTime:          make(map[uint32]*DateTime),
+		eventEntity:       make(map[uint32]*EventEntity),
+		fan:               make(map[uint32]*Fan),
+		light:             make(map[uint32]*Light),
+		lock:              make(map[uint32]*Lock),
+		mediaPlayer:       make(map[uint32]*MediaPlayer),
+		number:            make(map[uint32]*Number),
+		selects:           make(map[uint32]*Select),
+		sensor:            make(map[uint32]*Sensor),
+		service:           make(map[uint32]*Service),
+		siren:             make(map[uint32]*Siren),
+		switches:          make(map[uint32]*Switch),
+		text:              make(map[uint32]*Text),
+		textSensor:        make(map[uint32]*TextSensor),
+		time:              make(map[uint32]*Time),
+		update:            make(map[uint32]*Update),
+		valve:             make(map[uint32]*Valve),
+	}
+}
+
+// byUniqueID returns all entities indexed by their unique identifier.
+func (entities clientEntities) byUniqueID() map[string]interface{} {
+	index := make(map[string]interface{})
+	for _, entity := range entities.alarmControlPanel {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.binarySensor {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.button {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.camera {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.climate {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.cover {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.date {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.dateTime {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.eventEntity {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.fan {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.light {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.lock {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.mediaPlayer {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.number {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.selects {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.sensor {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.siren {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.switches {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.text {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.textSensor {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.time {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.update {
+		index[entity.UniqueID] = entity
+	}
+	for _, entity := range entities.valve {
+		index[entity.UniqueID] = entity
+	}
+	return index
+}
+
+// serviceByName returns all services indexed by their name, services don't have a unique identifier.
+func (entities clientEntities) serviceByName() map[string]*Service {
+	index := make(map[string]*Service)
+	for _, entity := range entities.service {
+		index[entity.Name] = entity
 	}
+	return index
 }
 
 // Dial connects to ESPHome native API on the supplied TCP address.
@@ -74,218 +209,452 @@ func Dial(addr string) (*Client, error) {
 
 // DialTimeout is like Dial with a custom timeout.
 func DialTimeout(addr string, timeout time.Duration) (*Client, error) {
-	conn, err := net.DialTimeout("tcp", addr, timeout)
-	if err != nil {
-		return nil, err
+	ctx, cancel := timeoutContext(context.Background(), timeout)
+	defer cancel()
+	c, err := dial(ctx, addr, timeout, nil)
+	return c, timeoutError(err)
+}
+
+// DialContext is like Dial with a context. The context only applies to establishing the connection, use
+// Client.Timeout or the Context variants of the client methods to control subsequent calls.
+func DialContext(ctx context.Context, addr string) (*Client, error) {
+	return dial(ctx, addr, DefaultTimeout, nil)
+}
+
+// DialEncrypted connects to the ESPHome native API on the supplied TCP address, using the Noise encrypted transport.
+// The psk is the base64 encoded encryption key from the node's api configuration.
+func DialEncrypted(addr, psk string) (*Client, error) {
+	ctx, cancel := timeoutContext(context.Background(), DefaultTimeout)
+	defer cancel()
+	c, err := DialEncryptedContext(ctx, addr, psk)
+	return c, timeoutError(err)
+}
+
+// DialEncryptedContext is like DialEncrypted with a context.
+func DialEncryptedContext(ctx context.Context, addr, psk string) (*Client, error) {
+	key, err := base64.StdEncoding.DecodeString(psk)
+	if err != nil || len(key) != 32 {
+		return nil, ErrEncryptionKey
 	}
+	return dial(ctx, addr, DefaultTimeout, key)
+}
 
+func dial(ctx context.Context, addr string, timeout time.Duration, psk []byte) (*Client, error) {
 	c := &Client{
-		Timeout:  timeout,
-		Info:     defaultClientInfo,
-		Clock:    func() time.Time { return time.Now() },
-		conn:     conn,
-		br:       bufio.NewReader(conn),
-		in:       make(chan proto.Message, 16),
-		wait:     make(map[uint64]chan proto.Message),
-		stop:     make(chan struct{}),
-		entities: newClientEntities(),
-	}
-	go c.reader()
+		Timeout:       timeout,
+		Info:          defaultClientInfo,
+		Clock:         func() time.Time { return time.Now() },
+		addr:          addr,
+		psk:           psk,
+		dispatcher:    newDispatcher(),
+		done:          make(chan struct{}),
+		entities:      newClientEntities(),
+		subscriptions: make(map[*subscription]proto.Message),
+	}
+	if err := c.connect(ctx); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
-func (c *Client) reader() {
-	defer c.conn.Close()
-	for {
-		select {
-		case <-c.stop:
-			return
+// connect establishes a new connection to the node and starts reading from it.
+func (c *Client) connect(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
 
-		default:
-			if err := c.readMessage(); err != nil {
-				c.err = err
-				return
-			}
-		}
+	frames, err := c.handshake(ctx, conn)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.done:
+		_ = conn.Close()
+		return ErrClosed
+	default:
 	}
+	c.conn = conn
+	c.frames = frames
+	c.connDone = make(chan struct{})
+	c.err = nil
+	go c.reader(conn, frames, c.connDone)
+	return nil
 }
 
-func (c *Client) nextMessage() (proto.Message, error) {
-	if c.err != nil {
-		return nil, c.err
+// handshake sets up the transport framing, performing the Noise handshake if the client uses encryption.
+func (c *Client) handshake(ctx context.Context, conn net.Conn) (api.FrameHelper, error) {
+	if c.psk == nil {
+		return api.NewPlaintextFrameHelper(conn), nil
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
 	}
-	return <-c.in, nil
+	frames, err := api.NewNoiseClientFrameHelper(conn, c.psk)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	return frames, conn.SetDeadline(time.Time{})
 }
 
-func (c *Client) nextMessageTimeout(timeout time.Duration) (proto.Message, error) {
-	if c.err != nil {
-		return nil, c.err
+// timeoutContext returns a context that expires after timeout, a timeout of zero or less means no timeout.
+func timeoutContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(parent)
 	}
-	select {
-	case message := <-c.in:
-		return message, nil
-	case <-time.After(timeout):
-		return nil, ErrTimeout
+	return context.WithTimeout(parent, timeout)
+}
+
+// timeoutError translates context deadline errors to ErrTimeout, for the methods that don't take a context.
+func timeoutError(err error) error {
+	if err == context.DeadlineExceeded {
+		return ErrTimeout
 	}
+	return err
+}
+
+// context returns a context using the client's timeout.
+func (c *Client) context() (context.Context, context.CancelFunc) {
+	return timeoutContext(context.Background(), c.Timeout)
 }
 
-func (c *Client) waitFor(messageType uint64, in chan proto.Message) {
-	c.waitMutex.Lock()
-	{
-		if other, waiting := c.wait[messageType]; waiting {
-			other <- nil
-			close(other)
+func (c *Client) reader(conn net.Conn, frames api.FrameHelper, done chan struct{}) {
+	for {
+		if err := c.readMessage(frames); err != nil {
+			_ = conn.Close()
+			c.mu.Lock()
+			c.err = err
+			c.mu.Unlock()
+			close(done)
+			c.disconnected()
+			return
 		}
-		c.wait[messageType] = in
 	}
-	c.waitMutex.Unlock()
 }
 
-func (c *Client) waitDone(messageType uint64) {
-	c.waitMutex.Lock()
-	{
-		delete(c.wait, messageType)
+// disconnected is called when the connection is lost; it either starts reconnecting or finishes the client.
+func (c *Client) disconnected() {
+	c.mu.Lock()
+	var (
+		reconnect    = c.reconnect != nil && !c.reconnecting && !c.isDone()
+		reconnecting = c.reconnecting
+	)
+	if reconnect {
+		c.reconnecting = true
+	}
+	c.mu.Unlock()
+
+	switch {
+	case reconnect:
+		go c.reconnectLoop()
+	case !reconnecting:
+		c.finish()
 	}
-	c.waitMutex.Unlock()
 }
 
-func (c *Client) waitMessage(messageType uint64) proto.Message {
-	in := make(chan proto.Message, 1)
-	c.waitFor(messageType, in)
-	message := <-in
-	c.waitDone(messageType)
-	return message
+// connection returns the current connection and a channel that is closed when the connection is lost.
+func (c *Client) connection() (net.Conn, <-chan struct{}) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.conn, c.connDone
+}
+
+// closed returns the error that caused the last connection to stop.
+func (c *Client) closed() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.err != nil {
+		return c.err
+	}
+	return ErrClosed
+}
+
+// finish marks the client as done, this stops all streams.
+func (c *Client) finish() {
+	c.doneOnce.Do(func() {
+		close(c.done)
+		c.events.close()
+	})
+}
+
+func (c *Client) isDone() bool {
+	return isClosed(c.done)
 }
 
-func (c *Client) waitMessageTimeout(messageType uint64, timeout time.Duration) (proto.Message, error) {
-	in := make(chan proto.Message, 1)
-	c.waitFor(messageType, in)
-	defer c.waitDone(messageType)
+// waitMessageContext waits for a message of the subscription, until the context is done or the connection is lost.
+func (c *Client) waitMessageContext(ctx context.Context, s *subscription, connDone <-chan struct{}) (proto.Message, error) {
 	select {
-	case message := <-in:
+	case message := <-s.C:
 		return message, nil
-	case <-time.After(timeout):
-		return nil, ErrTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-connDone:
+		return nil, c.closed()
 	}
 }
 
-func (c *Client) readMessage() (err error) {
+func (c *Client) readMessage(frames api.FrameHelper) (err error) {
 	var message proto.Message
-	if message, err = api.ReadMessage(c.br); err == nil {
-		c.lastMessage = time.Now()
-		if !c.handleInternal(message) {
-			c.waitMutex.Lock()
-			in, waiting := c.wait[api.TypeOf(message)]
-			c.waitMutex.Unlock()
-			if waiting {
-				in <- message
-			} else {
-				c.in <- message
-			}
+	if message, err = frames.ReadMessage(); err == nil {
+		received := time.Now()
+		c.mu.Lock()
+		c.lastMessage = received
+		c.mu.Unlock()
+		if !c.handleInternal(message, received) {
+			c.dispatcher.dispatch(message)
 		}
+	} else if _, ok := err.(api.UnknownTypeError); ok {
+		// Newer nodes may send messages this client doesn't know about, skip them.
+		c.mu.Lock()
+		c.lastMessage = time.Now()
+		c.unknown++
+		c.mu.Unlock()
+		err = nil
 	}
 	return
 }
 
-func (c *Client) handleInternal(message proto.Message) bool {
-	switch message := message.(type) {
+// UnknownMessages returns the number of messages that were skipped, because their type is unknown to the client.
+func (c *Client) UnknownMessages() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.unknown
+}
+
+func (c *Client) handleInternal(message proto.Message, received time.Time) bool {
+	switch message.(type) {
 	case *api.DisconnectRequest:
-		_ = c.sendTimeout(&api.DisconnectResponse{}, c.Timeout)
-		c.Close()
+		_ = c.send(&api.DisconnectResponse{})
+		conn, _ := c.connection()
+		_ = conn.Close()
 		return true
 
 	case *api.PingRequest:
-		_ = c.sendTimeout(&api.PingResponse{}, c.Timeout)
+		_ = c.send(&api.PingResponse{})
 		return true
 
 	case *api.GetTimeRequest:
-		_ = c.sendTimeout(&api.GetTimeResponse{EpochSeconds: uint32(c.Clock().Unix())}, c.Timeout)
+		_ = c.send(&api.GetTimeResponse{EpochSeconds: uint32(c.Clock().Unix())})
 		return true
+	}
 
-	case *api.FanStateResponse:
-		if _, ok := c.entities.fan[message.Key]; ok {
-			// TODO
-			// entity.update(message)
+	var calls callbacks
+	if event := c.updateState(message, received, &calls); event != nil {
+		calls.run()
+		c.events.publish(event)
+	}
+	return false
+}
+
+// updateState updates the entity state for state responses, it returns the resulting event. The Handle* callbacks of
+// the entity are queued on calls, to be run once the client lock is released.
+func (c *Client) updateState(message proto.Message, received time.Time, calls *callbacks) Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch message := message.(type) {
+	case *api.AlarmControlPanelStateResponse:
+		if entity, ok := c.entities.alarmControlPanel[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.BinarySensorStateResponse:
+		if entity, ok := c.entities.binarySensor[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.ClimateStateResponse:
+		if entity, ok := c.entities.climate[message.Key]; ok {
+			return entity.update(message, received, calls)
 		}
 	case *api.CoverStateResponse:
-		if _, ok := c.entities.cover[message.Key]; ok {
-			// TODO
-			// entity.update(message)
+		if entity, ok := c.entities.cover[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.DateStateResponse:
+		if entity, ok := c.entities.date[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.DateTimeStateResponse:
+		if entity, ok := c.entities.dateTime[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.EventResponse:
+		if entity, ok := c.entities.eventEntity[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.FanStateResponse:
+		if entity, ok := c.entities.fan[message.Key]; ok {
+			return entity.update(message, received, calls)
 		}
 	case *api.LightStateResponse:
 		if entity, ok := c.entities.light[message.Key]; ok {
-			entity.update(message)
+			return entity.update(message, received, calls)
+		}
+	case *api.LockStateResponse:
+		if entity, ok := c.entities.lock[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.MediaPlayerStateResponse:
+		if entity, ok := c.entities.mediaPlayer[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.NumberStateResponse:
+		if entity, ok := c.entities.number[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.SelectStateResponse:
+		if entity, ok := c.entities.selects[message.Key]; ok {
+			return entity.update(message, received, calls)
 		}
 	case *api.SensorStateResponse:
 		if entity, ok := c.entities.sensor[message.Key]; ok {
-			entity.update(message)
+			return entity.update(message, received, calls)
+		}
+	case *api.SirenStateResponse:
+		if entity, ok := c.entities.siren[message.Key]; ok {
+			return entity.update(message, received, calls)
 		}
 	case *api.SwitchStateResponse:
 		if entity, ok := c.entities.switches[message.Key]; ok {
-			entity.update(message)
+			return entity.update(message, received, calls)
+		}
+	case *api.TextSensorStateResponse:
+		if entity, ok := c.entities.textSensor[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.TextStateResponse:
+		if entity, ok := c.entities.text[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.TimeStateResponse:
+		if entity, ok := c.entities.time[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.UpdateStateResponse:
+		if entity, ok := c.entities.update[message.Key]; ok {
+			return entity.update(message, received, calls)
+		}
+	case *api.ValveStateResponse:
+		if entity, ok := c.entities.valve[message.Key]; ok {
+			return entity.update(message, received, calls)
 		}
 	}
-
-	return false
+	return nil
 }
 
-func (c *Client) send(message proto.Message) error {
-	packed, err := api.Marshal(message)
-	if err != nil {
-		return err
-	}
-	if _, err = c.conn.Write(packed); err != nil {
-		return err
+// callbacks collects the Handle* callbacks triggered by a state update, so they can run without holding the client
+// lock. Callbacks added to a nil callbacks are run immediately.
+type callbacks []func()
+
+func (calls *callbacks) add(call func()) {
+	if calls == nil {
+		call()
+		return
 	}
-	return nil
+	*calls = append(*calls, call)
 }
 
-func (c *Client) sendTimeout(message proto.Message, timeout time.Duration) error {
-	packed, err := api.Marshal(message)
-	if err != nil {
-		return err
+func (calls callbacks) run() {
+	for _, call := range calls {
+		call()
 	}
-	if err = c.conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+}
+
+// send a message using the client's timeout.
+func (c *Client) send(message proto.Message) error {
+	ctx, cancel := c.context()
+	defer cancel()
+	return timeoutError(c.sendContext(ctx, message))
+}
+
+// sendContext writes a message to the connection, the write is aborted if the context is done.
+func (c *Client) sendContext(ctx context.Context, message proto.Message) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	if err := ctx.Err(); err != nil {
 		return err
 	}
-	if _, err = c.conn.Write(packed); err != nil {
+	c.mu.RLock()
+	conn, frames := c.conn, c.frames
+	c.mu.RUnlock()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetWriteDeadline(deadline); err != nil {
 		return err
 	}
-	if err = c.conn.SetWriteDeadline(time.Time{}); err != nil {
+
+	// The watcher must be done before the write lock is released, or it could unblock the write of the next message.
+	var (
+		written = make(chan struct{})
+		stopped = make(chan struct{})
+	)
+	defer func() {
+		close(written)
+		<-stopped
+	}()
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			// Unblock the pending write.
+			_ = conn.SetWriteDeadline(time.Unix(1, 0))
+		case <-written:
+		}
+	}()
+
+	if err := frames.WriteMessage(message); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		return err
 	}
 	return nil
 }
 
-func (c *Client) sendAndWaitResponse(message proto.Message, messageType uint64) (proto.Message, error) {
-	if err := c.send(message); err != nil {
-		return nil, err
-	}
-	return c.waitMessage(messageType), nil
-}
+func (c *Client) sendAndWaitResponseContext(ctx context.Context, message proto.Message, messageType uint64) (proto.Message, error) {
+	// Register before sending, so we can't miss a fast response.
+	pending := c.dispatcher.request(messageType)
+	defer c.dispatcher.cancel(pending)
 
-func (c *Client) sendAndWaitResponseTimeout(message proto.Message, messageType uint64, timeout time.Duration) (proto.Message, error) {
-	if timeout <= 0 {
-		return c.sendAndWaitResponse(message, messageType)
-	}
-	if err := c.sendTimeout(message, timeout); err != nil {
+	_, connDone := c.connection()
+	if err := c.sendContext(ctx, message); err != nil {
 		return nil, err
 	}
-	return c.waitMessageTimeout(messageType, timeout)
+	return c.waitMessageContext(ctx, pending, connDone)
 }
 
 // Login must be called to do the initial handshake. The provided password can be empty.
 func (c *Client) Login(password string) error {
-	message, err := c.sendAndWaitResponseTimeout(&api.HelloRequest{
+	ctx, cancel := c.context()
+	defer cancel()
+	return timeoutError(c.LoginContext(ctx, password))
+}
+
+// LoginContext is like Login with a context.
+func (c *Client) LoginContext(ctx context.Context, password string) error {
+	message, err := c.sendAndWaitResponseContext(ctx, &api.HelloRequest{
 		ClientInfo: c.Info,
-	}, api.HelloResponseType, c.Timeout)
+	}, api.HelloResponseType)
 	if err != nil {
 		return err
 	}
+	helloResponse := message.(*api.HelloResponse)
+	c.mu.Lock()
+	c.apiVersion = apiVersion{helloResponse.ApiVersionMajor, helloResponse.ApiVersionMinor}
+	c.mu.Unlock()
 
-	if message, err = c.sendAndWaitResponseTimeout(&api.ConnectRequest{
+	if message, err = c.sendAndWaitResponseContext(ctx, &api.ConnectRequest{
 		Password: password,
-	}, api.ConnectResponseType, c.Timeout); err != nil {
+	}, api.ConnectResponseType); err != nil {
 		return err
 	}
 	connectResponse := message.(*api.ConnectResponse)
@@ -294,55 +663,252 @@ func (c *Client) Login(password string) error {
 	}
 
 	// Query available entities, this allows us to map sensor/actor names to keys.
-	entities, err := c.listEntities()
+	entities, err := c.listEntities(ctx)
 	if err != nil {
 		return err
 	}
-	for _, item := range entities {
+	c.bindEntities(entities)
+
+	// Subscribe to states, this is also used for streaming requests.
+	if err = c.sendContext(ctx, &api.SubscribeStatesRequest{}); err != nil {
+		return err
+	}
+
+	// Restore subscriptions of a previous connection.
+	c.mu.RLock()
+	subscriptions := make([]proto.Message, 0, len(c.subscriptions))
+	for _, request := range c.subscriptions {
+		subscriptions = append(subscriptions, request)
+	}
+	c.mu.RUnlock()
+	for _, request := range subscriptions {
+		if err = c.sendContext(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bindEntities updates the entities from a listing. Entities known from a previous connection are matched by their
+// unique identifier and retain their state and handlers.
+func (c *Client) bindEntities(items []proto.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		previous         = c.entities.byUniqueID()
+		previousServices = c.entities.serviceByName()
+		entities         = newClientEntities()
+	)
+	for _, item := range items {
 		switch item := item.(type) {
+		case *api.ListEntitiesAlarmControlPanelResponse:
+			entity := newAlarmControlPanel(c, item)
+			if other, ok := previous[item.UniqueId].(*AlarmControlPanel); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.alarmControlPanel[item.Key] = entity
 		case *api.ListEntitiesBinarySensorResponse:
-			c.entities.binarySensor[item.Key] = newBinarySensor(c, item)
+			entity := newBinarySensor(c, item)
+			if other, ok := previous[item.UniqueId].(*BinarySensor); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.binarySensor[item.Key] = entity
+		case *api.ListEntitiesButtonResponse:
+			entity := newButton(c, item)
+			if other, ok := previous[item.UniqueId].(*Button); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.button[item.Key] = entity
 		case *api.ListEntitiesCameraResponse:
-			c.entities.camera[item.Key] = newCamera(c, item)
+			entity := newCamera(c, item)
+			if other, ok := previous[item.UniqueId].(*Camera); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.camera[item.Key] = entity
 		case *api.ListEntitiesClimateResponse:
-			c.entities.climate[item.Key] = newClimate(c, item)
+			entity := newClimate(c, item)
+			if other, ok := previous[item.UniqueId].(*Climate); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.climate[item.Key] = entity
 		case *api.ListEntitiesCoverResponse:
-			c.entities.cover[item.Key] = newCover(c, item)
+			entity := newCover(c, item)
+			if other, ok := previous[item.UniqueId].(*Cover); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.cover[item.Key] = entity
+		case *api.ListEntitiesDateResponse:
+			entity := newDate(c, item)
+			if other, ok := previous[item.UniqueId].(*Date); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.date[item.Key] = entity
+		case *api.ListEntitiesDateTimeResponse:
+			entity := newDateTime(c, item)
+			if other, ok := previous[item.UniqueId].(*DateTime); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.dateTime[item.Key] = entity
+		case *api.ListEntitiesEventResponse:
+			entity := newEventEntity(c, item)
+			if other, ok := previous[item.UniqueId].(*EventEntity); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.eventEntity[item.Key] = entity
 		case *api.ListEntitiesFanResponse:
-			c.entities.fan[item.Key] = newFan(c, item)
+			entity := newFan(c, item)
+			if other, ok := previous[item.UniqueId].(*Fan); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.fan[item.Key] = entity
 		case *api.ListEntitiesLightResponse:
-			c.entities.light[item.Key] = newLight(c, item)
+			entity := newLight(c, item)
+			if other, ok := previous[item.UniqueId].(*Light); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.light[item.Key] = entity
+		case *api.ListEntitiesLockResponse:
+			entity := newLock(c, item)
+			if other, ok := previous[item.UniqueId].(*Lock); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.lock[item.Key] = entity
+		case *api.ListEntitiesMediaPlayerResponse:
+			entity := newMediaPlayer(c, item)
+			if other, ok := previous[item.UniqueId].(*MediaPlayer); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.mediaPlayer[item.Key] = entity
+		case *api.ListEntitiesNumberResponse:
+			entity := newNumber(c, item)
+			if other, ok := previous[item.UniqueId].(*Number); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.number[item.Key] = entity
+		case *api.ListEntitiesSelectResponse:
+			entity := newSelect(c, item)
+			if other, ok := previous[item.UniqueId].(*Select); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.selects[item.Key] = entity
 		case *api.ListEntitiesSensorResponse:
-			c.entities.sensor[item.Key] = newSensor(c, item)
+			entity := newSensor(c, item)
+			if other, ok := previous[item.UniqueId].(*Sensor); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.sensor[item.Key] = entity
+		case *api.ListEntitiesServicesResponse:
+			entity := newService(c, item)
+			if other, ok := previousServices[item.Name]; ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.service[item.Key] = entity
+		case *api.ListEntitiesSirenResponse:
+			entity := newSiren(c, item)
+			if other, ok := previous[item.UniqueId].(*Siren); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.siren[item.Key] = entity
 		case *api.ListEntitiesSwitchResponse:
-			c.entities.switches[item.Key] = newSwitch(c, item)
+			entity := newSwitch(c, item)
+			if other, ok := previous[item.UniqueId].(*Switch); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.switches[item.Key] = entity
+		case *api.ListEntitiesTextResponse:
+			entity := newText(c, item)
+			if other, ok := previous[item.UniqueId].(*Text); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.text[item.Key] = entity
 		case *api.ListEntitiesTextSensorResponse:
-			c.entities.textSensor[item.Key] = newTextSensor(c, item)
+			entity := newTextSensor(c, item)
+			if other, ok := previous[item.UniqueId].(*TextSensor); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.textSensor[item.Key] = entity
+		case *api.ListEntitiesTimeResponse:
+			entity := newTime(c, item)
+			if other, ok := previous[item.UniqueId].(*Time); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.time[item.Key] = entity
+		case *api.ListEntitiesUpdateResponse:
+			entity := newUpdate(c, item)
+			if other, ok := previous[item.UniqueId].(*Update); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.update[item.Key] = entity
+		case *api.ListEntitiesValveResponse:
+			entity := newValve(c, item)
+			if other, ok := previous[item.UniqueId].(*Valve); ok {
+				other.rebind(entity)
+				entity = other
+			}
+			entities.valve[item.Key] = entity
 		default:
-			fmt.Printf("unknown\t%T\n", item)
+			// Listings of entity types this client doesn't support are ignored.
 		}
 	}
-
-	// Subscribe to states, this is also used for streaming requests.
-	if err = c.sendTimeout(&api.SubscribeStatesRequest{}, c.Timeout); err != nil {
-		return err
-	}
-
-	return nil
+	c.entities = entities
 }
 
 // Close the device connection.
 func (c *Client) Close() error {
-	_, err := c.sendAndWaitResponseTimeout(&api.DisconnectRequest{}, api.DisconnectResponseType, 5*time.Second)
-	select {
-	case c.stop <- struct{}{}:
-	default:
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return timeoutError(c.CloseContext(ctx))
+}
+
+// CloseContext is like Close with a context. The connection is closed, even if the context expires before the
+// device has acknowledged the disconnect.
+func (c *Client) CloseContext(ctx context.Context) error {
+	_, err := c.sendAndWaitResponseContext(ctx, &api.DisconnectRequest{}, api.DisconnectResponseType)
+
+	// Mark the client done before closing the connection, so it won't reconnect.
+	c.finish()
+	conn, connDone := c.connection()
+	_ = conn.Close()
+	<-connDone
 	return err
 }
 
+// APIVersion returns the native API version of the node, as reported during Login.
+func (c *Client) APIVersion() (major, minor uint32) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.apiVersion.major, c.apiVersion.minor
+}
+
 // LastMessage returns the time of the last message received.
 func (c *Client) LastMessage() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.lastMessage
 }
 
@@ -373,7 +939,15 @@ type DeviceInfo struct {
 
 // DeviceInfo queries the ESPHome device information.
 func (c *Client) DeviceInfo() (DeviceInfo, error) {
-	message, err := c.sendAndWaitResponseTimeout(&api.DeviceInfoRequest{}, api.DeviceInfoResponseType, c.Timeout)
+	ctx, cancel := c.context()
+	defer cancel()
+	info, err := c.DeviceInfoContext(ctx)
+	return info, timeoutError(err)
+}
+
+// DeviceInfoContext is like DeviceInfo with a context.
+func (c *Client) DeviceInfoContext(ctx context.Context) (DeviceInfo, error) {
+	message, err := c.sendAndWaitResponseContext(ctx, &api.DeviceInfoRequest{}, api.DeviceInfoResponseType)
 	if err != nil {
 		return DeviceInfo{}, err
 	}
@@ -393,19 +967,42 @@ func (c *Client) DeviceInfo() (DeviceInfo, error) {
 // Entities returns all configured entities on the connected device.
 func (c *Client) Entities() Entities {
 	var entities = Entities{
-		BinarySensor: make(map[string]*BinarySensor),
-		Camera:       make(map[string]*Camera),
-		Climate:      make(map[string]*Climate),
-		Cover:        make(map[string]*Cover),
-		Fan:          make(map[string]*Fan),
-		Light:        make(map[string]*Light),
-		Sensor:       make(map[string]*Sensor),
-		Switch:       make(map[string]*Switch),
-		TextSensor:   make(map[string]*TextSensor),
+		AlarmControlPanel: make(map[string]*AlarmControlPanel),
+		BinarySensor:      make(map[string]*BinarySensor),
+		Button:            make(map[string]*Button),
+		Camera:            make(map[string]*Camera),
+		Climate:           make(map[string]*Climate),
+		Cover:             make(map[string]*Cover),
+		Date:              make(map[string]*Date),
+		DateTime:          make(map[string]*DateTime),
+		Event:             make(map[string]*EventEntity),
+		Fan:               make(map[string]*Fan),
+		Light:             make(map[string]*Light),
+		Lock:              make(map[string]*Lock),
+		MediaPlayer:       make(map[string]*MediaPlayer),
+		Number:            make(map[string]*Number),
+		Select:            make(map[string]*Select),
+		Sensor:            make(map[string]*Sensor),
+		Service:           make(map[string]*Service),
+		Siren:             make(map[string]*Siren),
+		Switch:            make(map[string]*Switch),
+		Text:              make(map[string]*Text),
+		TextSensor:        make(map[string]*TextSensor),
+		Time:              make(map[string]*Time),
+		Update:            make(map[string]*Update),
+		Valve:             make(map[string]*Valve),
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, item := range c.entities.alarmControlPanel {
+		entities.AlarmControlPanel[item.UniqueID] = item
 	}
 	for _, item := range c.entities.binarySensor {
 		entities.BinarySensor[item.UniqueID] = item
 	}
+	for _, item := range c.entities.button {
+		entities.Button[item.UniqueID] = item
+	}
 	for _, item := range c.entities.camera {
 		entities.Camera[item.UniqueID] = item
 	}
@@ -415,21 +1012,60 @@ func (c *Client) Entities() Entities {
 	for _, item := range c.entities.cover {
 		entities.Cover[item.UniqueID] = item
 	}
+	for _, item := range c.entities.date {
+		entities.Date[item.UniqueID] = item
+	}
+	for _, item := range c.entities.dateTime {
+		entities.DateTime[item.UniqueID] = item
+	}
+	for _, item := range c.entities.eventEntity {
+		entities.Event[item.UniqueID] = item
+	}
 	for _, item := range c.entities.fan {
 		entities.Fan[item.UniqueID] = item
 	}
 	for _, item := range c.entities.light {
 		entities.Light[item.UniqueID] = item
 	}
+	for _, item := range c.entities.lock {
+		entities.Lock[item.UniqueID] = item
+	}
+	for _, item := range c.entities.mediaPlayer {
+		entities.MediaPlayer[item.UniqueID] = item
+	}
+	for _, item := range c.entities.number {
+		entities.Number[item.UniqueID] = item
+	}
+	for _, item := range c.entities.selects {
+		entities.Select[item.UniqueID] = item
+	}
 	for _, item := range c.entities.sensor {
 		entities.Sensor[item.UniqueID] = item
 	}
+	for _, item := range c.entities.service {
+		entities.Service[item.Name] = item
+	}
+	for _, item := range c.entities.siren {
+		entities.Siren[item.UniqueID] = item
+	}
 	for _, item := range c.entities.switches {
 		entities.Switch[item.UniqueID] = item
 	}
+	for _, item := range c.entities.text {
+		entities.Text[item.UniqueID] = item
+	}
 	for _, item := range c.entities.textSensor {
 		entities.TextSensor[item.UniqueID] = item
 	}
+	for _, item := range c.entities.time {
+		entities.Time[item.UniqueID] = item
+	}
+	for _, item := range c.entities.update {
+		entities.Update[item.UniqueID] = item
+	}
+	for _, item := range c.entities.valve {
+		entities.Valve[item.UniqueID] = item
+	}
 	return entities
 }
 
@@ -442,6 +1078,7 @@ const (
 	LogError
 	LogWarn
 	LogInfo
+	LogConfig
 	LogDebug
 	LogVerbose
 	LogVeryVerbose
@@ -464,65 +1101,127 @@ type LogEntry struct {
 
 // Logs streams log entries.
 func (c *Client) Logs(level LogLevel) (chan LogEntry, error) {
-	if err := c.sendTimeout(&api.SubscribeLogsRequest{
+	return c.LogsContext(context.Background(), level)
+}
+
+// LogsContext is like Logs with a context. The returned channel is closed once the context is done or the
+// client is closed.
+func (c *Client) LogsContext(ctx context.Context, level LogLevel) (chan LogEntry, error) {
+	logs := c.dispatcher.subscribe(streamBuffer, api.SubscribeLogsResponseType)
+
+	sendCtx, cancel := timeoutContext(ctx, c.Timeout)
+	defer cancel()
+	request := &api.SubscribeLogsRequest{
 		Level: api.LogLevel(level),
-	}, c.Timeout); err != nil {
+	}
+	if err := c.sendContext(sendCtx, request); err != nil {
+		c.dispatcher.cancel(logs)
 		return nil, err
 	}
-
-	in := make(chan proto.Message, 1)
-	c.waitMutex.Lock()
-	c.wait[api.SubscribeLogsResponseType] = in
-	c.waitMutex.Unlock()
+	c.subscribe(logs, request)
 
 	out := make(chan LogEntry)
-	go func(in <-chan proto.Message, out chan LogEntry) {
+	go func(logs *subscription, out chan LogEntry) {
 		defer close(out)
-		for entry := range in {
-			entry := entry.(*api.SubscribeLogsResponse)
-			out <- LogEntry{
+		defer c.dispatcher.cancel(logs)
+		defer c.unsubscribe(logs)
+		for {
+			var message proto.Message
+			select {
+			case message = <-logs.C:
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
+			}
+
+			entry := message.(*api.SubscribeLogsResponse)
+			select {
+			case out <- LogEntry{
 				Level:      LogLevel(entry.Level),
 				Tag:        entry.Tag,
 				Message:    entry.Message,
 				SendFailed: entry.SendFailed,
+			}:
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
 			}
 		}
-	}(in, out)
+	}(logs, out)
 
 	return out, nil
 }
 
+// subscribe registers the subscription request of s, that is replayed when reconnecting.
+func (c *Client) subscribe(s *subscription, request proto.Message) {
+	c.mu.Lock()
+	c.subscriptions[s] = request
+	c.mu.Unlock()
+}
+
+// unsubscribe removes the subscription request of s, other subscriptions of the same type are kept.
+func (c *Client) unsubscribe(s *subscription) {
+	c.mu.Lock()
+	delete(c.subscriptions, s)
+	c.mu.Unlock()
+}
+
 // listEntities lists connected entities.
-func (c *Client) listEntities() (entities []proto.Message, err error) {
-	if err = c.sendTimeout(&api.ListEntitiesRequest{}, c.Timeout); err != nil {
+func (c *Client) listEntities(ctx context.Context) (entities []proto.Message, err error) {
+	listing := c.dispatcher.subscribe(listingBuffer,
+		api.ListEntitiesAlarmControlPanelResponseType,
+		api.ListEntitiesBinarySensorResponseType,
+		api.ListEntitiesButtonResponseType,
+		api.ListEntitiesCameraResponseType,
+		api.ListEntitiesClimateResponseType,
+		api.ListEntitiesCoverResponseType,
+		api.ListEntitiesDateResponseType,
+		api.ListEntitiesDateTimeResponseType,
+		api.ListEntitiesEventResponseType,
+		api.ListEntitiesFanResponseType,
+		api.ListEntitiesLightResponseType,
+		api.ListEntitiesLockResponseType,
+		api.ListEntitiesMediaPlayerResponseType,
+		api.ListEntitiesNumberResponseType,
+		api.ListEntitiesSelectResponseType,
+		api.ListEntitiesSensorResponseType,
+		api.ListEntitiesServicesResponseType,
+		api.ListEntitiesSirenResponseType,
+		api.ListEntitiesSwitchResponseType,
+		api.ListEntitiesTextResponseType,
+		api.ListEntitiesTextSensorResponseType,
+		api.ListEntitiesTimeResponseType,
+		api.ListEntitiesUpdateResponseType,
+		api.ListEntitiesValveResponseType,
+		api.ListEntitiesDoneResponseType)
+	defer c.dispatcher.cancel(listing)
+
+	_, connDone := c.connection()
+	if err = c.sendContext(ctx, &api.ListEntitiesRequest{}); err != nil {
 		return nil, err
 	}
 
 	for {
-		message, err := c.nextMessageTimeout(c.Timeout)
+		message, err := c.waitMessageContext(ctx, listing, connDone)
 		if err != nil {
 			return nil, err
 		}
-		switch message := message.(type) {
-		case *api.ListEntitiesDoneResponse:
+		if _, done := message.(*api.ListEntitiesDoneResponse); done {
+			if listing.Dropped() > 0 {
+				return nil, ErrOverflow
+			}
 			return entities, nil
-		case *api.ListEntitiesBinarySensorResponse,
-			*api.ListEntitiesCameraResponse,
-			*api.ListEntitiesClimateResponse,
-			*api.ListEntitiesCoverResponse,
-			*api.ListEntitiesFanResponse,
-			*api.ListEntitiesLightResponse,
-			*api.ListEntitiesSensorResponse,
-			*api.ListEntitiesServicesResponse,
-			*api.ListEntitiesSwitchResponse,
-			*api.ListEntitiesTextSensorResponse:
-			entities = append(entities, message)
 		}
+		entities = append(entities, message)
 	}
 }
 
 // Camera returns a reference to the camera. It returns an error if no camera is found.
 func (c *Client) Camera() (Camera, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	for _, entity := range c.entities.camera {
 		return *entity, nil
 	}
@@ -536,6 +1235,13 @@ func (c *Client) Ping() error {
 
 // PingTimeout is like ping with a custom timeout.
 func (c *Client) PingTimeout(timeout time.Duration) error {
+	ctx, cancel := timeoutContext(context.Background(), timeout)
+	defer cancel()
+	return timeoutError(c.PingContext(ctx))
+}
+
+// PingContext is like ping with a context.
+func (c *Client) PingContext(ctx context.Context) error {
 	// ESPHome doesn't respond to ping (bug? expected?), so we fire & forget.
-	return c.sendTimeout(&api.PingRequest{}, timeout)
+	return c.sendContext(ctx, &api.PingRequest{})
 }
@@ -0,0 +1,326 @@
+package esphome
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome/api"
+)
+
+func testClient(t *testing.T, d *testDevice) *Client {
+	t.Helper()
+	c, err := DialTimeout(d.Addr(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Login(""); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClientConcurrentDeviceInfo(t *testing.T) {
+	d := newTestDevice(t)
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	var (
+		wait sync.WaitGroup
+		errs = make(chan error, 16)
+	)
+	for i := 0; i < cap(errs); i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			info, err := c.DeviceInfo()
+			if err == nil && info.Name != "test" {
+				t.Errorf("unexpected device name %q", info.Name)
+			}
+			errs <- err
+		}()
+	}
+	wait.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestClientLogsWithCameraStream(t *testing.T) {
+	var frame bytes.Buffer
+	if err := jpeg.Encode(&frame, image.NewGray(image.Rect(0, 0, 8, 8)), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newTestDevice(t, &api.ListEntitiesCameraResponse{
+		ObjectId: "camera",
+		Key:      1,
+		Name:     "Camera",
+		UniqueId: "test-camera",
+	})
+	d.handle = func(conn *testConn, message proto.Message) {
+		switch message.(type) {
+		case *api.SubscribeLogsRequest:
+			_ = conn.send(&api.SubscribeLogsResponse{Message: "hello"})
+		case *api.CameraImageRequest:
+			data := frame.Bytes()
+			_ = conn.send(&api.CameraImageResponse{Key: 1, Data: data[:len(data)/2]})
+			_ = conn.send(&api.CameraImageResponse{Key: 1, Data: data[len(data)/2:], Done: true})
+		}
+	}
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	camera, err := c.Camera()
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames, err := camera.ImageStreamContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs, err := c.LogsContext(ctx, LogVeryVerbose)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case entry := <-logs:
+		if entry.Message != "hello" {
+			t.Errorf("unexpected log message %q", entry.Message)
+		}
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for log entry")
+	}
+
+	select {
+	case i := <-frames:
+		if i == nil || i.Bounds().Dx() != 8 {
+			t.Errorf("unexpected frame %v", i)
+		}
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for camera frame")
+	}
+
+	if _, err = camera.ImageContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Streams stop once the context is cancelled.
+	cancel()
+	for range logs {
+	}
+	for range frames {
+	}
+}
+
+func TestClientStateUpdates(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesSensorResponse{
+		ObjectId: "temperature",
+		Key:      1,
+		Name:     "Temperature",
+		UniqueId: "test-temperature",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected state subscription, got %d", n)
+	}
+
+	var wait sync.WaitGroup
+	wait.Add(2)
+	go func() {
+		defer wait.Done()
+		for i := 0; i < 100; i++ {
+			d.Broadcast(&api.SensorStateResponse{Key: 1, State: float32(i)})
+		}
+	}()
+	go func() {
+		defer wait.Done()
+		for i := 0; i < 100; i++ {
+			_ = c.Entities()
+			_ = c.LastMessage()
+		}
+	}()
+	wait.Wait()
+}
+
+func TestClientCommandsWithStateUpdates(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesLightResponse{
+		ObjectId:            "lamp",
+		Key:                 1,
+		UniqueId:            "test-lamp",
+		SupportedColorModes: []api.ColorMode{api.ColorMode_COLOR_MODE_RGB},
+	}, &api.ListEntitiesNumberResponse{
+		ObjectId: "setpoint",
+		Key:      2,
+		UniqueId: "test-setpoint",
+		MaxValue: 100,
+		Step:     1,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected state subscription, got %d", n)
+	}
+
+	var (
+		light  = c.Entities().Light["test-lamp"]
+		number = c.Entities().Number["test-setpoint"]
+		done   = make(chan struct{})
+		wait   sync.WaitGroup
+	)
+	number.HandleState = func(value float32) {
+		if value == 499 {
+			close(done)
+		}
+	}
+	wait.Add(2)
+	go func() {
+		defer wait.Done()
+		for i := 0; i < 500; i++ {
+			d.Broadcast(&api.LightStateResponse{Key: 1, State: i%2 == 0, Brightness: float32(i%100) / 100})
+			d.Broadcast(&api.NumberStateResponse{Key: 2, State: float32(i)})
+		}
+	}()
+	go func() {
+		defer wait.Done()
+		// Keep sending commands until the last state update is received.
+		for i := 0; ; i = (i + 1) % 100 {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err := light.SetBrightness(float32(i) / 100); err != nil {
+				t.Error(err)
+				return
+			}
+			if err := number.SetValue(number.Clamp(float32(i))); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	wait.Wait()
+}
+
+func TestClientStateCallbackUsesClient(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesSensorResponse{
+		ObjectId: "temperature",
+		Key:      1,
+		Name:     "Temperature",
+		UniqueId: "test-temperature",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected state subscription, got %d", n)
+	}
+
+	// The callback takes the client lock, which deadlocks if callbacks run while the reader holds it.
+	errs := make(chan error, 1)
+	c.Entities().Sensor["test-temperature"].HandleState = func(float32) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := c.LogsContext(ctx, LogInfo)
+		errs <- err
+	}
+	d.Broadcast(&api.SensorStateResponse{Key: 1, State: 21})
+
+	select {
+	case err := <-errs:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("state callback did not complete")
+	}
+}
+
+func TestClientUnknownMessage(t *testing.T) {
+	d := newTestDevice(t)
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	// An empty message of type 16383, that is not in the protocol definitions.
+	d.mu.Lock()
+	for _, conn := range d.conns {
+		conn.writeMutex.Lock()
+		_, _ = conn.Write([]byte{0x00, 0x00, 0xff, 0x7f})
+		conn.writeMutex.Unlock()
+	}
+	d.mu.Unlock()
+
+	if _, err := c.DeviceInfo(); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.UnknownMessages(); n != 1 {
+		t.Fatalf("expected 1 unknown message, got %d", n)
+	}
+}
+
+func TestClientContextCancel(t *testing.T) {
+	d := newTestDevice(t)
+	d.info = nil // don't answer device info requests
+	d.handle = func(*testConn, proto.Message) {}
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := c.DeviceInfoContext(ctx); err != context.Canceled {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestClientConnectionLost(t *testing.T) {
+	d := newTestDevice(t)
+	d.info = nil
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		d.Drop()
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := c.DeviceInfoContext(ctx); err == nil || err == ctx.Err() {
+		t.Fatalf("expected connection error, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"flag"
 	"net"
 	"os"
@@ -12,18 +13,37 @@ import (
 const (
 	envHost     = "ESPHOME_HOST"
 	envPassword = "ESPHOME_PASSWORD"
+	envPSK      = "ESPHOME_PSK"
 )
 
 var (
 	NodeFlag     = flag.String("node", getenv(envHost, "esphome.local"), "node API hostname or IP ("+envHost+")")
 	PortFlag     = flag.Int("port", esphome.DefaultPort, "node API port")
 	PasswordFlag = flag.String("password", "", "node API password ("+envPassword+")")
+	PSKFlag      = flag.String("psk", "", "node API encryption key ("+envPSK+")")
 	TimeoutFlag  = flag.Duration("timeout", esphome.DefaultTimeout, "network timeout")
 )
 
 func Dial() (*esphome.Client, error) {
 	addr := net.JoinHostPort(*NodeFlag, strconv.Itoa(*PortFlag))
-	client, err := esphome.DialTimeout(addr, *TimeoutFlag)
+
+	if *PSKFlag == "" {
+		*PSKFlag = os.Getenv(envPSK)
+	}
+
+	var (
+		client *esphome.Client
+		err    error
+	)
+	if *PSKFlag != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), *TimeoutFlag)
+		defer cancel()
+		if client, err = esphome.DialEncryptedContext(ctx, addr, *PSKFlag); err == nil {
+			client.Timeout = *TimeoutFlag
+		}
+	} else {
+		client, err = esphome.DialTimeout(addr, *TimeoutFlag)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -23,11 +23,17 @@ func main() {
 		entities = client.Entities()
 		w        = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.Debug)
 	)
+	for _, item := range entities.AlarmControlPanel {
+		fmt.Fprintf(w, "alarm control panel\t%s\t%s\n", item.ObjectID, item.Name)
+	}
 	for _, item := range entities.BinarySensor {
 		fmt.Fprintf(w, "binary sensor\t%s\t%s\n", item.ObjectID, item.Name)
 	}
+	for _, item := range entities.Button {
+		fmt.Fprintf(w, "button\t%s\t%s\n", item.ObjectID, item.Name)
+	}
 	for _, item := range entities.Camera {
-		fmt.Fprintf(w, "climate sensor\t%s\t%s\n", item.ObjectID, item.Name)
+		fmt.Fprintf(w, "camera\t%s\t%s\n", item.ObjectID, item.Name)
 	}
 	for _, item := range entities.Climate {
 		fmt.Fprintf(w, "climate sensor\t%s\t%s\n", item.ObjectID, item.Name)
@@ -35,20 +41,59 @@ func main() {
 	for _, item := range entities.Cover {
 		fmt.Fprintf(w, "cover\t%s\t%s\n", item.ObjectID, item.Name)
 	}
+	for _, item := range entities.Date {
+		fmt.Fprintf(w, "date\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.DateTime {
+		fmt.Fprintf(w, "date time\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.Event {
+		fmt.Fprintf(w, "event\t%s\t%s\n", item.ObjectID, item.Name)
+	}
 	for _, item := range entities.Fan {
 		fmt.Fprintf(w, "fan\t%s\t%s\n", item.ObjectID, item.Name)
 	}
 	for _, item := range entities.Light {
 		fmt.Fprintf(w, "light\t%s\t%s\n", item.ObjectID, item.Name)
 	}
+	for _, item := range entities.Lock {
+		fmt.Fprintf(w, "lock\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.MediaPlayer {
+		fmt.Fprintf(w, "media player\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.Number {
+		fmt.Fprintf(w, "number\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.Select {
+		fmt.Fprintf(w, "select\t%s\t%s\n", item.ObjectID, item.Name)
+	}
 	for _, item := range entities.Sensor {
 		fmt.Fprintf(w, "sensor\t%s\t%s\n", item.ObjectID, item.Name)
 	}
+	for _, item := range entities.Service {
+		fmt.Fprintf(w, "service\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.Siren {
+		fmt.Fprintf(w, "siren\t%s\t%s\n", item.ObjectID, item.Name)
+	}
 	for _, item := range entities.Switch {
 		fmt.Fprintf(w, "switch\t%s\t%s\n", item.ObjectID, item.Name)
 	}
-	for _, item := range entities.Sensor {
+	for _, item := range entities.Text {
+		fmt.Fprintf(w, "text\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.TextSensor {
 		fmt.Fprintf(w, "text sensor\t%s\t%s\n", item.ObjectID, item.Name)
 	}
+	for _, item := range entities.Time {
+		fmt.Fprintf(w, "time\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.Update {
+		fmt.Fprintf(w, "update\t%s\t%s\n", item.ObjectID, item.Name)
+	}
+	for _, item := range entities.Valve {
+		fmt.Fprintf(w, "valve\t%s\t%s\n", item.ObjectID, item.Name)
+	}
 	w.Flush()
 }
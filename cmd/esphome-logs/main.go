@@ -10,7 +10,7 @@ import (
 )
 
 func main() {
-	var level = flag.Int("level", int(esphome.LogVeryVerbose), "entry level (0-6)")
+	var level = flag.Int("level", int(esphome.LogVeryVerbose), "entry level (0-7)")
 	flag.Parse()
 
 	client, err := cmd.Dial()
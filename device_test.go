@@ -0,0 +1,186 @@
+package esphome
+
+import (
+	"bufio"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome/api"
+)
+
+// testDevice is an in-process fake ESPHome node.
+type testDevice struct {
+	t        *testing.T
+	listener net.Listener
+	entities []proto.Message
+
+	// info is the response to device info requests, if nil the request is ignored.
+	info *api.DeviceInfoResponse
+
+	// major and minor are the native API version.
+	major, minor uint32
+
+	// handle is called for requests that are not handled by the device itself.
+	handle func(conn *testConn, message proto.Message)
+
+	serveOnce sync.Once
+	mu        sync.Mutex
+	conns     []*testConn
+	requests  []proto.Message
+}
+
+// testConn is a client connection to the fake node.
+type testConn struct {
+	net.Conn
+	writeMutex sync.Mutex
+}
+
+func (conn *testConn) send(message proto.Message) error {
+	packed, err := api.Marshal(message)
+	if err != nil {
+		return err
+	}
+	conn.writeMutex.Lock()
+	defer conn.writeMutex.Unlock()
+	_, err = conn.Write(packed)
+	return err
+}
+
+func newTestDevice(t *testing.T, entities ...proto.Message) *testDevice {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &testDevice{
+		t:        t,
+		listener: listener,
+		entities: entities,
+		info: &api.DeviceInfoResponse{
+			Name:           "test",
+			MacAddress:     "00:00:00:00:00:00",
+			EsphomeVersion: "1.14.3",
+		},
+		major: 1,
+		minor: 3,
+	}
+	return d
+}
+
+// Addr starts serving and returns the listening address. The device should be configured before calling Addr.
+func (d *testDevice) Addr() string {
+	d.serveOnce.Do(func() { go d.serve() })
+	return d.listener.Addr().String()
+}
+
+func (d *testDevice) Close() {
+	_ = d.listener.Close()
+	d.Drop()
+}
+
+// Drop closes all client connections.
+func (d *testDevice) Drop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, conn := range d.conns {
+		_ = conn.Close()
+	}
+	d.conns = nil
+}
+
+// Broadcast sends a message to all client connections.
+func (d *testDevice) Broadcast(message proto.Message) {
+	d.mu.Lock()
+	conns := append([]*testConn(nil), d.conns...)
+	d.mu.Unlock()
+	for _, conn := range conns {
+		_ = conn.send(message)
+	}
+}
+
+// Requests returns the received requests of the given type.
+func (d *testDevice) Requests(messageType uint64) []proto.Message {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var requests []proto.Message
+	for _, request := range d.requests {
+		if api.TypeOf(request) == messageType {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// WaitRequests waits up to a second for n requests of the given type, it returns the number of requests seen.
+func (d *testDevice) WaitRequests(messageType uint64, n int) int {
+	deadline := time.Now().Add(time.Second)
+	for {
+		seen := len(d.Requests(messageType))
+		if seen >= n || time.Now().After(deadline) {
+			return seen
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (d *testDevice) serve() {
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+		c := &testConn{Conn: conn}
+		d.mu.Lock()
+		d.conns = append(d.conns, c)
+		d.mu.Unlock()
+		go d.serveConn(c)
+	}
+}
+
+func (d *testDevice) serveConn(conn *testConn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	for {
+		message, err := api.ReadMessage(br)
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		d.requests = append(d.requests, message)
+		d.mu.Unlock()
+
+		switch message.(type) {
+		case *api.HelloRequest:
+			err = conn.send(&api.HelloResponse{ApiVersionMajor: d.major, ApiVersionMinor: d.minor, ServerInfo: "test"})
+		case *api.ConnectRequest:
+			err = conn.send(&api.ConnectResponse{})
+		case *api.DeviceInfoRequest:
+			if d.info != nil {
+				err = conn.send(d.info)
+			}
+		case *api.ListEntitiesRequest:
+			for _, entity := range d.entities {
+				if err = conn.send(entity); err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = conn.send(&api.ListEntitiesDoneResponse{})
+			}
+		case *api.DisconnectRequest:
+			_ = conn.send(&api.DisconnectResponse{})
+			return
+		default:
+			if d.handle != nil {
+				d.handle(conn, message)
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package esphome
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome/api"
+)
+
+// Subscription buffer sizes.
+const (
+	streamBuffer  = 64
+	listingBuffer = 256
+)
+
+// dispatcher routes messages read from the node to the goroutines waiting for them.
+//
+// There are two kinds of receivers:
+//
+//   - pending requests wait for exactly one response of a single type. The node answers requests in order, so
+//     responses are handed out first come, first served: the first pending request of a type receives the first
+//     response of that type, the second pending request the second response, and so on.
+//   - subscriptions receive every message of their types. Multiple subscriptions of the same type all receive a
+//     copy of each message.
+//
+// The reader goroutine never blocks on a receiver. Each subscription has a bounded buffer; when the buffer is full,
+// the message is dropped for that subscription and counted in its dropped counter. Messages without any receiver are
+// dropped.
+type dispatcher struct {
+	mu            sync.Mutex
+	pending       map[uint64][]*subscription
+	subscriptions map[uint64][]*subscription
+}
+
+// subscription receives messages from the dispatcher.
+type subscription struct {
+	C       chan proto.Message
+	types   []uint64
+	once    bool
+	dropped uint64
+}
+
+func newDispatcher() *dispatcher {
+	return &dispatcher{
+		pending:       make(map[uint64][]*subscription),
+		subscriptions: make(map[uint64][]*subscription),
+	}
+}
+
+// request registers a pending request, that receives the next unclaimed response of messageType.
+func (d *dispatcher) request(messageType uint64) *subscription {
+	s := &subscription{
+		C:     make(chan proto.Message, 1),
+		types: []uint64{messageType},
+		once:  true,
+	}
+	d.mu.Lock()
+	d.pending[messageType] = append(d.pending[messageType], s)
+	d.mu.Unlock()
+	return s
+}
+
+// subscribe registers a subscription for all messages of the supplied types.
+func (d *dispatcher) subscribe(size int, messageTypes ...uint64) *subscription {
+	s := &subscription{
+		C:     make(chan proto.Message, size),
+		types: messageTypes,
+	}
+	d.mu.Lock()
+	for _, messageType := range messageTypes {
+		d.subscriptions[messageType] = append(d.subscriptions[messageType], s)
+	}
+	d.mu.Unlock()
+	return s
+}
+
+// cancel removes a pending request or subscription. It is safe to cancel more than once.
+func (d *dispatcher) cancel(s *subscription) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	index := d.subscriptions
+	if s.once {
+		index = d.pending
+	}
+	for _, messageType := range s.types {
+		index[messageType] = remove(index[messageType], s)
+		if len(index[messageType]) == 0 {
+			delete(index, messageType)
+		}
+	}
+}
+
+// dispatch delivers a message to its receivers, it reports if there was any receiver.
+func (d *dispatcher) dispatch(message proto.Message) bool {
+	messageType := api.TypeOf(message)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var handled bool
+	if pending := d.pending[messageType]; len(pending) > 0 {
+		// The buffer of a pending request is never full, it receives a single message.
+		pending[0].C <- message
+		if len(pending) == 1 {
+			delete(d.pending, messageType)
+		} else {
+			d.pending[messageType] = pending[1:]
+		}
+		handled = true
+	}
+	for _, s := range d.subscriptions[messageType] {
+		select {
+		case s.C <- message:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+		handled = true
+	}
+	return handled
+}
+
+// Dropped returns the number of messages dropped because the subscription buffer was full.
+func (s *subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+func remove(subscriptions []*subscription, s *subscription) []*subscription {
+	for i, other := range subscriptions {
+		if other == s {
+			return append(subscriptions[:i:i], subscriptions[i+1:]...)
+		}
+	}
+	return subscriptions
+}
@@ -0,0 +1,89 @@
+package esphome
+
+import (
+	"testing"
+
+	"maze.io/x/esphome/api"
+)
+
+func TestDispatcherRequestOrder(t *testing.T) {
+	var (
+		d      = newDispatcher()
+		first  = d.request(api.DeviceInfoResponseType)
+		second = d.request(api.DeviceInfoResponseType)
+	)
+	d.dispatch(&api.DeviceInfoResponse{Name: "first"})
+	d.dispatch(&api.DeviceInfoResponse{Name: "second"})
+	if d.dispatch(&api.DeviceInfoResponse{Name: "unclaimed"}) {
+		t.Error("expected unclaimed response to be unhandled")
+	}
+
+	if name := (<-first.C).(*api.DeviceInfoResponse).Name; name != "first" {
+		t.Errorf("expected first response, got %q", name)
+	}
+	if name := (<-second.C).(*api.DeviceInfoResponse).Name; name != "second" {
+		t.Errorf("expected second response, got %q", name)
+	}
+}
+
+func TestDispatcherCancel(t *testing.T) {
+	var (
+		d         = newDispatcher()
+		cancelled = d.request(api.DeviceInfoResponseType)
+		pending   = d.request(api.DeviceInfoResponseType)
+	)
+	d.cancel(cancelled)
+	d.cancel(cancelled)
+	d.dispatch(&api.DeviceInfoResponse{Name: "test"})
+
+	select {
+	case <-cancelled.C:
+		t.Error("cancelled request received a response")
+	default:
+	}
+	if name := (<-pending.C).(*api.DeviceInfoResponse).Name; name != "test" {
+		t.Errorf("expected response, got %q", name)
+	}
+}
+
+func TestDispatcherFanOut(t *testing.T) {
+	var (
+		d      = newDispatcher()
+		logs   = d.subscribe(1, api.SubscribeLogsResponseType)
+		both   = d.subscribe(2, api.SubscribeLogsResponseType, api.CameraImageResponseType)
+		frames = d.subscribe(1, api.CameraImageResponseType)
+	)
+	d.dispatch(&api.SubscribeLogsResponse{Message: "test"})
+	d.dispatch(&api.CameraImageResponse{Done: true})
+
+	if _, ok := (<-logs.C).(*api.SubscribeLogsResponse); !ok {
+		t.Error("expected log entry")
+	}
+	if _, ok := (<-both.C).(*api.SubscribeLogsResponse); !ok {
+		t.Error("expected log entry")
+	}
+	if _, ok := (<-both.C).(*api.CameraImageResponse); !ok {
+		t.Error("expected camera frame")
+	}
+	if _, ok := (<-frames.C).(*api.CameraImageResponse); !ok {
+		t.Error("expected camera frame")
+	}
+}
+
+func TestDispatcherOverflow(t *testing.T) {
+	var (
+		d    = newDispatcher()
+		slow = d.subscribe(2, api.SubscribeLogsResponseType)
+	)
+	for i := 0; i < 5; i++ {
+		if !d.dispatch(&api.SubscribeLogsResponse{}) {
+			t.Fatal("expected message to be handled")
+		}
+	}
+	if n := len(slow.C); n != 2 {
+		t.Errorf("expected 2 buffered messages, got %d", n)
+	}
+	if n := slow.Dropped(); n != 3 {
+		t.Errorf("expected 3 dropped messages, got %d", n)
+	}
+}
@@ -1,8 +1,14 @@
 package esphome
 
 import (
+	"context"
+	"fmt"
 	"image/color"
 	"math"
+	"math/bits"
+	"regexp"
+	"strings"
+	"time"
 
 	"maze.io/x/esphome/api"
 )
@@ -16,24 +22,347 @@ type Entity struct {
 	client   *Client
 }
 
+// rebind replaces the listing of entity with listing. The client is retained, it is never changed after the entity
+// is created so it can be read without holding the client lock.
+func (entity *Entity) rebind(listing *Entity) {
+	entity.Name = listing.Name
+	entity.ObjectID = listing.ObjectID
+	entity.UniqueID = listing.UniqueID
+	entity.Key = listing.Key
+}
+
 // Entities is a high level map of a device's entities.
 type Entities struct {
-	BinarySensor map[string]*BinarySensor
-	Camera       map[string]*Camera
-	Climate      map[string]*Climate
-	Cover        map[string]*Cover
-	Fan          map[string]*Fan
-	Light        map[string]*Light
-	Sensor       map[string]*Sensor
-	Switch       map[string]*Switch
-	TextSensor   map[string]*TextSensor
+	AlarmControlPanel map[string]*AlarmControlPanel
+	BinarySensor      map[string]*BinarySensor
+	Button            map[string]*Button
+	Camera            map[string]*Camera
+	Climate           map[string]*Climate
+	Cover             map[string]*Cover
+	Date              map[string]*Date
+	DateTime          map[string]*DateTime
+	Event             map[string]*EventEntity
+	Fan               map[string]*Fan
+	Light             map[string]*Light
+	Lock              map[string]*Lock
+	MediaPlayer       map[string]*MediaPlayer
+	Number            map[string]*Number
+	Select            map[string]*Select
+	Sensor            map[string]*Sensor
+	Service           map[string]*Service // indexed by name
+	Siren             map[string]*Siren
+	Switch            map[string]*Switch
+	Text              map[string]*Text
+	TextSensor        map[string]*TextSensor
+	Time              map[string]*Time
+	Update            map[string]*Update
+	Valve             map[string]*Valve
+}
+
+// AlarmControlPanel device, such as a security system.
+type AlarmControlPanel struct {
+	Entity
+	Icon              string
+	SupportedFeatures AlarmControlPanelFeature
+
+	// RequiresCode indicates a code is required to disarm the panel, RequiresCodeToArm indicates a code is also
+	// required to arm the panel.
+	RequiresCode      bool
+	RequiresCodeToArm bool
+
+	// State of the alarm control panel.
+	State        AlarmControlPanelState
+	StateIsValid bool
+
+	HandleState func(AlarmControlPanelState)
+}
+
+// AlarmControlPanelFeature is a bit mask of features supported by an alarm control panel.
+type AlarmControlPanelFeature uint32
+
+// Alarm control panel features.
+const (
+	AlarmControlPanelArmHome AlarmControlPanelFeature = 1 << iota
+	AlarmControlPanelArmAway
+	AlarmControlPanelArmNight
+	AlarmControlPanelTrigger
+	AlarmControlPanelArmCustomBypass
+	AlarmControlPanelArmVacation
+)
+
+// AlarmControlPanelState is the state of an alarm control panel.
+type AlarmControlPanelState int32
+
+// Alarm control panel states.
+const (
+	AlarmControlPanelStateDisarmed AlarmControlPanelState = iota
+	AlarmControlPanelStateArmedHome
+	AlarmControlPanelStateArmedAway
+	AlarmControlPanelStateArmedNight
+	AlarmControlPanelStateArmedVacation
+	AlarmControlPanelStateArmedCustomBypass
+	AlarmControlPanelStatePending
+	AlarmControlPanelStateArming
+	AlarmControlPanelStateDisarming
+	AlarmControlPanelStateTriggered
+)
+
+func (state AlarmControlPanelState) String() string {
+	switch state {
+	case AlarmControlPanelStateDisarmed:
+		return "disarmed"
+	case AlarmControlPanelStateArmedHome:
+		return "armed home"
+	case AlarmControlPanelStateArmedAway:
+		return "armed away"
+	case AlarmControlPanelStateArmedNight:
+		return "armed night"
+	case AlarmControlPanelStateArmedVacation:
+		return "armed vacation"
+	case AlarmControlPanelStateArmedCustomBypass:
+		return "armed custom bypass"
+	case AlarmControlPanelStatePending:
+		return "pending"
+	case AlarmControlPanelStateArming:
+		return "arming"
+	case AlarmControlPanelStateDisarming:
+		return "disarming"
+	case AlarmControlPanelStateTriggered:
+		return "triggered"
+	default:
+		return "unknown"
+	}
+}
+
+func newAlarmControlPanel(client *Client, entity *api.ListEntitiesAlarmControlPanelResponse) *AlarmControlPanel {
+	return &AlarmControlPanel{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:              entity.Icon,
+		SupportedFeatures: AlarmControlPanelFeature(entity.SupportedFeatures),
+		RequiresCode:      entity.RequiresCode,
+		RequiresCodeToArm: entity.RequiresCodeToArm,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *AlarmControlPanel) rebind(listing *AlarmControlPanel) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.SupportedFeatures = listing.SupportedFeatures
+	entity.RequiresCode = listing.RequiresCode
+	entity.RequiresCodeToArm = listing.RequiresCodeToArm
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *AlarmControlPanel) snapshot() AlarmControlPanel {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *AlarmControlPanel) update(
+	state *api.AlarmControlPanelStateResponse,
+	received time.Time,
+	calls *callbacks,
+) Event {
+	event := AlarmControlPanelStateEvent{
+		event:             newEvent(KindAlarmControlPanel, &entity.Entity, received),
+		AlarmControlPanel: entity,
+		Previous:          entity.State,
+		State:             AlarmControlPanelState(state.State),
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
+}
+
+// command sends an alarm control panel command, after checking the feature and validating the code.
+func (entity AlarmControlPanel) command(ctx context.Context, command api.AlarmControlPanelStateCommand,
+	feature AlarmControlPanelFeature, name, code string) error {
+	if feature != 0 && entity.SupportedFeatures&feature == 0 {
+		return UnsupportedError{ObjectID: entity.ObjectID, Feature: name}
+	}
+	required := entity.RequiresCode
+	if command != api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_DISARM {
+		required = required && entity.RequiresCodeToArm
+	}
+	if err := checkCode(entity.ObjectID, required, "", code); err != nil {
+		return err
+	}
+	return entity.client.sendContext(ctx, &api.AlarmControlPanelCommandRequest{
+		Key:     entity.Key,
+		Command: command,
+		Code:    code,
+	})
+}
+
+// Disarm the panel. The code may be empty if the panel doesn't require a code.
+func (entity *AlarmControlPanel) Disarm(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.DisarmContext(ctx, code))
+}
+
+// DisarmContext is like Disarm with a context.
+func (entity *AlarmControlPanel) DisarmContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_DISARM, 0, "disarm", code)
+}
+
+// ArmAway arms the panel for when nobody is home. The code may be empty if the panel doesn't require a code to arm.
+func (entity *AlarmControlPanel) ArmAway(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.ArmAwayContext(ctx, code))
+}
+
+// ArmAwayContext is like ArmAway with a context.
+func (entity *AlarmControlPanel) ArmAwayContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_AWAY,
+		AlarmControlPanelArmAway, "arm away", code)
+}
+
+// ArmHome arms the panel for when people are home. The code may be empty if the panel doesn't require a code to arm.
+func (entity *AlarmControlPanel) ArmHome(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.ArmHomeContext(ctx, code))
+}
+
+// ArmHomeContext is like ArmHome with a context.
+func (entity *AlarmControlPanel) ArmHomeContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_HOME,
+		AlarmControlPanelArmHome, "arm home", code)
+}
+
+// ArmNight arms the panel for the night. The code may be empty if the panel doesn't require a code to arm.
+func (entity *AlarmControlPanel) ArmNight(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.ArmNightContext(ctx, code))
+}
+
+// ArmNightContext is like ArmNight with a context.
+func (entity *AlarmControlPanel) ArmNightContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_NIGHT,
+		AlarmControlPanelArmNight, "arm night", code)
+}
+
+// ArmVacation arms the panel for a vacation. The code may be empty if the panel doesn't require a code to arm.
+func (entity *AlarmControlPanel) ArmVacation(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.ArmVacationContext(ctx, code))
+}
+
+// ArmVacationContext is like ArmVacation with a context.
+func (entity *AlarmControlPanel) ArmVacationContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_VACATION,
+		AlarmControlPanelArmVacation, "arm vacation", code)
+}
+
+// ArmCustomBypass arms the panel with custom bypassed zones. The code may be empty if the panel doesn't require a
+// code to arm.
+func (entity *AlarmControlPanel) ArmCustomBypass(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.ArmCustomBypassContext(ctx, code))
+}
+
+// ArmCustomBypassContext is like ArmCustomBypass with a context.
+func (entity *AlarmControlPanel) ArmCustomBypassContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_CUSTOM_BYPASS,
+		AlarmControlPanelArmCustomBypass, "arm custom bypass", code)
+}
+
+// Trigger the alarm. The code may be empty if the panel doesn't require a code to arm.
+func (entity *AlarmControlPanel) Trigger(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.TriggerContext(ctx, code))
+}
+
+// TriggerContext is like Trigger with a context.
+func (entity *AlarmControlPanel) TriggerContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_TRIGGER,
+		AlarmControlPanelTrigger, "trigger", code)
 }
 
 // BinarySensor can be pressed, released and/or clicked.
 type BinarySensor struct {
 	Entity
 	DeviceClass string
-	State       bool
+
+	State        bool
+	StateIsValid bool
+
+	// Timings used to detect clicks, long presses and double clicks, zero values use the
+	// DefaultBinarySensorTimings.
+	Timings BinarySensorTimings
+
+	HandleState func(bool)
+
+	// HandlePress is called if the state changes from off to on, and HandleRelease if the state changes from on to
+	// off.
+	HandlePress   func()
+	HandleRelease func()
+
+	// HandleClick is called on release if the sensor was on for a click duration, HandleDoubleClick is called in
+	// addition if the click quickly followed another click. HandleLongPress is called on release if the sensor was on
+	// for at least the long press duration.
+	HandleClick       func()
+	HandleDoubleClick func()
+	HandleLongPress   func()
+
+	pressed   time.Time
+	lastClick time.Time
+}
+
+// BinarySensorTimings are the timings used to detect BinarySensor clicks.
+type BinarySensorTimings struct {
+	// ClickMin and ClickMax are the minimum and maximum durations of a click.
+	ClickMin, ClickMax time.Duration
+
+	// LongPress is the minimum duration of a long press.
+	LongPress time.Duration
+
+	// DoubleClick is the maximum duration between the release of a click and the press of the next click, for both
+	// clicks to count as a double click.
+	DoubleClick time.Duration
+}
+
+// DefaultBinarySensorTimings are the default BinarySensor timings, these match the ESPHome defaults.
+var DefaultBinarySensorTimings = BinarySensorTimings{
+	ClickMin:    50 * time.Millisecond,
+	ClickMax:    350 * time.Millisecond,
+	LongPress:   time.Second,
+	DoubleClick: 250 * time.Millisecond,
+}
+
+func (timings BinarySensorTimings) withDefaults() BinarySensorTimings {
+	if timings.ClickMin == 0 {
+		timings.ClickMin = DefaultBinarySensorTimings.ClickMin
+	}
+	if timings.ClickMax == 0 {
+		timings.ClickMax = DefaultBinarySensorTimings.ClickMax
+	}
+	if timings.LongPress == 0 {
+		timings.LongPress = DefaultBinarySensorTimings.LongPress
+	}
+	if timings.DoubleClick == 0 {
+		timings.DoubleClick = DefaultBinarySensorTimings.DoubleClick
+	}
+	return timings
 }
 
 func newBinarySensor(client *Client, entity *api.ListEntitiesBinarySensorResponse) *BinarySensor {
@@ -49,6 +378,137 @@ func newBinarySensor(client *Client, entity *api.ListEntitiesBinarySensorRespons
 	}
 }
 
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *BinarySensor) rebind(listing *BinarySensor) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.DeviceClass = listing.DeviceClass
+}
+
+func (entity *BinarySensor) update(state *api.BinarySensorStateResponse, received time.Time, calls *callbacks) Event {
+	event := BinarySensorStateEvent{
+		event:           newEvent(KindBinarySensor, &entity.Entity, received),
+		BinarySensor:    entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           state.State,
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != state.State) {
+		calls.add(func() { handle(state.State) })
+	}
+
+	switch {
+	case state.MissingState:
+		entity.pressed = time.Time{}
+	case !entity.StateIsValid:
+		// The first state received is not an edge.
+	case !entity.State && state.State:
+		entity.press(received, calls)
+	case entity.State && !state.State:
+		entity.release(received, calls)
+	}
+
+	entity.State = state.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+func (entity *BinarySensor) press(received time.Time, calls *callbacks) {
+	if handle := entity.HandlePress; handle != nil {
+		calls.add(handle)
+	}
+	entity.pressed = received
+}
+
+func (entity *BinarySensor) release(received time.Time, calls *callbacks) {
+	if handle := entity.HandleRelease; handle != nil {
+		calls.add(handle)
+	}
+	if entity.pressed.IsZero() {
+		return
+	}
+
+	var (
+		timings  = entity.Timings.withDefaults()
+		duration = received.Sub(entity.pressed)
+	)
+	switch {
+	case duration >= timings.LongPress:
+		entity.lastClick = time.Time{}
+		if handle := entity.HandleLongPress; handle != nil {
+			calls.add(handle)
+		}
+	case duration >= timings.ClickMin && duration <= timings.ClickMax:
+		if handle := entity.HandleClick; handle != nil {
+			calls.add(handle)
+		}
+		if !entity.lastClick.IsZero() && entity.pressed.Sub(entity.lastClick) <= timings.DoubleClick {
+			// A third click starts a new double click.
+			entity.lastClick = time.Time{}
+			if handle := entity.HandleDoubleClick; handle != nil {
+				calls.add(handle)
+			}
+		} else {
+			entity.lastClick = received
+		}
+	default:
+		entity.lastClick = time.Time{}
+	}
+	entity.pressed = time.Time{}
+}
+
+// Button triggers an action on the node, such as a restart. Buttons don't have a state.
+type Button struct {
+	Entity
+	Icon        string
+	DeviceClass string
+}
+
+func newButton(client *Client, entity *api.ListEntitiesButtonResponse) *Button {
+	return &Button{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:        entity.Icon,
+		DeviceClass: entity.DeviceClass,
+	}
+}
+
+// rebind replaces the listing of entity with listing.
+func (entity *Button) rebind(listing *Button) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.DeviceClass = listing.DeviceClass
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Button) snapshot() Button {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+// Press the button.
+func (entity *Button) Press() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.PressContext(ctx))
+}
+
+// PressContext is like Press with a context.
+func (entity *Button) PressContext(ctx context.Context) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.ButtonCommandRequest{
+		Key: current.Key,
+	})
+}
+
 // Climate devices can represent different types of hardware, but the defining factor is that climate devices have a
 // settable target temperature and can be put in different modes like HEAT, COOL, AUTO or OFF.
 type Climate struct {
@@ -56,6 +516,12 @@ type Climate struct {
 
 	// Capabilities of the entity.
 	Capabilities ClimateCapabilities
+
+	// State of the entity.
+	State        ClimateState
+	StateIsValid bool
+
+	HandleState func(ClimateState)
 }
 
 type (
@@ -81,16 +547,37 @@ type (
 
 	// ClimateSwingMode represents a climate (fan) swing mode.
 	ClimateSwingMode int32
+
+	// ClimateAction represents what a climate device is currently doing.
+	ClimateAction int32
+
+	// ClimateState represents the state of a climate device.
+	ClimateState struct {
+		Mode                  ClimateMode
+		Action                ClimateAction
+		CurrentTemperature    float32
+		TargetTemperature     float32
+		TargetTemperatureLow  float32
+		TargetTemperatureHigh float32
+		Away                  bool
+		FanMode               ClimateFanMode
+		SwingMode             ClimateSwingMode
+	}
 )
 
-// Climate modes.
+// Climate modes, the values match the native API. Nodes before API 1.5 report automatic heating and cooling as
+// ClimateModeHeatCool.
+//
+// The values changed with the API upgrade: ClimateModeHeatCool took value 1, which was ClimateModeAuto before, and
+// ClimateModeAuto is now 6. Use the constants rather than stored numeric values.
 const (
 	ClimateModeOff ClimateMode = iota
-	ClimateModeAuto
+	ClimateModeHeatCool
 	ClimateModeCool
 	ClimateModeHeat
 	ClimateModeFanOnly
 	ClimateModeDry
+	ClimateModeAuto
 )
 
 // Climate fan modes.
@@ -104,6 +591,7 @@ const (
 	ClimateFanModeMiddle
 	ClimateFanModeFocus
 	ClimateFanModeDiffuse
+	ClimateFanModeQuiet
 )
 
 // Climate swing modes.
@@ -114,6 +602,79 @@ const (
 	ClimateSwingModeHorizontal
 )
 
+func (mode ClimateMode) String() string {
+	switch mode {
+	case ClimateModeOff:
+		return "off"
+	case ClimateModeHeatCool:
+		return "heat cool"
+	case ClimateModeCool:
+		return "cool"
+	case ClimateModeHeat:
+		return "heat"
+	case ClimateModeFanOnly:
+		return "fan only"
+	case ClimateModeDry:
+		return "dry"
+	case ClimateModeAuto:
+		return "auto"
+	default:
+		return "unknown"
+	}
+}
+
+func (mode ClimateFanMode) String() string {
+	switch mode {
+	case ClimateFanModeOn:
+		return "on"
+	case ClimateFanModeOff:
+		return "off"
+	case ClimateFanModeAuto:
+		return "auto"
+	case ClimateFanModeLow:
+		return "low"
+	case ClimateFanModeMedium:
+		return "medium"
+	case ClimateFanModeHigh:
+		return "high"
+	case ClimateFanModeMiddle:
+		return "middle"
+	case ClimateFanModeFocus:
+		return "focus"
+	case ClimateFanModeDiffuse:
+		return "diffuse"
+	case ClimateFanModeQuiet:
+		return "quiet"
+	default:
+		return "unknown"
+	}
+}
+
+func (mode ClimateSwingMode) String() string {
+	switch mode {
+	case ClimateSwingModeOff:
+		return "off"
+	case ClimateSwingModeBoth:
+		return "both"
+	case ClimateSwingModeVertical:
+		return "vertical"
+	case ClimateSwingModeHorizontal:
+		return "horizontal"
+	default:
+		return "unknown"
+	}
+}
+
+// Climate actions.
+const (
+	ClimateActionOff     ClimateAction = 0
+	ClimateActionCooling ClimateAction = 2
+	ClimateActionHeating ClimateAction = 3
+	ClimateActionIdle    ClimateAction = 4
+	ClimateActionDrying  ClimateAction = 5
+	ClimateActionFan     ClimateAction = 6
+)
+
 func newClimate(client *Client, entity *api.ListEntitiesClimateResponse) *Climate {
 	var (
 		modes      = make([]ClimateMode, len(entity.SupportedModes))
@@ -143,8 +704,8 @@ func newClimate(client *Client, entity *api.ListEntitiesClimateResponse) *Climat
 			Modes:                     modes,
 			VisualMinTemperature:      entity.VisualMinTemperature,
 			VisualMaxTemperature:      entity.VisualMaxTemperature,
-			VisualTemperatureStep:     entity.VisualTemperatureStep,
-			Away:                      entity.SupportsAway,
+			VisualTemperatureStep:     entity.VisualTargetTemperatureStep,
+			Away:                      entity.LegacySupportsAway,
 			Action:                    entity.SupportsAction,
 			FanModes:                  fanModes,
 			SwingModes:                swingModes,
@@ -152,200 +713,2245 @@ func newClimate(client *Client, entity *api.ListEntitiesClimateResponse) *Climat
 	}
 }
 
-// Cover device.
-type Cover struct {
-	Entity
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Climate) rebind(listing *Climate) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Capabilities = listing.Capabilities
+}
 
-	// TODO(maze): Finish me
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Climate) snapshot() Climate {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
 }
 
-func newCover(client *Client, entity *api.ListEntitiesCoverResponse) *Cover {
-	return &Cover{
-		Entity: Entity{
-			Name:     entity.Name,
-			ObjectID: entity.ObjectId,
-			UniqueID: entity.UniqueId,
-			Key:      entity.Key,
-			client:   client,
+func (entity *Climate) update(state *api.ClimateStateResponse, received time.Time, calls *callbacks) Event {
+	event := ClimateStateEvent{
+		event:    newEvent(KindClimate, &entity.Entity, received),
+		Climate:  entity,
+		Previous: entity.State,
+		State: ClimateState{
+			Mode:                  ClimateMode(state.Mode),
+			Action:                ClimateAction(state.Action),
+			CurrentTemperature:    state.CurrentTemperature,
+			TargetTemperature:     state.TargetTemperature,
+			TargetTemperatureLow:  state.TargetTemperatureLow,
+			TargetTemperatureHigh: state.TargetTemperatureHigh,
+			Away:                  state.LegacyAway,
+			FanMode:               ClimateFanMode(state.FanMode),
+			SwingMode:             ClimateSwingMode(state.SwingMode),
 		},
 	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
 }
 
-// Fan device.
-type Fan struct {
-	Entity
+// checkTemperature validates a target temperature against the visual range and step of the climate device, like
+// Number the steps start at the minimum.
+func (entity Climate) checkTemperature(temperature float32) error {
+	var (
+		min  = entity.Capabilities.VisualMinTemperature
+		max  = entity.Capabilities.VisualMaxTemperature
+		step = entity.Capabilities.VisualTemperatureStep
+	)
+	if max > min && (temperature < min || temperature > max) {
+		return RangeError{ObjectID: entity.ObjectID, Value: temperature, Min: min, Max: max}
+	}
+	if step > 0 {
+		// Allow for some rounding error in the float32 values.
+		steps := float64((temperature - min) / step)
+		if math.Abs(steps-math.Round(steps)) > 1e-3 {
+			return StepError{ObjectID: entity.ObjectID, Value: temperature, Step: step}
+		}
+	}
+	return nil
+}
 
-	// TODO(maze): Finish me
+// SetMode sets the climate mode.
+func (entity *Climate) SetMode(mode ClimateMode) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetModeContext(ctx, mode))
 }
 
-func newFan(client *Client, entity *api.ListEntitiesFanResponse) *Fan {
-	return &Fan{
-		Entity: Entity{
-			Name:     entity.Name,
-			ObjectID: entity.ObjectId,
-			UniqueID: entity.UniqueId,
-			Key:      entity.Key,
-			client:   client,
-		},
+// SetModeContext is like SetMode with a context.
+func (entity *Climate) SetModeContext(ctx context.Context, mode ClimateMode) error {
+	current := entity.snapshot()
+	if !containsClimateMode(current.Capabilities.Modes, mode) {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "mode " + mode.String()}
 	}
+	return current.client.sendContext(ctx, &api.ClimateCommandRequest{
+		Key:     current.Key,
+		HasMode: true,
+		Mode:    api.ClimateMode(mode),
+	})
 }
 
-// Light device.
-type Light struct {
-	Entity
+// SetTargetTemperature sets the target temperature, for climate devices that don't have a two-point target
+// temperature.
+func (entity *Climate) SetTargetTemperature(temperature float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetTargetTemperatureContext(ctx, temperature))
+}
 
-	Capabilities LightCapabilities
-	Effects      []string
+// SetTargetTemperatureContext is like SetTargetTemperature with a context.
+func (entity *Climate) SetTargetTemperatureContext(ctx context.Context, temperature float32) error {
+	current := entity.snapshot()
+	if current.Capabilities.TwoPointTargetTemperature {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "single-point target temperature"}
+	}
+	if err := current.checkTemperature(temperature); err != nil {
+		return err
+	}
+	return current.client.sendContext(ctx, &api.ClimateCommandRequest{
+		Key:                  current.Key,
+		HasTargetTemperature: true,
+		TargetTemperature:    temperature,
+	})
+}
 
-	State        LightState
-	StateIsValid bool
+// SetTargetRange sets the low and high target temperatures, for climate devices that have a two-point target
+// temperature.
+func (entity *Climate) SetTargetRange(low, high float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetTargetRangeContext(ctx, low, high))
+}
 
-	HandleState            func(on bool)
-	HandleBrightness       func(float32)
-	HandleColor            func(r, g, b, w float32)
-	HandleColorTemperature func(float32)
-	HandleEffect           func(string)
+// SetTargetRangeContext is like SetTargetRange with a context.
+func (entity *Climate) SetTargetRangeContext(ctx context.Context, low, high float32) error {
+	current := entity.snapshot()
+	if !current.Capabilities.TwoPointTargetTemperature {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "two-point target temperature"}
+	}
+	for _, temperature := range []float32{low, high} {
+		if err := current.checkTemperature(temperature); err != nil {
+			return err
+		}
+	}
+	if low > high {
+		return TargetRangeError{ObjectID: current.ObjectID, Low: low, High: high}
+	}
+	return current.client.sendContext(ctx, &api.ClimateCommandRequest{
+		Key:                      current.Key,
+		HasTargetTemperatureLow:  true,
+		TargetTemperatureLow:     low,
+		HasTargetTemperatureHigh: true,
+		TargetTemperatureHigh:    high,
+	})
 }
 
-// LightCapabilities represents the capabilities of a Light.
-type LightCapabilities struct {
-	Brightness       bool
-	RGB              bool
-	WhiteValue       bool
-	ColorTemperature bool
-	MinMired         float32
-	MaxMired         float32
+// SetAway enables or disables away mode.
+func (entity *Climate) SetAway(away bool) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetAwayContext(ctx, away))
 }
 
-// LightState represents the state of a Light.
-type LightState struct {
-	On                      bool
-	Brightness              float32
-	Red, Green, Blue, White float32
-	ColorTemperature        float32
-	Effect                  string
+// SetAwayContext is like SetAway with a context.
+func (entity *Climate) SetAwayContext(ctx context.Context, away bool) error {
+	current := entity.snapshot()
+	if !current.Capabilities.Away {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "away mode"}
+	}
+	return current.client.sendContext(ctx, &api.ClimateCommandRequest{
+		Key:           current.Key,
+		HasLegacyAway: true,
+		LegacyAway:    away,
+	})
 }
 
-func newLight(client *Client, entity *api.ListEntitiesLightResponse) *Light {
-	effects := make([]string, len(entity.Effects))
-	copy(effects, entity.Effects)
-	return &Light{
-		Entity: Entity{
-			Name:     entity.Name,
-			ObjectID: entity.ObjectId,
-			UniqueID: entity.UniqueId,
-			Key:      entity.Key,
-			client:   client,
-		},
-		Capabilities: LightCapabilities{
-			Brightness:       entity.SupportsBrightness,
-			RGB:              entity.SupportsRgb,
-			WhiteValue:       entity.SupportsWhiteValue,
-			ColorTemperature: entity.SupportsColorTemperature,
-			MinMired:         entity.MinMireds,
-			MaxMired:         entity.MaxMireds,
-		},
-		Effects: effects,
+// SetFanMode sets the fan mode.
+func (entity *Climate) SetFanMode(mode ClimateFanMode) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetFanModeContext(ctx, mode))
+}
+
+// SetFanModeContext is like SetFanMode with a context.
+func (entity *Climate) SetFanModeContext(ctx context.Context, mode ClimateFanMode) error {
+	current := entity.snapshot()
+	if !containsClimateFanMode(current.Capabilities.FanModes, mode) {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "fan mode " + mode.String()}
 	}
+	return current.client.sendContext(ctx, &api.ClimateCommandRequest{
+		Key:        current.Key,
+		HasFanMode: true,
+		FanMode:    api.ClimateFanMode(mode),
+	})
 }
 
-func (entity Light) commandRequest() *api.LightCommandRequest {
-	return &api.LightCommandRequest{
-		Key:                 entity.Key,
-		State:               entity.State.On,
-		HasBrightness:       entity.Capabilities.Brightness,
-		Brightness:          entity.State.Brightness,
-		HasRgb:              entity.Capabilities.RGB,
-		Red:                 entity.State.Red,
-		Green:               entity.State.Green,
-		Blue:                entity.State.Blue,
-		HasWhite:            entity.Capabilities.WhiteValue,
-		White:               entity.State.White,
-		HasColorTemperature: entity.Capabilities.ColorTemperature,
-		ColorTemperature:    entity.State.ColorTemperature,
-		HasTransitionLength: false,
-		TransitionLength:    0,
-		HasFlashLength:      false,
-		FlashLength:         0,
-		HasEffect:           false,
-		Effect:              entity.State.Effect,
-	}
-}
-
-func (entity *Light) update(state *api.LightStateResponse) {
-	if entity.HandleState != nil {
-		if state.State != entity.State.On {
-			entity.HandleState(true)
-		}
-	}
-	if entity.HandleColor != nil {
-		if !entity.StateIsValid {
-			entity.HandleColor(state.Red, state.Green, state.Blue, state.White)
-		} else if !equal(entity.State.Red, state.Red) ||
-			!equal(entity.State.Green, state.Green) ||
-			!equal(entity.State.Blue, state.Blue) ||
-			!equal(entity.State.White, state.White) {
-			entity.HandleColor(state.Red, state.Green, state.Blue, state.White)
+// SetSwingMode sets the swing mode.
+func (entity *Climate) SetSwingMode(mode ClimateSwingMode) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetSwingModeContext(ctx, mode))
+}
+
+// SetSwingModeContext is like SetSwingMode with a context.
+func (entity *Climate) SetSwingModeContext(ctx context.Context, mode ClimateSwingMode) error {
+	current := entity.snapshot()
+	if !containsClimateSwingMode(current.Capabilities.SwingModes, mode) {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "swing mode " + mode.String()}
+	}
+	return current.client.sendContext(ctx, &api.ClimateCommandRequest{
+		Key:          current.Key,
+		HasSwingMode: true,
+		SwingMode:    api.ClimateSwingMode(mode),
+	})
+}
+
+func containsClimateMode(modes []ClimateMode, mode ClimateMode) bool {
+	for _, other := range modes {
+		if other == mode {
+			return true
 		}
 	}
-	if entity.HandleColorTemperature != nil {
+	return false
+}
+
+func containsClimateFanMode(modes []ClimateFanMode, mode ClimateFanMode) bool {
+	for _, other := range modes {
+		if other == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func containsClimateSwingMode(modes []ClimateSwingMode, mode ClimateSwingMode) bool {
+	for _, other := range modes {
+		if other == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// Cover device, such as blinds, garage doors and windows.
+type Cover struct {
+	Entity
+	AssumedState     bool
+	SupportsPosition bool
+	SupportsTilt     bool
+	DeviceClass      string
+
+	// State of the cover.
+	State        CoverState
+	StateIsValid bool
+
+	HandleState func(CoverState)
+}
+
+// CoverOperation is the current operation of a cover.
+type CoverOperation int32
+
+// Cover operations.
+const (
+	CoverOperationIdle CoverOperation = iota
+	CoverOperationOpening
+	CoverOperationClosing
+)
+
+// Cover positions.
+const (
+	CoverClosed float32 = 0.0
+	CoverOpen   float32 = 1.0
+)
+
+// CoverState represents the state of a cover.
+type CoverState struct {
+	// Position from 0.0 (closed) to 1.0 (open).
+	Position float32
+
+	// Tilt from 0.0 (closed) to 1.0 (open).
+	Tilt float32
+
+	CurrentOperation CoverOperation
+}
+
+func newCover(client *Client, entity *api.ListEntitiesCoverResponse) *Cover {
+	return &Cover{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		AssumedState:     entity.AssumedState,
+		SupportsPosition: entity.SupportsPosition,
+		SupportsTilt:     entity.SupportsTilt,
+		DeviceClass:      entity.DeviceClass,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Cover) rebind(listing *Cover) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.AssumedState = listing.AssumedState
+	entity.SupportsPosition = listing.SupportsPosition
+	entity.SupportsTilt = listing.SupportsTilt
+	entity.DeviceClass = listing.DeviceClass
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Cover) snapshot() Cover {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Cover) update(state *api.CoverStateResponse, received time.Time, calls *callbacks) Event {
+	event := CoverStateEvent{
+		event:    newEvent(KindCover, &entity.Entity, received),
+		Cover:    entity,
+		Previous: entity.State,
+		State: CoverState{
+			Position:         state.Position,
+			Tilt:             state.Tilt,
+			CurrentOperation: CoverOperation(state.CurrentOperation),
+		},
+	}
+	// The client lock is held during updates.
+	if !entity.client.apiVersion.atLeast(1, 1) {
+		// Nodes before API 1.1 only report open or closed.
+		if state.LegacyState == api.LegacyCoverState_LEGACY_COVER_STATE_OPEN {
+			event.State.Position = CoverOpen
+		} else {
+			event.State.Position = CoverClosed
+		}
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
+}
+
+// legacy checks if the node only supports the legacy cover commands, which were replaced in API 1.1 (ESPHome 1.13).
+func (entity Cover) legacy() bool {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return !entity.client.apiVersion.atLeast(1, 1)
+}
+
+// command sends a cover command, nil values are not sent.
+func (entity Cover) command(ctx context.Context, position, tilt *float32, stop bool) error {
+	request := &api.CoverCommandRequest{
+		Key: entity.Key,
+	}
+	if entity.legacy() {
+		request.HasLegacyCommand = true
+		switch {
+		case tilt != nil:
+			return UnsupportedError{ObjectID: entity.ObjectID, Feature: "tilt"}
+		case stop:
+			request.LegacyCommand = api.LegacyCoverCommand_LEGACY_COVER_COMMAND_STOP
+		case *position == CoverOpen:
+			request.LegacyCommand = api.LegacyCoverCommand_LEGACY_COVER_COMMAND_OPEN
+		case *position == CoverClosed:
+			request.LegacyCommand = api.LegacyCoverCommand_LEGACY_COVER_COMMAND_CLOSE
+		default:
+			return UnsupportedError{ObjectID: entity.ObjectID, Feature: "position"}
+		}
+	} else {
+		if position != nil {
+			request.HasPosition = true
+			request.Position = *position
+		}
+		if tilt != nil {
+			request.HasTilt = true
+			request.Tilt = *tilt
+		}
+		request.Stop = stop
+	}
+	return entity.client.sendContext(ctx, request)
+}
+
+// Open the cover.
+func (entity *Cover) Open() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.OpenContext(ctx))
+}
+
+// OpenContext is like Open with a context.
+func (entity *Cover) OpenContext(ctx context.Context) error {
+	position := CoverOpen
+	return entity.snapshot().command(ctx, &position, nil, false)
+}
+
+// Close the cover.
+func (entity *Cover) Close() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.CloseContext(ctx))
+}
+
+// CloseContext is like Close with a context.
+func (entity *Cover) CloseContext(ctx context.Context) error {
+	position := CoverClosed
+	return entity.snapshot().command(ctx, &position, nil, false)
+}
+
+// Stop the current cover operation.
+func (entity *Cover) Stop() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.StopContext(ctx))
+}
+
+// StopContext is like Stop with a context.
+func (entity *Cover) StopContext(ctx context.Context) error {
+	return entity.snapshot().command(ctx, nil, nil, true)
+}
+
+// SetPosition moves the cover to a position between 0.0 (closed) and 1.0 (open). Covers that don't support
+// positioning can only be opened or closed.
+func (entity *Cover) SetPosition(position float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetPositionContext(ctx, position))
+}
+
+// SetPositionContext is like SetPosition with a context.
+func (entity *Cover) SetPositionContext(ctx context.Context, position float32) error {
+	current := entity.snapshot()
+	if position < CoverClosed || position > CoverOpen {
+		return RangeError{ObjectID: current.ObjectID, Value: position, Min: CoverClosed, Max: CoverOpen}
+	}
+	if !current.SupportsPosition && position != CoverClosed && position != CoverOpen {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "position"}
+	}
+	return current.command(ctx, &position, nil, false)
+}
+
+// SetTilt tilts the cover to a position between 0.0 (closed) and 1.0 (open).
+func (entity *Cover) SetTilt(tilt float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetTiltContext(ctx, tilt))
+}
+
+// SetTiltContext is like SetTilt with a context.
+func (entity *Cover) SetTiltContext(ctx context.Context, tilt float32) error {
+	current := entity.snapshot()
+	if !current.SupportsTilt {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "tilt"}
+	}
+	if tilt < CoverClosed || tilt > CoverOpen {
+		return RangeError{ObjectID: current.ObjectID, Value: tilt, Min: CoverClosed, Max: CoverOpen}
+	}
+	return current.command(ctx, nil, &tilt, false)
+}
+
+// CivilDate is a date without a time or location.
+type CivilDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// CivilDateOf returns the date of t, in the location of t.
+func CivilDateOf(t time.Time) CivilDate {
+	year, month, day := t.Date()
+	return CivilDate{Year: year, Month: month, Day: day}
+}
+
+// In returns the start of the date in the location.
+func (date CivilDate) In(location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, location)
+}
+
+func (date CivilDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+}
+
+// Date is a date that can be set, such as the start of a schedule.
+type Date struct {
+	Entity
+	Icon string
+
+	State        CivilDate
+	StateIsValid bool
+
+	HandleState func(CivilDate)
+}
+
+func newDate(client *Client, entity *api.ListEntitiesDateResponse) *Date {
+	return &Date{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon: entity.Icon,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Date) rebind(listing *Date) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Date) snapshot() Date {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Date) update(state *api.DateStateResponse, received time.Time, calls *callbacks) Event {
+	event := DateStateEvent{
+		event:           newEvent(KindDate, &entity.Entity, received),
+		Date:            entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State: CivilDate{
+			Year:  int(state.Year),
+			Month: time.Month(state.Month),
+			Day:   int(state.Day),
+		},
+		StateIsValid: !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != event.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// SetDate sets the date.
+func (entity *Date) SetDate(date CivilDate) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetDateContext(ctx, date))
+}
+
+// SetDateContext is like SetDate with a context.
+func (entity *Date) SetDateContext(ctx context.Context, date CivilDate) error {
+	current := entity.snapshot()
+	if date.Year < 1 || date.Year > 9999 {
+		return RangeError{ObjectID: current.ObjectID, Value: float32(date.Year), Min: 1, Max: 9999}
+	}
+	if date.Month < time.January || date.Month > time.December {
+		return RangeError{ObjectID: current.ObjectID, Value: float32(date.Month), Min: 1, Max: 12}
+	}
+	// The zeroth day of the next month is the last day of the month.
+	if days := time.Date(date.Year, date.Month+1, 0, 0, 0, 0, 0, time.UTC).Day(); date.Day < 1 || date.Day > days {
+		return RangeError{ObjectID: current.ObjectID, Value: float32(date.Day), Min: 1, Max: float32(days)}
+	}
+	return current.client.sendContext(ctx, &api.DateCommandRequest{
+		Key:   current.Key,
+		Year:  uint32(date.Year),
+		Month: uint32(date.Month),
+		Day:   uint32(date.Day),
+	})
+}
+
+// DateTime is a point in time that can be set, such as an alarm.
+type DateTime struct {
+	Entity
+	Icon string
+
+	State        time.Time
+	StateIsValid bool
+
+	HandleState func(time.Time)
+}
+
+func newDateTime(client *Client, entity *api.ListEntitiesDateTimeResponse) *DateTime {
+	return &DateTime{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon: entity.Icon,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *DateTime) rebind(listing *DateTime) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *DateTime) snapshot() DateTime {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *DateTime) update(state *api.DateTimeStateResponse, received time.Time, calls *callbacks) Event {
+	event := DateTimeStateEvent{
+		event:           newEvent(KindDateTime, &entity.Entity, received),
+		DateTime:        entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           time.Unix(int64(state.EpochSeconds), 0),
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || !entity.State.Equal(event.State)) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// SetDateTime sets the point in time, with a precision of one second.
+func (entity *DateTime) SetDateTime(t time.Time) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetDateTimeContext(ctx, t))
+}
+
+// SetDateTimeContext is like SetDateTime with a context.
+func (entity *DateTime) SetDateTimeContext(ctx context.Context, t time.Time) error {
+	current := entity.snapshot()
+	// Nodes use unsigned 32-bit epoch seconds.
+	if seconds := t.Unix(); seconds < 0 || seconds > math.MaxUint32 {
+		return RangeError{ObjectID: current.ObjectID, Value: float32(seconds), Min: 0, Max: math.MaxUint32}
+	}
+	return current.client.sendContext(ctx, &api.DateTimeCommandRequest{
+		Key:          current.Key,
+		EpochSeconds: uint32(t.Unix()),
+	})
+}
+
+// EventEntity fires events, such as doorbell presses. It's not named Event, because Event is a state update.
+//
+// Fired events are published as FiredEvent to subscribers, see Client.Subscribe.
+type EventEntity struct {
+	Entity
+	Icon        string
+	DeviceClass string
+
+	// EventTypes are the types of events the entity fires.
+	EventTypes []string
+
+	// LastEventType is the type of the last fired event, LastFired is the time it was received.
+	LastEventType string
+	LastFired     time.Time
+
+	HandleEvent func(eventType string, fired time.Time)
+}
+
+func newEventEntity(client *Client, entity *api.ListEntitiesEventResponse) *EventEntity {
+	return &EventEntity{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:        entity.Icon,
+		DeviceClass: entity.DeviceClass,
+		EventTypes:  entity.EventTypes,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *EventEntity) rebind(listing *EventEntity) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.DeviceClass = listing.DeviceClass
+	entity.EventTypes = listing.EventTypes
+}
+
+func (entity *EventEntity) update(message *api.EventResponse, received time.Time, calls *callbacks) Event {
+	event := FiredEvent{
+		event:       newEvent(KindEvent, &entity.Entity, received),
+		EventEntity: entity,
+		EventType:   message.EventType,
+	}
+
+	// Every event is a new event, even if the type is the same.
+	if handle := entity.HandleEvent; handle != nil {
+		calls.add(func() { handle(message.EventType, received) })
+	}
+
+	entity.LastEventType = message.EventType
+	entity.LastFired = received
+	return event
+}
+
+// Fan device.
+type Fan struct {
+	Entity
+	Icon                string
+	SupportsOscillation bool
+	SupportsSpeed       bool
+	SupportsDirection   bool
+
+	// SpeedCount is the number of speed levels, nodes before API 1.4 (ESPHome 1.20) support the three legacy speeds.
+	SpeedCount int
+
+	// PresetModes are the supported preset modes.
+	PresetModes []string
+
+	// State of the fan.
+	State        FanState
+	StateIsValid bool
+
+	HandleState func(FanState)
+}
+
+// FanSpeed is the legacy speed of a fan, it was replaced by speed levels in API 1.4 (ESPHome 1.20).
+type FanSpeed int32
+
+// Fan speeds.
+const (
+	FanSpeedLow FanSpeed = iota
+	FanSpeedMedium
+	FanSpeedHigh
+)
+
+// FanDirection is the direction of a fan.
+type FanDirection int32
+
+// Fan directions.
+const (
+	FanDirectionForward FanDirection = iota
+	FanDirectionReverse
+)
+
+func (direction FanDirection) String() string {
+	switch direction {
+	case FanDirectionForward:
+		return "forward"
+	case FanDirectionReverse:
+		return "reverse"
+	default:
+		return "unknown"
+	}
+}
+
+// FanState represents the state of a fan.
+type FanState struct {
+	On          bool
+	Oscillating bool
+	Direction   FanDirection
+
+	// SpeedLevel is the speed between 1 and SpeedCount, Speed is the closest legacy speed.
+	SpeedLevel int
+	Speed      FanSpeed
+
+	// PresetMode is the active preset mode, empty if none.
+	PresetMode string
+}
+
+func newFan(client *Client, entity *api.ListEntitiesFanResponse) *Fan {
+	fan := &Fan{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:                entity.Icon,
+		SupportsOscillation: entity.SupportsOscillation,
+		SupportsSpeed:       entity.SupportsSpeed,
+		SupportsDirection:   entity.SupportsDirection,
+		SpeedCount:          int(entity.SupportedSpeedCount),
+		PresetModes:         entity.SupportedPresetModes,
+	}
+	if fan.SupportsSpeed && fan.SpeedCount == 0 {
+		// Nodes before API 1.4 don't report the speed count.
+		fan.SpeedCount = int(FanSpeedHigh) + 1
+	}
+	return fan
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Fan) rebind(listing *Fan) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.SupportsOscillation = listing.SupportsOscillation
+	entity.SupportsSpeed = listing.SupportsSpeed
+	entity.SupportsDirection = listing.SupportsDirection
+	entity.SpeedCount = listing.SpeedCount
+	entity.PresetModes = listing.PresetModes
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Fan) snapshot() Fan {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Fan) update(state *api.FanStateResponse, received time.Time, calls *callbacks) Event {
+	event := FanStateEvent{
+		event:    newEvent(KindFan, &entity.Entity, received),
+		Fan:      entity,
+		Previous: entity.State,
+		State: FanState{
+			On:          state.State,
+			Oscillating: state.Oscillating,
+			Direction:   FanDirection(state.Direction),
+			SpeedLevel:  int(state.SpeedLevel),
+			PresetMode:  state.PresetMode,
+		},
+	}
+	// The client lock is held during updates.
+	if entity.client.apiVersion.atLeast(1, 4) {
+		event.State.Speed = entity.legacySpeed(event.State.SpeedLevel)
+	} else {
+		event.State.Speed = FanSpeed(state.Speed)
+		event.State.SpeedLevel = int(state.Speed) + 1
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
+}
+
+// legacy checks if the node only supports the legacy speeds, which were replaced in API 1.4 (ESPHome 1.20).
+func (entity Fan) legacy() bool {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return !entity.client.apiVersion.atLeast(1, 4)
+}
+
+// legacySpeed returns the legacy speed closest to a speed level.
+func (entity Fan) legacySpeed(level int) FanSpeed {
+	if entity.SpeedCount <= 1 || level <= 1 {
+		return FanSpeedLow
+	}
+	// Round to the closest speed, the lowest level is low and the highest level is high.
+	steps := entity.SpeedCount - 1
+	return FanSpeed(((level-1)*int(FanSpeedHigh)*2 + steps) / (steps * 2))
+}
+
+// TurnOn turns the fan on.
+func (entity *Fan) TurnOn() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.TurnOnContext(ctx))
+}
+
+// TurnOnContext is like TurnOn with a context.
+func (entity *Fan) TurnOnContext(ctx context.Context) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.FanCommandRequest{
+		Key:      current.Key,
+		HasState: true,
+		State:    true,
+	})
+}
+
+// TurnOff turns the fan off.
+func (entity *Fan) TurnOff() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.TurnOffContext(ctx))
+}
+
+// TurnOffContext is like TurnOff with a context.
+func (entity *Fan) TurnOffContext(ctx context.Context) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.FanCommandRequest{
+		Key:      current.Key,
+		HasState: true,
+		State:    false,
+	})
+}
+
+// SetSpeed sets the fan to the speed level closest to a legacy speed.
+func (entity *Fan) SetSpeed(speed FanSpeed) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetSpeedContext(ctx, speed))
+}
+
+// SetSpeedContext is like SetSpeed with a context.
+func (entity *Fan) SetSpeedContext(ctx context.Context, speed FanSpeed) error {
+	current := entity.snapshot()
+	if speed < FanSpeedLow || speed > FanSpeedHigh {
+		return RangeError{
+			ObjectID: current.ObjectID,
+			Value:    float32(speed),
+			Min:      float32(FanSpeedLow),
+			Max:      float32(FanSpeedHigh),
+		}
+	}
+	level := 1
+	if current.SpeedCount > 1 {
+		// Round to the closest level, low is the lowest and high is the highest level.
+		steps := current.SpeedCount - 1
+		level = 1 + (int(speed)*steps*2+int(FanSpeedHigh))/(int(FanSpeedHigh)*2)
+	}
+	return current.SetSpeedLevelContext(ctx, level)
+}
+
+// SetSpeedLevel sets the speed between 1 and SpeedCount.
+func (entity *Fan) SetSpeedLevel(level int) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetSpeedLevelContext(ctx, level))
+}
+
+// SetSpeedLevelContext is like SetSpeedLevel with a context.
+func (entity *Fan) SetSpeedLevelContext(ctx context.Context, level int) error {
+	current := entity.snapshot()
+	if !current.SupportsSpeed {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "speed"}
+	}
+	if level < 1 || level > current.SpeedCount {
+		return RangeError{ObjectID: current.ObjectID, Value: float32(level), Min: 1, Max: float32(current.SpeedCount)}
+	}
+	request := &api.FanCommandRequest{
+		Key: current.Key,
+	}
+	if current.legacy() {
+		request.HasSpeed = true
+		request.Speed = api.FanSpeed(level - 1)
+	} else {
+		request.HasSpeedLevel = true
+		request.SpeedLevel = int32(level)
+	}
+	return current.client.sendContext(ctx, request)
+}
+
+// SetOscillating enables or disables oscillation.
+func (entity *Fan) SetOscillating(oscillating bool) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetOscillatingContext(ctx, oscillating))
+}
+
+// SetOscillatingContext is like SetOscillating with a context.
+func (entity *Fan) SetOscillatingContext(ctx context.Context, oscillating bool) error {
+	current := entity.snapshot()
+	if !current.SupportsOscillation {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "oscillation"}
+	}
+	return current.client.sendContext(ctx, &api.FanCommandRequest{
+		Key:            current.Key,
+		HasOscillating: true,
+		Oscillating:    oscillating,
+	})
+}
+
+// SetDirection sets the fan direction.
+func (entity *Fan) SetDirection(direction FanDirection) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetDirectionContext(ctx, direction))
+}
+
+// SetDirectionContext is like SetDirection with a context.
+func (entity *Fan) SetDirectionContext(ctx context.Context, direction FanDirection) error {
+	current := entity.snapshot()
+	if !current.SupportsDirection {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "direction"}
+	}
+	if direction != FanDirectionForward && direction != FanDirectionReverse {
+		return RangeError{
+			ObjectID: current.ObjectID,
+			Value:    float32(direction),
+			Min:      float32(FanDirectionForward),
+			Max:      float32(FanDirectionReverse),
+		}
+	}
+	return current.client.sendContext(ctx, &api.FanCommandRequest{
+		Key:          current.Key,
+		HasDirection: true,
+		Direction:    api.FanDirection(direction),
+	})
+}
+
+// SetPresetMode activates one of the preset modes.
+func (entity *Fan) SetPresetMode(mode string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetPresetModeContext(ctx, mode))
+}
+
+// SetPresetModeContext is like SetPresetMode with a context.
+func (entity *Fan) SetPresetModeContext(ctx context.Context, mode string) error {
+	current := entity.snapshot()
+	if !containsString(current.PresetModes, mode) {
+		return OptionError{ObjectID: current.ObjectID, Option: mode}
+	}
+	return current.client.sendContext(ctx, &api.FanCommandRequest{
+		Key:           current.Key,
+		HasPresetMode: true,
+		PresetMode:    mode,
+	})
+}
+
+// Light device.
+type Light struct {
+	Entity
+
+	Capabilities LightCapabilities
+	Effects      []string
+
+	// legacy is set for nodes that don't report color modes, they are controlled by the legacy capabilities.
+	legacy bool
+
+	State        LightState
+	StateIsValid bool
+
+	HandleState            func(on bool)
+	HandleBrightness       func(float32)
+	HandleColor            func(r, g, b, w float32)
+	HandleColorTemperature func(float32)
+	HandleEffect           func(string)
+}
+
+// LightCapabilities represents the capabilities of a Light.
+type LightCapabilities struct {
+	// ColorModes are the supported color modes. For nodes that don't report color modes, before ESPHome 1.20, it is
+	// the color mode matching the legacy capabilities.
+	ColorModes []LightColorMode
+
+	// Brightness, RGB, WhiteValue and ColorTemperature are the legacy capabilities, for nodes that report color
+	// modes they indicate if any of the color modes supports the capability.
+	Brightness       bool
+	RGB              bool
+	WhiteValue       bool
+	ColorTemperature bool
+	MinMired         float32
+	MaxMired         float32
+}
+
+// LightColorMode is a color mode of a light, it determines which values of the light are used.
+type LightColorMode int32
+
+// Light color mode capabilities, color modes are a combination of capabilities.
+const (
+	lightOnOff LightColorMode = 1 << iota
+	lightBrightness
+	lightWhite
+	lightColorTemperature
+	lightColdWarmWhite
+	lightRGB
+)
+
+// Light color modes.
+const (
+	LightColorModeUnknown             LightColorMode = 0
+	LightColorModeOnOff                              = lightOnOff
+	LightColorModeBrightness                         = lightOnOff | lightBrightness
+	LightColorModeWhite                              = lightOnOff | lightBrightness | lightWhite
+	LightColorModeColorTemperature                   = lightOnOff | lightBrightness | lightColorTemperature
+	LightColorModeColdWarmWhite                      = lightOnOff | lightBrightness | lightColdWarmWhite
+	LightColorModeRGB                                = lightOnOff | lightBrightness | lightRGB
+	LightColorModeRGBWhite                           = lightOnOff | lightBrightness | lightRGB | lightWhite
+	LightColorModeRGBColorTemperature                = LightColorModeRGBWhite | lightColorTemperature
+	LightColorModeRGBColdWarmWhite                   = lightOnOff | lightBrightness | lightRGB | lightColdWarmWhite
+)
+
+func (mode LightColorMode) String() string {
+	switch mode {
+	case LightColorModeUnknown:
+		return "unknown"
+	case LightColorModeOnOff:
+		return "on/off"
+	case LightColorModeBrightness:
+		return "brightness"
+	case LightColorModeWhite:
+		return "white"
+	case LightColorModeColorTemperature:
+		return "color temperature"
+	case LightColorModeColdWarmWhite:
+		return "cold/warm white"
+	case LightColorModeRGB:
+		return "RGB"
+	case LightColorModeRGBWhite:
+		return "RGBW"
+	case LightColorModeRGBColorTemperature:
+		return "RGB with color temperature"
+	case LightColorModeRGBColdWarmWhite:
+		return "RGBWW"
+	default:
+		return "invalid"
+	}
+}
+
+// has checks if the color mode has all capabilities.
+func (mode LightColorMode) has(capabilities LightColorMode) bool {
+	return mode != LightColorModeUnknown && mode&capabilities == capabilities
+}
+
+// supports checks if the values for the capabilities can be used in the color mode. Color modes with cold and warm
+// white channels also support color temperatures.
+func (mode LightColorMode) supports(capabilities LightColorMode) bool {
+	if capabilities&lightColorTemperature != 0 && mode.has(lightColdWarmWhite) {
+		capabilities = capabilities&^lightColorTemperature | lightColdWarmWhite
+	}
+	return mode.has(capabilities)
+}
+
+// features describes the capabilities, for errors.
+func (mode LightColorMode) features() string {
+	var features []string
+	for _, capability := range []struct {
+		capability LightColorMode
+		name       string
+	}{
+		{lightBrightness, "brightness"},
+		{lightWhite, "white"},
+		{lightColorTemperature, "color temperature"},
+		{lightColdWarmWhite, "cold/warm white"},
+		{lightRGB, "RGB"},
+	} {
+		if mode&capability.capability != 0 {
+			features = append(features, capability.name)
+		}
+	}
+	return strings.Join(features, " and ")
+}
+
+func containsLightColorMode(modes []LightColorMode, mode LightColorMode) bool {
+	for _, other := range modes {
+		if other == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// legacyLightColorMode returns the color mode matching the legacy capabilities.
+func legacyLightColorMode(entity *api.ListEntitiesLightResponse) LightColorMode {
+	switch {
+	case entity.LegacySupportsRgb && entity.LegacySupportsColorTemperature:
+		return LightColorModeRGBColorTemperature
+	case entity.LegacySupportsRgb && entity.LegacySupportsWhiteValue:
+		return LightColorModeRGBWhite
+	case entity.LegacySupportsRgb:
+		return LightColorModeRGB
+	case entity.LegacySupportsColorTemperature:
+		return LightColorModeColorTemperature
+	case entity.LegacySupportsWhiteValue:
+		return LightColorModeWhite
+	case entity.LegacySupportsBrightness:
+		return LightColorModeBrightness
+	default:
+		return LightColorModeOnOff
+	}
+}
+
+// LightState represents the state of a Light.
+type LightState struct {
+	On         bool
+	Brightness float32
+
+	// ColorMode is the current color mode, it is unknown for nodes that don't report color modes.
+	ColorMode LightColorMode
+
+	// ColorBrightness is the brightness of the RGB channels, relative to Brightness.
+	ColorBrightness         float32
+	Red, Green, Blue, White float32
+	ColorTemperature        float32
+	ColdWhite, WarmWhite    float32
+	Effect                  string
+}
+
+func newLight(client *Client, entity *api.ListEntitiesLightResponse) *Light {
+	effects := make([]string, len(entity.Effects))
+	copy(effects, entity.Effects)
+	light := &Light{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Capabilities: LightCapabilities{
+			Brightness:       entity.LegacySupportsBrightness,
+			RGB:              entity.LegacySupportsRgb,
+			WhiteValue:       entity.LegacySupportsWhiteValue,
+			ColorTemperature: entity.LegacySupportsColorTemperature,
+			MinMired:         entity.MinMireds,
+			MaxMired:         entity.MaxMireds,
+		},
+		Effects: effects,
+		legacy:  len(entity.SupportedColorModes) == 0,
+	}
+	if light.legacy {
+		light.Capabilities.ColorModes = []LightColorMode{legacyLightColorMode(entity)}
+		return light
+	}
+	for _, mode := range entity.SupportedColorModes {
+		mode := LightColorMode(mode)
+		light.Capabilities.ColorModes = append(light.Capabilities.ColorModes, mode)
+		light.Capabilities.Brightness = light.Capabilities.Brightness || mode.has(lightBrightness)
+		light.Capabilities.RGB = light.Capabilities.RGB || mode.has(lightRGB)
+		light.Capabilities.WhiteValue = light.Capabilities.WhiteValue || mode.has(lightWhite)
+		light.Capabilities.ColorTemperature = light.Capabilities.ColorTemperature || mode.has(lightColorTemperature)
+	}
+	return light
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Light) rebind(listing *Light) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Capabilities = listing.Capabilities
+	entity.Effects = listing.Effects
+	entity.legacy = listing.legacy
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Light) snapshot() Light {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Light) update(state *api.LightStateResponse, received time.Time, calls *callbacks) Event {
+	event := LightStateEvent{
+		event:    newEvent(KindLight, &entity.Entity, received),
+		Light:    entity,
+		Previous: entity.State,
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || entity.State.On != state.State) {
+		calls.add(func() { handle(state.State) })
+	}
+	if handle := entity.HandleBrightness; handle != nil {
+		if !entity.StateIsValid || !equal(entity.State.Brightness, state.Brightness) {
+			calls.add(func() { handle(state.Brightness) })
+		}
+	}
+	if handle := entity.HandleColor; handle != nil {
+		if !entity.StateIsValid ||
+			!equal(entity.State.Red, state.Red) ||
+			!equal(entity.State.Green, state.Green) ||
+			!equal(entity.State.Blue, state.Blue) ||
+			!equal(entity.State.White, state.White) {
+			calls.add(func() { handle(state.Red, state.Green, state.Blue, state.White) })
+		}
+	}
+	if handle := entity.HandleColorTemperature; handle != nil {
 		if !entity.StateIsValid || !equal(entity.State.ColorTemperature, state.ColorTemperature) {
-			entity.HandleColorTemperature(state.ColorTemperature)
+			calls.add(func() { handle(state.ColorTemperature) })
+		}
+	}
+	if handle := entity.HandleEffect; handle != nil {
+		if !entity.StateIsValid || entity.State.Effect != state.Effect {
+			calls.add(func() { handle(state.Effect) })
+		}
+	}
+
+	entity.State.On = state.State
+	entity.State.Brightness = state.Brightness
+	entity.State.ColorMode = LightColorMode(state.ColorMode)
+	entity.State.ColorBrightness = state.ColorBrightness
+	entity.State.Red = state.Red
+	entity.State.Green = state.Green
+	entity.State.Blue = state.Blue
+	entity.State.White = state.White
+	entity.State.ColorTemperature = state.ColorTemperature
+	entity.State.ColdWhite = state.ColdWhite
+	entity.State.WarmWhite = state.WarmWhite
+	entity.State.Effect = state.Effect
+	entity.StateIsValid = true
+
+	event.State = entity.State
+	return event
+}
+
+// SetBrightness sets the light's intensity (brightness).
+func (entity *Light) SetBrightness(value float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetBrightnessContext(ctx, value))
+}
+
+// SetBrightnessContext is like SetBrightness with a context.
+func (entity *Light) SetBrightnessContext(ctx context.Context, value float32) error {
+	return entity.Command().Brightness(value).ApplyContext(ctx)
+}
+
+// SetColor sets the light's red, green and blue values, see LightCommand.Color for the alpha value.
+func (entity *Light) SetColor(value color.Color) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetColorContext(ctx, value))
+}
+
+// SetColorContext is like SetColor with a context.
+func (entity *Light) SetColorContext(ctx context.Context, value color.Color) error {
+	return entity.Command().Color(value).ApplyContext(ctx)
+}
+
+// SetWhite sets the light's white value.
+func (entity *Light) SetWhite(value float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetWhiteContext(ctx, value))
+}
+
+// SetWhiteContext is like SetWhite with a context.
+func (entity *Light) SetWhiteContext(ctx context.Context, value float32) error {
+	return entity.Command().White(value).ApplyContext(ctx)
+}
+
+// SetEffect selects one of the effects of the light, or LightEffectNone to stop the current effect.
+func (entity *Light) SetEffect(effect string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetEffectContext(ctx, effect))
+}
+
+// SetEffectContext is like SetEffect with a context.
+func (entity *Light) SetEffectContext(ctx context.Context, effect string) error {
+	return entity.Command().Effect(effect).ApplyContext(ctx)
+}
+
+// SetState turns the light on or off.
+func (entity *Light) SetState(on bool) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetStateContext(ctx, on))
+}
+
+// SetStateContext is like SetState with a context.
+func (entity *Light) SetStateContext(ctx context.Context, on bool) error {
+	return entity.Command().State(on).ApplyContext(ctx)
+}
+
+// LightEffectNone is the effect that stops the current effect.
+const LightEffectNone = "None"
+
+// LightCommand is a light command, see Light.Command. Only the values that are set are sent, the other values of the
+// light are left unchanged.
+//
+// Unless ColorMode is set, Apply switches to a color mode that supports all values if the current color mode
+// doesn't, preferring the color mode with the fewest capabilities. Nodes that don't report color modes are controlled
+// with the legacy capabilities.
+//
+// Invalid values are reported by Apply, in which case no command is sent.
+type LightCommand struct {
+	light    Light
+	request  api.LightCommandRequest
+	required LightColorMode
+	err      error
+}
+
+// Command returns a new command for the light.
+//
+// For example, to turn the light on at half brightness, fading in over a second:
+//
+//	err := light.Command().State(true).Brightness(.5).Transition(time.Second).Apply()
+func (entity *Light) Command() *LightCommand {
+	current := entity.snapshot()
+	return &LightCommand{
+		light:   current,
+		request: api.LightCommandRequest{Key: current.Key},
+	}
+}
+
+// fail records the first error.
+func (command *LightCommand) fail(err error) {
+	if command.err == nil {
+		command.err = err
+	}
+}
+
+// check checks if value is between 0.0 and 1.0.
+func (command *LightCommand) check(value float32) bool {
+	if value < 0 || value > 1 {
+		command.fail(RangeError{ObjectID: command.light.ObjectID, Value: value, Min: 0, Max: 1})
+		return false
+	}
+	return true
+}
+
+// duration converts a duration to milliseconds.
+func (command *LightCommand) duration(length time.Duration) (uint32, bool) {
+	if ms := length.Milliseconds(); ms >= 0 && ms <= math.MaxUint32 {
+		return uint32(ms), true
+	}
+	command.fail(RangeError{
+		ObjectID: command.light.ObjectID,
+		Value:    float32(length.Seconds()),
+		Min:      0,
+		Max:      math.MaxUint32 / 1000,
+	})
+	return 0, false
+}
+
+// State turns the light on or off.
+func (command *LightCommand) State(on bool) *LightCommand {
+	command.request.HasState = true
+	command.request.State = on
+	return command
+}
+
+// ColorMode sets the color mode, it must be one of the color modes of the light.
+func (command *LightCommand) ColorMode(mode LightColorMode) *LightCommand {
+	if !containsLightColorMode(command.light.Capabilities.ColorModes, mode) {
+		command.fail(UnsupportedError{ObjectID: command.light.ObjectID, Feature: "color mode " + mode.String()})
+		return command
+	}
+	command.request.HasColorMode = true
+	command.request.ColorMode = api.ColorMode(mode)
+	return command
+}
+
+// Brightness sets the intensity between 0.0 and 1.0.
+func (command *LightCommand) Brightness(value float32) *LightCommand {
+	if command.check(value) {
+		command.required |= lightBrightness
+		command.request.HasBrightness = true
+		command.request.Brightness = value
+	}
+	return command
+}
+
+// ColorBrightness sets the brightness of the RGB channels between 0.0 and 1.0, relative to the brightness.
+func (command *LightCommand) ColorBrightness(value float32) *LightCommand {
+	if command.check(value) {
+		command.required |= lightRGB
+		command.request.HasColorBrightness = true
+		command.request.ColorBrightness = value
+	}
+	return command
+}
+
+// Color sets the red, green and blue values. The alpha value of non-premultiplied colors (color.NRGBA and
+// color.NRGBA64) is ignored; other colors are premultiplied by alpha, they are converted to the non-premultiplied
+// color so a fully transparent color is black.
+func (command *LightCommand) Color(value color.Color) *LightCommand {
+	var rgb color.NRGBA64
+	switch value := value.(type) {
+	case color.NRGBA:
+		rgb = color.NRGBA64{R: uint16(value.R) * 0x101, G: uint16(value.G) * 0x101, B: uint16(value.B) * 0x101}
+	case color.NRGBA64:
+		rgb = value
+	default:
+		rgb = color.NRGBA64Model.Convert(value).(color.NRGBA64)
+	}
+	command.required |= lightRGB
+	command.request.HasRgb = true
+	command.request.Red = float32(rgb.R) / 0xffff
+	command.request.Green = float32(rgb.G) / 0xffff
+	command.request.Blue = float32(rgb.B) / 0xffff
+	return command
+}
+
+// White sets the white value between 0.0 and 1.0.
+func (command *LightCommand) White(value float32) *LightCommand {
+	if command.check(value) {
+		command.required |= lightWhite
+		command.request.HasWhite = true
+		command.request.White = value
+	}
+	return command
+}
+
+// ColorTemperature sets the color temperature in mireds, clamped to the MinMired and MaxMired capabilities.
+func (command *LightCommand) ColorTemperature(mireds float32) *LightCommand {
+	capabilities := command.light.Capabilities
+	if capabilities.MinMired > 0 && mireds < capabilities.MinMired {
+		mireds = capabilities.MinMired
+	}
+	if capabilities.MaxMired > 0 && mireds > capabilities.MaxMired {
+		mireds = capabilities.MaxMired
+	}
+	command.required |= lightColorTemperature
+	command.request.HasColorTemperature = true
+	command.request.ColorTemperature = mireds
+	return command
+}
+
+// ColdWhite sets the cold white value between 0.0 and 1.0, for lights with separate cold and warm white channels.
+func (command *LightCommand) ColdWhite(value float32) *LightCommand {
+	if command.check(value) {
+		command.required |= lightColdWarmWhite
+		command.request.HasColdWhite = true
+		command.request.ColdWhite = value
+	}
+	return command
+}
+
+// WarmWhite sets the warm white value between 0.0 and 1.0, for lights with separate cold and warm white channels.
+func (command *LightCommand) WarmWhite(value float32) *LightCommand {
+	if command.check(value) {
+		command.required |= lightColdWarmWhite
+		command.request.HasWarmWhite = true
+		command.request.WarmWhite = value
+	}
+	return command
+}
+
+// Kelvin sets the color temperature in Kelvin, see ColorTemperature.
+func (command *LightCommand) Kelvin(kelvin float32) *LightCommand {
+	if kelvin <= 0 {
+		command.fail(RangeError{ObjectID: command.light.ObjectID, Value: kelvin, Min: 0, Max: math.MaxFloat32})
+		return command
+	}
+	return command.ColorTemperature(1e6 / kelvin)
+}
+
+// Transition sets the time the light takes to transition to the new values, with a precision of one millisecond.
+func (command *LightCommand) Transition(length time.Duration) *LightCommand {
+	if ms, ok := command.duration(length); ok {
+		command.request.HasTransitionLength = true
+		command.request.TransitionLength = ms
+	}
+	return command
+}
+
+// Flash flashes the light with the new values for the duration, after which the light returns to its previous
+// values.
+func (command *LightCommand) Flash(length time.Duration) *LightCommand {
+	if ms, ok := command.duration(length); ok {
+		command.request.HasFlashLength = true
+		command.request.FlashLength = ms
+	}
+	return command
+}
+
+// Effect selects one of the effects of the light, or LightEffectNone to stop the current effect.
+func (command *LightCommand) Effect(effect string) *LightCommand {
+	if effect != LightEffectNone && !containsString(command.light.Effects, effect) {
+		command.fail(OptionError{ObjectID: command.light.ObjectID, Option: effect})
+		return command
+	}
+	command.request.HasEffect = true
+	command.request.Effect = effect
+	return command
+}
+
+// Apply sends the command, or returns the first error if any value is invalid.
+func (command *LightCommand) Apply() error {
+	ctx, cancel := command.light.client.context()
+	defer cancel()
+	return timeoutError(command.ApplyContext(ctx))
+}
+
+// ApplyContext is like Apply with a context.
+func (command *LightCommand) ApplyContext(ctx context.Context) error {
+	if command.err != nil {
+		return command.err
+	}
+	request := command.request
+	if err := command.light.colorMode(&request, command.required); err != nil {
+		return err
+	}
+	return command.light.client.sendContext(ctx, &request)
+}
+
+// colorMode sets the color mode of the request, if the color mode of the request or the current color mode doesn't
+// have the required capabilities.
+func (entity Light) colorMode(request *api.LightCommandRequest, required LightColorMode) error {
+	if entity.legacy && request.HasColorBrightness {
+		return UnsupportedError{ObjectID: entity.ObjectID, Feature: "color brightness"}
+	}
+
+	if request.HasColorMode {
+		if !LightColorMode(request.ColorMode).supports(required) {
+			return UnsupportedError{ObjectID: entity.ObjectID, Feature: required.features()}
+		}
+	} else if required != 0 && !entity.State.ColorMode.supports(required) {
+		var best LightColorMode
+		for _, mode := range entity.Capabilities.ColorModes {
+			fewer := best == LightColorModeUnknown || bits.OnesCount32(uint32(mode)) < bits.OnesCount32(uint32(best))
+			if mode.supports(required) && fewer {
+				best = mode
+			}
 		}
+		if best == LightColorModeUnknown {
+			return UnsupportedError{ObjectID: entity.ObjectID, Feature: required.features()}
+		}
+		request.HasColorMode = true
+		request.ColorMode = api.ColorMode(best)
 	}
-	if entity.HandleEffect != nil {
-		if !entity.StateIsValid || entity.State.Effect != state.Effect {
-			entity.HandleEffect(state.Effect)
+
+	if entity.legacy {
+		// Nodes that don't report color modes don't support setting the color mode either.
+		request.HasColorMode = false
+		request.ColorMode = api.ColorMode_COLOR_MODE_UNKNOWN
+	}
+	return nil
+}
+
+// Lock device, such as a door lock.
+type Lock struct {
+	Entity
+	Icon         string
+	AssumedState bool
+	SupportsOpen bool
+
+	// RequiresCode indicates a code is required to operate the lock, CodeFormat is a regular expression matching valid
+	// codes, if any.
+	RequiresCode bool
+	CodeFormat   string
+
+	// State of the lock.
+	State        LockState
+	StateIsValid bool
+
+	HandleState func(LockState)
+}
+
+// LockState is the state of a lock.
+type LockState int32
+
+// Lock states.
+const (
+	LockStateNone LockState = iota
+	LockStateLocked
+	LockStateUnlocked
+	LockStateJammed
+	LockStateLocking
+	LockStateUnlocking
+)
+
+func (state LockState) String() string {
+	switch state {
+	case LockStateNone:
+		return "none"
+	case LockStateLocked:
+		return "locked"
+	case LockStateUnlocked:
+		return "unlocked"
+	case LockStateJammed:
+		return "jammed"
+	case LockStateLocking:
+		return "locking"
+	case LockStateUnlocking:
+		return "unlocking"
+	default:
+		return "unknown"
+	}
+}
+
+func newLock(client *Client, entity *api.ListEntitiesLockResponse) *Lock {
+	return &Lock{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:         entity.Icon,
+		AssumedState: entity.AssumedState,
+		SupportsOpen: entity.SupportsOpen,
+		RequiresCode: entity.RequiresCode,
+		CodeFormat:   entity.CodeFormat,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Lock) rebind(listing *Lock) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.AssumedState = listing.AssumedState
+	entity.SupportsOpen = listing.SupportsOpen
+	entity.RequiresCode = listing.RequiresCode
+	entity.CodeFormat = listing.CodeFormat
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Lock) snapshot() Lock {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Lock) update(state *api.LockStateResponse, received time.Time, calls *callbacks) Event {
+	event := LockStateEvent{
+		event:           newEvent(KindLock, &entity.Entity, received),
+		Lock:            entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           LockState(state.State),
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
+}
+
+// command sends a lock command, after validating the code.
+func (entity Lock) command(ctx context.Context, command api.LockCommand, code string) error {
+	if err := checkCode(entity.ObjectID, entity.RequiresCode, entity.CodeFormat, code); err != nil {
+		return err
+	}
+	return entity.client.sendContext(ctx, &api.LockCommandRequest{
+		Key:     entity.Key,
+		Command: command,
+		HasCode: code != "",
+		Code:    code,
+	})
+}
+
+// Lock the lock. The code may be empty if the lock doesn't require a code.
+func (entity *Lock) Lock(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.LockContext(ctx, code))
+}
+
+// LockContext is like Lock with a context.
+func (entity *Lock) LockContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.LockCommand_LOCK_LOCK, code)
+}
+
+// Unlock the lock. The code may be empty if the lock doesn't require a code.
+func (entity *Lock) Unlock(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.UnlockContext(ctx, code))
+}
+
+// UnlockContext is like Unlock with a context.
+func (entity *Lock) UnlockContext(ctx context.Context, code string) error {
+	return entity.snapshot().command(ctx, api.LockCommand_LOCK_UNLOCK, code)
+}
+
+// Open unlatches the lock, such as opening the door. The code may be empty if the lock doesn't require a code.
+func (entity *Lock) Open(code string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.OpenContext(ctx, code))
+}
+
+// OpenContext is like Open with a context.
+func (entity *Lock) OpenContext(ctx context.Context, code string) error {
+	current := entity.snapshot()
+	if !current.SupportsOpen {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "open"}
+	}
+	return current.command(ctx, api.LockCommand_LOCK_OPEN, code)
+}
+
+// checkCode validates a code, format is a regular expression that must match the whole code.
+func checkCode(objectID string, required bool, format, code string) error {
+	if code == "" {
+		if required {
+			return CodeError{ObjectID: objectID}
 		}
+		return nil
+	}
+	if format == "" {
+		return nil
 	}
+	if ok, err := match(objectID, format, code); err != nil {
+		return err
+	} else if !ok {
+		return CodeError{ObjectID: objectID, Format: format}
+	}
+	return nil
+}
 
-	entity.State.On = state.State
-	entity.State.Brightness = state.Brightness
-	entity.State.Red = state.Red
-	entity.State.Green = state.Green
-	entity.State.Blue = state.Blue
-	entity.State.White = state.White
-	entity.State.ColorTemperature = state.ColorTemperature
-	entity.State.Effect = state.Effect
+// match reports if the pattern, a regular expression, matches the whole value.
+func match(objectID, pattern, value string) (bool, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return false, fmt.Errorf("esphome: %s has an invalid pattern: %v", objectID, err)
+	}
+	return re.MatchString(value), nil
+}
+
+// MediaPlayer device, such as a speaker.
+type MediaPlayer struct {
+	Entity
+	Icon          string
+	SupportsPause bool
+
+	// Formats are the audio formats supported by the media player.
+	Formats []MediaPlayerFormat
+
+	// State of the media player.
+	State        MediaPlayerState
+	StateIsValid bool
+
+	HandleState func(MediaPlayerState)
+}
+
+// MediaPlayerFormat is an audio format supported by a media player.
+type MediaPlayerFormat struct {
+	Format     string
+	SampleRate uint32
+	Channels   uint32
+
+	// Announcement indicates the format is used for announcements.
+	Announcement bool
+}
+
+// MediaPlayerPlayback is the playback state of a media player.
+type MediaPlayerPlayback int32
+
+// Media player playback states.
+const (
+	MediaPlayerNone MediaPlayerPlayback = iota
+	MediaPlayerIdle
+	MediaPlayerPlaying
+	MediaPlayerPaused
+)
+
+func (playback MediaPlayerPlayback) String() string {
+	switch playback {
+	case MediaPlayerNone:
+		return "none"
+	case MediaPlayerIdle:
+		return "idle"
+	case MediaPlayerPlaying:
+		return "playing"
+	case MediaPlayerPaused:
+		return "paused"
+	default:
+		return "unknown"
+	}
+}
+
+// MediaPlayerState represents the state of a media player.
+type MediaPlayerState struct {
+	Playback MediaPlayerPlayback
+
+	// Volume between 0.0 and 1.0.
+	Volume float32
+	Muted  bool
+}
+
+func newMediaPlayer(client *Client, entity *api.ListEntitiesMediaPlayerResponse) *MediaPlayer {
+	formats := make([]MediaPlayerFormat, len(entity.SupportedFormats))
+	for i, format := range entity.SupportedFormats {
+		formats[i] = MediaPlayerFormat{
+			Format:       format.Format,
+			SampleRate:   format.SampleRate,
+			Channels:     format.NumChannels,
+			Announcement: format.Purpose == api.MediaPlayerFormatPurpose_MEDIA_PLAYER_FORMAT_PURPOSE_ANNOUNCEMENT,
+		}
+	}
+	return &MediaPlayer{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:          entity.Icon,
+		SupportsPause: entity.SupportsPause,
+		Formats:       formats,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *MediaPlayer) rebind(listing *MediaPlayer) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.SupportsPause = listing.SupportsPause
+	entity.Formats = listing.Formats
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *MediaPlayer) snapshot() MediaPlayer {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *MediaPlayer) update(state *api.MediaPlayerStateResponse, received time.Time, calls *callbacks) Event {
+	event := MediaPlayerStateEvent{
+		event:       newEvent(KindMediaPlayer, &entity.Entity, received),
+		MediaPlayer: entity,
+		Previous:    entity.State,
+		State: MediaPlayerState{
+			Playback: MediaPlayerPlayback(state.State),
+			Volume:   state.Volume,
+			Muted:    state.Muted,
+		},
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
 	entity.StateIsValid = true
+	return event
 }
 
-// SetBrightness sets the light's intensity (brightness).
-func (entity Light) SetBrightness(value float32) error {
-	request := entity.commandRequest()
-	request.Brightness = value
-	return entity.client.sendTimeout(request, entity.client.Timeout)
+func (entity MediaPlayer) command(ctx context.Context, command api.MediaPlayerCommand) error {
+	return entity.client.sendContext(ctx, &api.MediaPlayerCommandRequest{
+		Key:        entity.Key,
+		HasCommand: true,
+		Command:    command,
+	})
 }
 
-// SetColor sets the light's red, green and blue values.
-func (entity Light) SetColor(value color.Color) error {
-	r, g, b, _ := value.RGBA()
-	request := entity.commandRequest()
-	request.Red = float32(r>>4) / 256.0
-	request.Green = float32(g>>4) / 256.0
-	request.Blue = float32(b>>4) / 256.0
-	return entity.client.sendTimeout(request, entity.client.Timeout)
+// Play resumes playback.
+func (entity *MediaPlayer) Play() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.PlayContext(ctx))
 }
 
-// SetWhite sets the light's white value.
-func (entity Light) SetWhite(value float32) error {
-	request := entity.commandRequest()
-	request.White = value
-	return entity.client.sendTimeout(request, entity.client.Timeout)
+// PlayContext is like Play with a context.
+func (entity *MediaPlayer) PlayContext(ctx context.Context) error {
+	return entity.snapshot().command(ctx, api.MediaPlayerCommand_MEDIA_PLAYER_COMMAND_PLAY)
 }
 
-// SetEffect selects a preconfigured effect.
-func (entity Light) SetEffect(effect string) error {
-	request := entity.commandRequest()
-	request.Effect = effect
-	return entity.client.sendTimeout(request, entity.client.Timeout)
+// Pause playback.
+func (entity *MediaPlayer) Pause() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.PauseContext(ctx))
 }
 
-// SetState turns the light on or off.
-func (entity Light) SetState(on bool) error {
-	request := entity.commandRequest()
-	request.State = on
-	return entity.client.sendTimeout(request, entity.client.Timeout)
+// PauseContext is like Pause with a context.
+func (entity *MediaPlayer) PauseContext(ctx context.Context) error {
+	current := entity.snapshot()
+	if !current.SupportsPause {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "pause"}
+	}
+	return current.command(ctx, api.MediaPlayerCommand_MEDIA_PLAYER_COMMAND_PAUSE)
+}
+
+// Stop playback.
+func (entity *MediaPlayer) Stop() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.StopContext(ctx))
+}
+
+// StopContext is like Stop with a context.
+func (entity *MediaPlayer) StopContext(ctx context.Context) error {
+	return entity.snapshot().command(ctx, api.MediaPlayerCommand_MEDIA_PLAYER_COMMAND_STOP)
+}
+
+// Mute the media player.
+func (entity *MediaPlayer) Mute() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.MuteContext(ctx))
+}
+
+// MuteContext is like Mute with a context.
+func (entity *MediaPlayer) MuteContext(ctx context.Context) error {
+	return entity.snapshot().command(ctx, api.MediaPlayerCommand_MEDIA_PLAYER_COMMAND_MUTE)
+}
+
+// Unmute the media player.
+func (entity *MediaPlayer) Unmute() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.UnmuteContext(ctx))
+}
+
+// UnmuteContext is like Unmute with a context.
+func (entity *MediaPlayer) UnmuteContext(ctx context.Context) error {
+	return entity.snapshot().command(ctx, api.MediaPlayerCommand_MEDIA_PLAYER_COMMAND_UNMUTE)
+}
+
+// SetVolume sets the volume between 0.0 and 1.0.
+func (entity *MediaPlayer) SetVolume(volume float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetVolumeContext(ctx, volume))
+}
+
+// SetVolumeContext is like SetVolume with a context.
+func (entity *MediaPlayer) SetVolumeContext(ctx context.Context, volume float32) error {
+	current := entity.snapshot()
+	if !(volume >= 0 && volume <= 1) {
+		return RangeError{ObjectID: current.ObjectID, Value: volume, Min: 0, Max: 1}
+	}
+	return current.client.sendContext(ctx, &api.MediaPlayerCommandRequest{
+		Key:       current.Key,
+		HasVolume: true,
+		Volume:    volume,
+	})
+}
+
+// PlayMedia plays the media at the URL. Announcements interrupt the current media, which resumes after the
+// announcement.
+func (entity *MediaPlayer) PlayMedia(url string, announcement bool) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.PlayMediaContext(ctx, url, announcement))
+}
+
+// PlayMediaContext is like PlayMedia with a context.
+func (entity *MediaPlayer) PlayMediaContext(ctx context.Context, url string, announcement bool) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.MediaPlayerCommandRequest{
+		Key:             current.Key,
+		HasMediaUrl:     true,
+		MediaUrl:        url,
+		HasAnnouncement: announcement,
+		Announcement:    announcement,
+	})
+}
+
+// Number is a numeric value that can be set, such as a setpoint.
+type Number struct {
+	Entity
+	Icon              string
+	UnitOfMeasurement string
+	DeviceClass       string
+
+	// Min, Max and Step describe the valid values, Step is the difference between consecutive values starting at
+	// Min.
+	Min, Max, Step float32
+
+	// Mode hints how the number should be shown.
+	Mode NumberMode
+
+	State        float32
+	StateIsValid bool
+
+	HandleState func(float32)
+}
+
+// NumberMode is how a number should be shown.
+type NumberMode int32
+
+// Number modes.
+const (
+	NumberModeAuto NumberMode = iota
+	NumberModeBox
+	NumberModeSlider
+)
+
+func (mode NumberMode) String() string {
+	switch mode {
+	case NumberModeAuto:
+		return "auto"
+	case NumberModeBox:
+		return "box"
+	case NumberModeSlider:
+		return "slider"
+	default:
+		return "unknown"
+	}
+}
+
+func newNumber(client *Client, entity *api.ListEntitiesNumberResponse) *Number {
+	return &Number{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:              entity.Icon,
+		UnitOfMeasurement: entity.UnitOfMeasurement,
+		DeviceClass:       entity.DeviceClass,
+		Min:               entity.MinValue,
+		Max:               entity.MaxValue,
+		Step:              entity.Step,
+		Mode:              NumberMode(entity.Mode),
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Number) rebind(listing *Number) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.UnitOfMeasurement = listing.UnitOfMeasurement
+	entity.DeviceClass = listing.DeviceClass
+	entity.Min = listing.Min
+	entity.Max = listing.Max
+	entity.Step = listing.Step
+	entity.Mode = listing.Mode
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Number) snapshot() Number {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Number) update(state *api.NumberStateResponse, received time.Time, calls *callbacks) Event {
+	event := NumberStateEvent{
+		event:           newEvent(KindNumber, &entity.Entity, received),
+		Number:          entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           state.State,
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || !equal(entity.State, state.State)) {
+		calls.add(func() { handle(state.State) })
+	}
+
+	entity.State = state.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// checkValue validates a value against the range and step of the number.
+func (entity Number) checkValue(value float32) error {
+	if math.IsNaN(float64(value)) || (entity.Max > entity.Min && (value < entity.Min || value > entity.Max)) {
+		return RangeError{ObjectID: entity.ObjectID, Value: value, Min: entity.Min, Max: entity.Max}
+	}
+	if entity.Step > 0 {
+		// Allow for some rounding error in the float32 values.
+		steps := float64((value - entity.Min) / entity.Step)
+		if math.Abs(steps-math.Round(steps)) > 1e-3 {
+			return StepError{ObjectID: entity.ObjectID, Value: value, Step: entity.Step}
+		}
+	}
+	return nil
+}
+
+// Clamp returns the valid value closest to value, it is limited to the range and rounded to the step of the number.
+func (entity *Number) Clamp(value float32) float32 {
+	current := entity.snapshot()
+	if current.Step > 0 {
+		value = current.Min + float32(math.Round(float64((value-current.Min)/current.Step)))*current.Step
+	}
+	if current.Max > current.Min {
+		if value < current.Min {
+			value = current.Min
+		} else if value > current.Max {
+			value = current.Max
+		}
+	}
+	return value
+}
+
+// SetValue sets the number. The value must be in the range and a multiple of the step of the number, use Clamp to
+// obtain a valid value.
+func (entity *Number) SetValue(value float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetValueContext(ctx, value))
+}
+
+// SetValueContext is like SetValue with a context.
+func (entity *Number) SetValueContext(ctx context.Context, value float32) error {
+	current := entity.snapshot()
+	if err := current.checkValue(value); err != nil {
+		return err
+	}
+	return current.client.sendContext(ctx, &api.NumberCommandRequest{
+		Key:   current.Key,
+		State: value,
+	})
+}
+
+// Select is a choice between a list of options.
+type Select struct {
+	Entity
+	Icon    string
+	Options []string
+
+	// State is the selected option.
+	State        string
+	StateIsValid bool
+
+	HandleState func(string)
+}
+
+func newSelect(client *Client, entity *api.ListEntitiesSelectResponse) *Select {
+	return &Select{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:    entity.Icon,
+		Options: entity.Options,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Select) rebind(listing *Select) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.Options = listing.Options
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Select) snapshot() Select {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Select) update(state *api.SelectStateResponse, received time.Time, calls *callbacks) Event {
+	event := SelectStateEvent{
+		event:           newEvent(KindSelect, &entity.Entity, received),
+		Select:          entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           state.State,
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != state.State) {
+		calls.add(func() { handle(state.State) })
+	}
+
+	entity.State = state.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// SetOption selects an option, it must be one of the Options.
+func (entity *Select) SetOption(option string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetOptionContext(ctx, option))
+}
+
+// SetOptionContext is like SetOption with a context.
+func (entity *Select) SetOptionContext(ctx context.Context, option string) error {
+	current := entity.snapshot()
+	if !containsString(current.Options, option) {
+		return OptionError{ObjectID: current.ObjectID, Option: option}
+	}
+	return current.client.sendContext(ctx, &api.SelectCommandRequest{
+		Key:   current.Key,
+		State: option,
+	})
 }
 
 // Sensor probes.
@@ -374,13 +2980,167 @@ func newSensor(client *Client, entity *api.ListEntitiesSensorResponse) *Sensor {
 	}
 }
 
-func (entity *Sensor) update(state *api.SensorStateResponse) {
-	if !state.MissingState && entity.HandleState != nil && !equal(entity.State, state.State) {
-		entity.HandleState(state.State)
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Sensor) rebind(listing *Sensor) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.UnitOfMeasurement = listing.UnitOfMeasurement
+	entity.AccuracyDecimals = listing.AccuracyDecimals
+	entity.ForceUpdate = listing.ForceUpdate
+}
+
+func (entity *Sensor) update(state *api.SensorStateResponse, received time.Time, calls *callbacks) Event {
+	event := SensorStateEvent{
+		event:           newEvent(KindSensor, &entity.Entity, received),
+		Sensor:          entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           state.State,
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; !state.MissingState && handle != nil && !equal(entity.State, state.State) {
+		calls.add(func() { handle(state.State) })
 	}
 
 	entity.State = state.State
 	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// Siren device, such as an alarm siren or a buzzer.
+type Siren struct {
+	Entity
+	Icon             string
+	Tones            []string
+	SupportsDuration bool
+	SupportsVolume   bool
+
+	// State of the siren.
+	State        bool
+	StateIsValid bool
+
+	HandleState func(bool)
+}
+
+// SirenOptions are the options for turning a siren on, zero values use the defaults of the node.
+type SirenOptions struct {
+	// Tone is one of the tones of the siren.
+	Tone string
+
+	// Duration the siren sounds, rounded up to whole seconds.
+	Duration time.Duration
+
+	// Volume between 0.0 and 1.0.
+	Volume float32
+}
+
+func newSiren(client *Client, entity *api.ListEntitiesSirenResponse) *Siren {
+	return &Siren{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:             entity.Icon,
+		Tones:            entity.Tones,
+		SupportsDuration: entity.SupportsDuration,
+		SupportsVolume:   entity.SupportsVolume,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Siren) rebind(listing *Siren) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.Tones = listing.Tones
+	entity.SupportsDuration = listing.SupportsDuration
+	entity.SupportsVolume = listing.SupportsVolume
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Siren) snapshot() Siren {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Siren) update(state *api.SirenStateResponse, received time.Time, calls *callbacks) Event {
+	event := SirenStateEvent{
+		event:    newEvent(KindSiren, &entity.Entity, received),
+		Siren:    entity,
+		Previous: entity.State,
+		State:    state.State,
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
+}
+
+// SetState turns the siren on or off, using the default tone, duration and volume.
+func (entity *Siren) SetState(on bool) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetStateContext(ctx, on))
+}
+
+// SetStateContext is like SetState with a context.
+func (entity *Siren) SetStateContext(ctx context.Context, on bool) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.SirenCommandRequest{
+		Key:      current.Key,
+		HasState: true,
+		State:    on,
+	})
+}
+
+// TurnOn turns the siren on with options.
+func (entity *Siren) TurnOn(options SirenOptions) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.TurnOnContext(ctx, options))
+}
+
+// TurnOnContext is like TurnOn with a context.
+func (entity *Siren) TurnOnContext(ctx context.Context, options SirenOptions) error {
+	current := entity.snapshot()
+	request := &api.SirenCommandRequest{
+		Key:      current.Key,
+		HasState: true,
+		State:    true,
+	}
+	if options.Tone != "" {
+		if !containsString(current.Tones, options.Tone) {
+			return OptionError{ObjectID: current.ObjectID, Option: options.Tone}
+		}
+		request.HasTone = true
+		request.Tone = options.Tone
+	}
+	if options.Duration != 0 {
+		if !current.SupportsDuration {
+			return UnsupportedError{ObjectID: current.ObjectID, Feature: "duration"}
+		}
+		request.HasDuration = true
+		request.Duration = uint32((options.Duration + time.Second - 1) / time.Second)
+	}
+	if options.Volume != 0 {
+		if !current.SupportsVolume {
+			return UnsupportedError{ObjectID: current.ObjectID, Feature: "volume"}
+		}
+		if options.Volume < 0 || options.Volume > 1 {
+			return RangeError{ObjectID: current.ObjectID, Value: options.Volume, Min: 0, Max: 1}
+		}
+		request.HasVolume = true
+		request.Volume = options.Volume
+	}
+	return current.client.sendContext(ctx, request)
 }
 
 // Switch includes all platforms that should show up like a switch and can only be turned ON or OFF.
@@ -407,13 +3167,35 @@ func newSwitch(client *Client, entity *api.ListEntitiesSwitchResponse) *Switch {
 	}
 }
 
-func (entity *Switch) update(state *api.SwitchStateResponse) {
-	if entity.HandleState != nil {
-		entity.HandleState(state.State)
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Switch) rebind(listing *Switch) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.AssumedState = listing.AssumedState
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Switch) snapshot() Switch {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Switch) update(state *api.SwitchStateResponse, received time.Time, calls *callbacks) Event {
+	event := SwitchStateEvent{
+		event:    newEvent(KindSwitch, &entity.Entity, received),
+		Switch:   entity,
+		Previous: entity.State,
+		State:    state.State,
+	}
+
+	if handle := entity.HandleState; handle != nil {
+		calls.add(func() { handle(state.State) })
 	}
 
 	entity.State = state.State
 	entity.AssumedState = false
+	return event
 }
 
 func (entity Switch) commandRequest() *api.SwitchCommandRequest {
@@ -424,10 +3206,137 @@ func (entity Switch) commandRequest() *api.SwitchCommandRequest {
 }
 
 // SetState updates the switch state.
-func (entity Switch) SetState(on bool) error {
-	request := entity.commandRequest()
+func (entity *Switch) SetState(on bool) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetStateContext(ctx, on))
+}
+
+// SetStateContext is like SetState with a context.
+func (entity *Switch) SetStateContext(ctx context.Context, on bool) error {
+	current := entity.snapshot()
+	request := current.commandRequest()
 	request.State = on
-	return entity.client.sendTimeout(request, entity.client.Timeout)
+	return current.client.sendContext(ctx, request)
+}
+
+// Text is a text value that can be set, such as a message shown on a display.
+type Text struct {
+	Entity
+	Icon string
+
+	// MinLength and MaxLength are the valid lengths in bytes, a MaxLength of 0 is unbounded. Pattern is a regular
+	// expression matching valid values, if any.
+	MinLength, MaxLength int
+	Pattern              string
+
+	// Mode hints how the text should be shown.
+	Mode TextMode
+
+	State        string
+	StateIsValid bool
+
+	HandleState func(string)
+}
+
+// TextMode is how a text should be shown.
+type TextMode int32
+
+// Text modes.
+const (
+	TextModeText TextMode = iota
+	TextModePassword
+)
+
+func (mode TextMode) String() string {
+	switch mode {
+	case TextModeText:
+		return "text"
+	case TextModePassword:
+		return "password"
+	default:
+		return "unknown"
+	}
+}
+
+func newText(client *Client, entity *api.ListEntitiesTextResponse) *Text {
+	return &Text{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:      entity.Icon,
+		MinLength: int(entity.MinLength),
+		MaxLength: int(entity.MaxLength),
+		Pattern:   entity.Pattern,
+		Mode:      TextMode(entity.Mode),
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Text) rebind(listing *Text) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.MinLength = listing.MinLength
+	entity.MaxLength = listing.MaxLength
+	entity.Pattern = listing.Pattern
+	entity.Mode = listing.Mode
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Text) snapshot() Text {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Text) update(state *api.TextStateResponse, received time.Time, calls *callbacks) Event {
+	event := TextStateEvent{
+		event:           newEvent(KindText, &entity.Entity, received),
+		Text:            entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           state.State,
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != state.State) {
+		calls.add(func() { handle(state.State) })
+	}
+
+	entity.State = state.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// SetValue sets the text.
+func (entity *Text) SetValue(value string) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetValueContext(ctx, value))
+}
+
+// SetValueContext is like SetValue with a context.
+func (entity *Text) SetValueContext(ctx context.Context, value string) error {
+	current := entity.snapshot()
+	if len(value) < current.MinLength || (current.MaxLength > 0 && len(value) > current.MaxLength) {
+		return LengthError{ObjectID: current.ObjectID, Length: len(value), Min: current.MinLength, Max: current.MaxLength}
+	}
+	if current.Pattern != "" {
+		if ok, err := match(current.ObjectID, current.Pattern, value); err != nil {
+			return err
+		} else if !ok {
+			return PatternError{ObjectID: current.ObjectID, Pattern: current.Pattern}
+		}
+	}
+	return current.client.sendContext(ctx, &api.TextCommandRequest{
+		Key:   current.Key,
+		State: value,
+	})
 }
 
 // TextSensor is a lot like Sensor, but where the “normal” sensors only represent sensors that output numbers, this
@@ -436,6 +3345,8 @@ type TextSensor struct {
 	Entity
 	State        string
 	StateIsValid bool
+
+	HandleState func(string)
 }
 
 func newTextSensor(client *Client, entity *api.ListEntitiesTextSensorResponse) *TextSensor {
@@ -450,6 +3361,419 @@ func newTextSensor(client *Client, entity *api.ListEntitiesTextSensorResponse) *
 	}
 }
 
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *TextSensor) rebind(listing *TextSensor) {
+	entity.Entity.rebind(&listing.Entity)
+}
+
+func (entity *TextSensor) update(state *api.TextSensorStateResponse, received time.Time, calls *callbacks) Event {
+	event := TextSensorStateEvent{
+		event:           newEvent(KindTextSensor, &entity.Entity, received),
+		TextSensor:      entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State:           state.State,
+		StateIsValid:    !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != state.State) {
+		calls.add(func() { handle(state.State) })
+	}
+
+	entity.State = state.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// CivilTime is a time of day without a date or location.
+type CivilTime struct {
+	Hour, Minute, Second int
+}
+
+// CivilTimeOf returns the time of day of t, in the location of t.
+func CivilTimeOf(t time.Time) CivilTime {
+	hour, minute, second := t.Clock()
+	return CivilTime{Hour: hour, Minute: minute, Second: second}
+}
+
+func (t CivilTime) String() string {
+	return fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+}
+
+// Time is a time of day that can be set, such as the start time of a schedule.
+type Time struct {
+	Entity
+	Icon string
+
+	State        CivilTime
+	StateIsValid bool
+
+	HandleState func(CivilTime)
+}
+
+func newTime(client *Client, entity *api.ListEntitiesTimeResponse) *Time {
+	return &Time{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon: entity.Icon,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Time) rebind(listing *Time) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Time) snapshot() Time {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Time) update(state *api.TimeStateResponse, received time.Time, calls *callbacks) Event {
+	event := TimeStateEvent{
+		event:           newEvent(KindTime, &entity.Entity, received),
+		TimeEntity:      entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State: CivilTime{
+			Hour:   int(state.Hour),
+			Minute: int(state.Minute),
+			Second: int(state.Second),
+		},
+		StateIsValid: !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != event.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// SetTime sets the time of day.
+func (entity *Time) SetTime(t CivilTime) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetTimeContext(ctx, t))
+}
+
+// SetTimeContext is like SetTime with a context.
+func (entity *Time) SetTimeContext(ctx context.Context, t CivilTime) error {
+	current := entity.snapshot()
+	switch {
+	case t.Hour < 0 || t.Hour > 23:
+		return RangeError{ObjectID: current.ObjectID, Value: float32(t.Hour), Min: 0, Max: 23}
+	case t.Minute < 0 || t.Minute > 59:
+		return RangeError{ObjectID: current.ObjectID, Value: float32(t.Minute), Min: 0, Max: 59}
+	case t.Second < 0 || t.Second > 59:
+		return RangeError{ObjectID: current.ObjectID, Value: float32(t.Second), Min: 0, Max: 59}
+	}
+	return current.client.sendContext(ctx, &api.TimeCommandRequest{
+		Key:    current.Key,
+		Hour:   uint32(t.Hour),
+		Minute: uint32(t.Minute),
+		Second: uint32(t.Second),
+	})
+}
+
+// Update is a firmware or software update of the node, or of a device connected to the node.
+type Update struct {
+	Entity
+	Icon        string
+	DeviceClass string
+
+	// State of the update.
+	State        UpdateState
+	StateIsValid bool
+
+	HandleState func(UpdateState)
+}
+
+// UpdateState represents the state of an update.
+type UpdateState struct {
+	// CurrentVersion is the installed version, LatestVersion is the latest available version.
+	CurrentVersion string
+	LatestVersion  string
+
+	// Title, ReleaseSummary and ReleaseURL describe the latest release.
+	Title          string
+	ReleaseSummary string
+	ReleaseURL     string
+
+	// InProgress indicates the update is being installed, Progress is the installation progress in percent if
+	// HasProgress is set.
+	InProgress  bool
+	HasProgress bool
+	Progress    float32
+}
+
+// Available reports if the latest version differs from the installed version.
+func (state UpdateState) Available() bool {
+	return state.LatestVersion != "" && state.LatestVersion != state.CurrentVersion
+}
+
+func newUpdate(client *Client, entity *api.ListEntitiesUpdateResponse) *Update {
+	return &Update{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:        entity.Icon,
+		DeviceClass: entity.DeviceClass,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Update) rebind(listing *Update) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.DeviceClass = listing.DeviceClass
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Update) snapshot() Update {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Update) update(state *api.UpdateStateResponse, received time.Time, calls *callbacks) Event {
+	event := UpdateStateEvent{
+		event:           newEvent(KindUpdate, &entity.Entity, received),
+		Update:          entity,
+		Previous:        entity.State,
+		PreviousIsValid: entity.StateIsValid,
+		State: UpdateState{
+			CurrentVersion: state.CurrentVersion,
+			LatestVersion:  state.LatestVersion,
+			Title:          state.Title,
+			ReleaseSummary: state.ReleaseSummary,
+			ReleaseURL:     state.ReleaseUrl,
+			InProgress:     state.InProgress,
+			HasProgress:    state.HasProgress,
+			Progress:       state.Progress,
+		},
+		StateIsValid: !state.MissingState,
+	}
+
+	if handle := entity.HandleState; handle != nil && !state.MissingState &&
+		(!entity.StateIsValid || entity.State != event.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = !state.MissingState
+	return event
+}
+
+// Install the latest version.
+func (entity *Update) Install() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.InstallContext(ctx))
+}
+
+// InstallContext is like Install with a context.
+func (entity *Update) InstallContext(ctx context.Context) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.UpdateCommandRequest{
+		Key:     current.Key,
+		Command: api.UpdateCommand_UPDATE_COMMAND_UPDATE,
+	})
+}
+
+// Check for a new version, the node sends a state update if the latest version changes.
+func (entity *Update) Check() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.CheckContext(ctx))
+}
+
+// CheckContext is like Check with a context.
+func (entity *Update) CheckContext(ctx context.Context) error {
+	current := entity.snapshot()
+	return current.client.sendContext(ctx, &api.UpdateCommandRequest{
+		Key:     current.Key,
+		Command: api.UpdateCommand_UPDATE_COMMAND_CHECK,
+	})
+}
+
+// Valve device, such as a water valve.
+type Valve struct {
+	Entity
+	Icon             string
+	DeviceClass      string
+	AssumedState     bool
+	SupportsPosition bool
+	SupportsStop     bool
+
+	// State of the valve.
+	State        ValveState
+	StateIsValid bool
+
+	HandleState func(ValveState)
+}
+
+// ValveOperation is the current operation of a valve.
+type ValveOperation int32
+
+// Valve operations.
+const (
+	ValveOperationIdle ValveOperation = iota
+	ValveOperationOpening
+	ValveOperationClosing
+)
+
+// Valve positions.
+const (
+	ValveClosed float32 = 0.0
+	ValveOpen   float32 = 1.0
+)
+
+// ValveState represents the state of a valve.
+type ValveState struct {
+	// Position from 0.0 (closed) to 1.0 (open).
+	Position float32
+
+	CurrentOperation ValveOperation
+}
+
+func newValve(client *Client, entity *api.ListEntitiesValveResponse) *Valve {
+	return &Valve{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.ObjectId,
+			UniqueID: entity.UniqueId,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Icon:             entity.Icon,
+		DeviceClass:      entity.DeviceClass,
+		AssumedState:     entity.AssumedState,
+		SupportsPosition: entity.SupportsPosition,
+		SupportsStop:     entity.SupportsStop,
+	}
+}
+
+// rebind replaces the listing of entity with listing, the state and handlers of entity are retained.
+func (entity *Valve) rebind(listing *Valve) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Icon = listing.Icon
+	entity.DeviceClass = listing.DeviceClass
+	entity.AssumedState = listing.AssumedState
+	entity.SupportsPosition = listing.SupportsPosition
+	entity.SupportsStop = listing.SupportsStop
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Valve) snapshot() Valve {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+func (entity *Valve) update(state *api.ValveStateResponse, received time.Time, calls *callbacks) Event {
+	event := ValveStateEvent{
+		event:    newEvent(KindValve, &entity.Entity, received),
+		Valve:    entity,
+		Previous: entity.State,
+		State: ValveState{
+			Position:         state.Position,
+			CurrentOperation: ValveOperation(state.CurrentOperation),
+		},
+	}
+
+	if handle := entity.HandleState; handle != nil && (!entity.StateIsValid || event.State != entity.State) {
+		calls.add(func() { handle(event.State) })
+	}
+
+	entity.State = event.State
+	entity.StateIsValid = true
+	return event
+}
+
+// Open the valve.
+func (entity *Valve) Open() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.OpenContext(ctx))
+}
+
+// OpenContext is like Open with a context.
+func (entity *Valve) OpenContext(ctx context.Context) error {
+	return entity.SetPositionContext(ctx, ValveOpen)
+}
+
+// Close the valve.
+func (entity *Valve) Close() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.CloseContext(ctx))
+}
+
+// CloseContext is like Close with a context.
+func (entity *Valve) CloseContext(ctx context.Context) error {
+	return entity.SetPositionContext(ctx, ValveClosed)
+}
+
+// Stop the current valve operation.
+func (entity *Valve) Stop() error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.StopContext(ctx))
+}
+
+// StopContext is like Stop with a context.
+func (entity *Valve) StopContext(ctx context.Context) error {
+	current := entity.snapshot()
+	if !current.SupportsStop {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "stop"}
+	}
+	return current.client.sendContext(ctx, &api.ValveCommandRequest{
+		Key:  current.Key,
+		Stop: true,
+	})
+}
+
+// SetPosition moves the valve to a position between 0.0 (closed) and 1.0 (open). Valves that don't support
+// positioning can only be opened or closed.
+func (entity *Valve) SetPosition(position float32) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.SetPositionContext(ctx, position))
+}
+
+// SetPositionContext is like SetPosition with a context.
+func (entity *Valve) SetPositionContext(ctx context.Context, position float32) error {
+	current := entity.snapshot()
+	if position < ValveClosed || position > ValveOpen {
+		return RangeError{ObjectID: current.ObjectID, Value: position, Min: ValveClosed, Max: ValveOpen}
+	}
+	if !current.SupportsPosition && position != ValveClosed && position != ValveOpen {
+		return UnsupportedError{ObjectID: current.ObjectID, Feature: "position"}
+	}
+	return current.client.sendContext(ctx, &api.ValveCommandRequest{
+		Key:         current.Key,
+		HasPosition: true,
+		Position:    position,
+	})
+}
+
 func equal(a, b float32) bool {
 	const ε = 1e-6
 	return math.Abs(float64(a)-float64(b)) <= ε
@@ -0,0 +1,1262 @@
+package esphome
+
+import (
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome/api"
+)
+
+// lastRequest waits for a request of the given type and returns the last one received.
+func lastRequest(t *testing.T, d *testDevice, messageType uint64, n int) proto.Message {
+	t.Helper()
+	if seen := d.WaitRequests(messageType, n); seen < n {
+		t.Fatalf("expected %d requests, got %d", n, seen)
+	}
+	requests := d.Requests(messageType)
+	return requests[len(requests)-1]
+}
+
+func TestCover(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesCoverResponse{
+		ObjectId:         "blinds",
+		Key:              1,
+		UniqueId:         "test-blinds",
+		SupportsPosition: true,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	cover := c.Entities().Cover["test-blinds"]
+	if cover == nil {
+		t.Fatal("cover not found")
+	}
+
+	if err := cover.SetPosition(0.5); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.CoverCommandRequestType, 1).(*api.CoverCommandRequest)
+	if !request.HasPosition || request.Position != 0.5 || request.HasTilt || request.HasLegacyCommand {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := cover.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.CoverCommandRequestType, 2).(*api.CoverCommandRequest)
+	if !request.Stop || request.HasPosition {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := cover.SetTilt(0.5); err == nil {
+		t.Error("expected tilt to be unsupported")
+	}
+	if err := cover.SetPosition(1.5); err == nil {
+		t.Error("expected position to be out of range")
+	}
+}
+
+func TestCoverLegacy(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesCoverResponse{
+		ObjectId: "garage",
+		Key:      1,
+		UniqueId: "test-garage",
+	})
+	d.major, d.minor = 1, 0
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	cover := c.Entities().Cover["test-garage"]
+	if err := cover.Open(); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.CoverCommandRequestType, 1).(*api.CoverCommandRequest)
+	if !request.HasLegacyCommand || request.LegacyCommand != api.LegacyCoverCommand_LEGACY_COVER_COMMAND_OPEN || request.HasPosition {
+		t.Errorf("unexpected request %+v", request)
+	}
+	if err := cover.SetPosition(0.5); err == nil {
+		t.Error("expected position to be unsupported")
+	}
+}
+
+func TestFan(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesFanResponse{
+		ObjectId:      "fan",
+		Key:           1,
+		UniqueId:      "test-fan",
+		SupportsSpeed: true,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	fan := c.Entities().Fan["test-fan"]
+	if fan == nil {
+		t.Fatal("fan not found")
+	}
+
+	if err := fan.TurnOn(); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.FanCommandRequestType, 1).(*api.FanCommandRequest)
+	if !request.HasState || !request.State || request.HasSpeed || request.HasOscillating {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := fan.SetSpeed(FanSpeedHigh); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.FanCommandRequestType, 2).(*api.FanCommandRequest)
+	if !request.HasSpeed || request.Speed != api.FanSpeed_FAN_SPEED_HIGH || request.HasState {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := fan.SetOscillating(true); err == nil {
+		t.Error("expected oscillation to be unsupported")
+	}
+	if err := fan.SetSpeed(FanSpeed(3)); err == nil {
+		t.Error("expected speed to be out of range")
+	}
+}
+
+func TestFanSpeedLevel(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesFanResponse{
+		ObjectId:             "fan",
+		Key:                  1,
+		UniqueId:             "test-fan",
+		SupportsSpeed:        true,
+		SupportsDirection:    true,
+		SupportedSpeedCount:  5,
+		SupportedPresetModes: []string{"sleep"},
+	})
+	d.minor = 4
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	fan := c.Entities().Fan["test-fan"]
+	if fan == nil {
+		t.Fatal("fan not found")
+	}
+
+	if err := fan.SetSpeed(FanSpeedMedium); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.FanCommandRequestType, 1).(*api.FanCommandRequest)
+	if !request.HasSpeedLevel || request.SpeedLevel != 3 || request.HasSpeed {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := fan.SetDirection(FanDirectionReverse); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.FanCommandRequestType, 2).(*api.FanCommandRequest)
+	if !request.HasDirection || request.Direction != api.FanDirection_FAN_DIRECTION_REVERSE || request.HasSpeedLevel {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"level", fan.SetSpeedLevel(6), RangeError{ObjectID: "fan", Value: 6, Min: 1, Max: 5}},
+		{"preset", fan.SetPresetMode("turbo"), OptionError{ObjectID: "fan", Option: "turbo"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+
+	c.mu.Lock()
+	fan.update(&api.FanStateResponse{State: true, SpeedLevel: 5, PresetMode: "sleep"}, time.Now(), nil)
+	c.mu.Unlock()
+	want := FanState{On: true, SpeedLevel: 5, Speed: FanSpeedHigh, PresetMode: "sleep"}
+	if fan.State != want {
+		t.Errorf("expected state %+v, got %+v", want, fan.State)
+	}
+}
+
+func TestClimate(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesClimateResponse{
+		ObjectId:                    "thermostat",
+		Key:                         1,
+		UniqueId:                    "test-thermostat",
+		SupportedModes:              []api.ClimateMode{api.ClimateMode_CLIMATE_MODE_OFF, api.ClimateMode_CLIMATE_MODE_HEAT},
+		VisualMinTemperature:        10,
+		VisualMaxTemperature:        30,
+		VisualTargetTemperatureStep: 0.5,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	climate := c.Entities().Climate["test-thermostat"]
+	if climate == nil {
+		t.Fatal("climate not found")
+	}
+
+	if err := climate.SetTargetTemperature(21.5); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.ClimateCommandRequestType, 1).(*api.ClimateCommandRequest)
+	if !request.HasTargetTemperature || request.TargetTemperature != 21.5 || request.HasMode {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := climate.SetMode(ClimateModeHeat); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.ClimateCommandRequestType, 2).(*api.ClimateCommandRequest)
+	if !request.HasMode || request.Mode != api.ClimateMode_CLIMATE_MODE_HEAT || request.HasTargetTemperature {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+	}{
+		{"unsupported mode", climate.SetMode(ClimateModeCool)},
+		{"below minimum", climate.SetTargetTemperature(5)},
+		{"step", climate.SetTargetTemperature(21.3)},
+		{"two-point", climate.SetTargetRange(18, 22)},
+		{"away", climate.SetAway(true)},
+		{"fan mode", climate.SetFanMode(ClimateFanModeAuto)},
+		{"swing mode", climate.SetSwingMode(ClimateSwingModeBoth)},
+	}
+	for _, test := range tests {
+		if test.Err == nil {
+			t.Errorf("%s: expected error", test.Name)
+		}
+	}
+}
+
+func TestClimateModeValues(t *testing.T) {
+	// The values are fixed by the native API.
+	for _, test := range []struct {
+		Mode  ClimateMode
+		Wire  api.ClimateMode
+		Value int32
+	}{
+		{ClimateModeOff, api.ClimateMode_CLIMATE_MODE_OFF, 0},
+		{ClimateModeHeatCool, api.ClimateMode_CLIMATE_MODE_HEAT_COOL, 1},
+		{ClimateModeCool, api.ClimateMode_CLIMATE_MODE_COOL, 2},
+		{ClimateModeHeat, api.ClimateMode_CLIMATE_MODE_HEAT, 3},
+		{ClimateModeFanOnly, api.ClimateMode_CLIMATE_MODE_FAN_ONLY, 4},
+		{ClimateModeDry, api.ClimateMode_CLIMATE_MODE_DRY, 5},
+		{ClimateModeAuto, api.ClimateMode_CLIMATE_MODE_AUTO, 6},
+	} {
+		if int32(test.Mode) != test.Value || int32(test.Wire) != test.Value {
+			t.Errorf("climate mode %s: expected %d, got %d (wire %d)", test.Mode, test.Value, test.Mode, test.Wire)
+		}
+	}
+}
+
+func TestClimateTargetRange(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesClimateResponse{
+		ObjectId:                          "thermostat",
+		Key:                               1,
+		UniqueId:                          "test-thermostat",
+		SupportsTwoPointTargetTemperature: true,
+		VisualMinTemperature:              10.25,
+		VisualMaxTemperature:              30.25,
+		VisualTargetTemperatureStep:       0.5,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	climate := c.Entities().Climate["test-thermostat"]
+	if climate == nil {
+		t.Fatal("climate not found")
+	}
+
+	// Steps start at the minimum temperature.
+	if err := climate.SetTargetRange(18.25, 22.75); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.ClimateCommandRequestType, 1).(*api.ClimateCommandRequest)
+	if !request.HasTargetTemperatureLow || request.TargetTemperatureLow != 18.25 ||
+		!request.HasTargetTemperatureHigh || request.TargetTemperatureHigh != 22.75 {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := climate.SetTargetRange(18, 22.75); err != (StepError{ObjectID: "thermostat", Value: 18, Step: 0.5}) {
+		t.Errorf("expected step error, got %v", err)
+	}
+	want := TargetRangeError{ObjectID: "thermostat", Low: 22.25, High: 18.25}
+	if err := climate.SetTargetRange(22.25, 18.25); err != want {
+		t.Errorf("expected target range error, got %v", err)
+	}
+}
+
+func TestBinarySensorEdges(t *testing.T) {
+	var (
+		sensor = &BinarySensor{Entity: Entity{ObjectID: "button"}}
+		events []string
+		now    = time.Now()
+	)
+	sensor.HandlePress = func() { events = append(events, "press") }
+	sensor.HandleRelease = func() { events = append(events, "release") }
+	sensor.HandleClick = func() { events = append(events, "click") }
+	sensor.HandleDoubleClick = func() { events = append(events, "double click") }
+	sensor.HandleLongPress = func() { events = append(events, "long press") }
+
+	for _, step := range []struct {
+		After time.Duration
+		State bool
+	}{
+		{0, false},
+		{time.Second, true},
+		{100 * time.Millisecond, false}, // click
+		{100 * time.Millisecond, true},
+		{100 * time.Millisecond, false}, // double click
+		{time.Second, true},
+		{2 * time.Second, false}, // long press
+		{time.Second, true},
+		{10 * time.Millisecond, false}, // too short for a click
+	} {
+		now = now.Add(step.After)
+		sensor.update(&api.BinarySensorStateResponse{State: step.State}, now, nil)
+	}
+
+	want := []string{
+		"press", "release", "click",
+		"press", "release", "click", "double click",
+		"press", "release", "long press",
+		"press", "release",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("expected %q, got %q", want, events)
+	}
+}
+
+func TestBinarySensorMissingState(t *testing.T) {
+	var (
+		sensor  = &BinarySensor{Entity: Entity{ObjectID: "door"}}
+		pressed bool
+	)
+	sensor.HandlePress = func() { pressed = true }
+
+	sensor.update(&api.BinarySensorStateResponse{MissingState: true}, time.Now(), nil)
+	if sensor.StateIsValid {
+		t.Error("expected state to be invalid")
+	}
+	event := sensor.update(&api.BinarySensorStateResponse{State: true}, time.Now(), nil).(BinarySensorStateEvent)
+	if !sensor.StateIsValid || event.PreviousIsValid || !event.StateIsValid {
+		t.Errorf("unexpected state validity in %+v", event)
+	}
+	if pressed {
+		t.Error("expected the first valid state not to be a press")
+	}
+}
+
+func TestNumber(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesNumberResponse{
+		ObjectId: "setpoint",
+		Key:      1,
+		UniqueId: "test-setpoint",
+		MinValue: 5,
+		MaxValue: 30,
+		Step:     0.5,
+		Mode:     api.NumberMode_NUMBER_MODE_SLIDER,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	number := c.Entities().Number["test-setpoint"]
+	if number == nil {
+		t.Fatal("number not found")
+	}
+	if number.Mode != NumberModeSlider {
+		t.Errorf("expected mode slider, got %s", number.Mode)
+	}
+
+	if err := number.SetValue(21.5); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.NumberCommandRequestType, 1).(*api.NumberCommandRequest)
+	if request.Key != 1 || request.State != 21.5 {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := number.SetValue(31); !reflect.DeepEqual(err, RangeError{ObjectID: "setpoint", Value: 31, Min: 5, Max: 30}) {
+		t.Errorf("expected range error, got %v", err)
+	}
+	if err := number.SetValue(21.2); !reflect.DeepEqual(err, StepError{ObjectID: "setpoint", Value: 21.2, Step: 0.5}) {
+		t.Errorf("expected step error, got %v", err)
+	}
+
+	for _, test := range []struct {
+		Value, Want float32
+	}{
+		{21.2, 21},
+		{21.3, 21.5},
+		{2, 5},
+		{40, 30},
+	} {
+		if v := number.Clamp(test.Value); v != test.Want {
+			t.Errorf("clamp %g: expected %g, got %g", test.Value, test.Want, v)
+		}
+	}
+}
+
+func TestNumberState(t *testing.T) {
+	var (
+		number = &Number{Entity: Entity{ObjectID: "setpoint"}}
+		states []float32
+	)
+	number.HandleState = func(state float32) { states = append(states, state) }
+
+	number.update(&api.NumberStateResponse{MissingState: true}, time.Now(), nil)
+	number.update(&api.NumberStateResponse{State: 0}, time.Now(), nil)
+	number.update(&api.NumberStateResponse{State: 0}, time.Now(), nil)
+	event := number.update(&api.NumberStateResponse{State: 1}, time.Now(), nil).(NumberStateEvent)
+	if !reflect.DeepEqual(states, []float32{0, 1}) {
+		t.Errorf("unexpected states %v", states)
+	}
+	if event.Previous != 0 || event.State != 1 || !event.PreviousIsValid || !event.StateIsValid {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesSelectResponse{
+		ObjectId: "mode",
+		Key:      1,
+		UniqueId: "test-mode",
+		Options:  []string{"auto", "manual"},
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	mode := c.Entities().Select["test-mode"]
+	if mode == nil {
+		t.Fatal("select not found")
+	}
+
+	if err := mode.SetOption("manual"); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.SelectCommandRequestType, 1).(*api.SelectCommandRequest)
+	if request.Key != 1 || request.State != "manual" {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := mode.SetOption("off"); err != (OptionError{ObjectID: "mode", Option: "off"}) {
+		t.Errorf("expected option error, got %v", err)
+	}
+
+	var states []string
+	mode.HandleState = func(state string) { states = append(states, state) }
+	mode.update(&api.SelectStateResponse{State: "auto"}, time.Now(), nil)
+	mode.update(&api.SelectStateResponse{State: "auto"}, time.Now(), nil)
+	event := mode.update(&api.SelectStateResponse{State: "manual"}, time.Now(), nil).(SelectStateEvent)
+	if !reflect.DeepEqual(states, []string{"auto", "manual"}) {
+		t.Errorf("unexpected states %v", states)
+	}
+	if event.Previous != "auto" || event.State != "manual" {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestButton(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesButtonResponse{
+		ObjectId:    "restart",
+		Key:         1,
+		UniqueId:    "test-restart",
+		DeviceClass: "restart",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	button := c.Entities().Button["test-restart"]
+	if button == nil {
+		t.Fatal("button not found")
+	}
+	if button.DeviceClass != "restart" {
+		t.Errorf("expected device class restart, got %q", button.DeviceClass)
+	}
+
+	if err := button.Press(); err != nil {
+		t.Fatal(err)
+	}
+	if request := lastRequest(t, d, api.ButtonCommandRequestType, 1).(*api.ButtonCommandRequest); request.Key != 1 {
+		t.Errorf("unexpected request %+v", request)
+	}
+}
+
+func TestLock(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesLockResponse{
+		ObjectId:     "door",
+		Key:          1,
+		UniqueId:     "test-door",
+		RequiresCode: true,
+		CodeFormat:   `\d{4}`,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	lock := c.Entities().Lock["test-door"]
+	if lock == nil {
+		t.Fatal("lock not found")
+	}
+
+	if err := lock.Unlock("1234"); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.LockCommandRequestType, 1).(*api.LockCommandRequest)
+	if request.Command != api.LockCommand_LOCK_UNLOCK || !request.HasCode || request.Code != "1234" {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"missing code", lock.Lock(""), CodeError{ObjectID: "door"}},
+		{"invalid code", lock.Lock("12345"), CodeError{ObjectID: "door", Format: `\d{4}`}},
+		{"open", lock.Open("1234"), UnsupportedError{ObjectID: "door", Feature: "open"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+
+	var states []LockState
+	lock.HandleState = func(state LockState) { states = append(states, state) }
+	event := lock.update(&api.LockStateResponse{State: api.LockState_LOCK_STATE_NONE}, time.Now(), nil).(LockStateEvent)
+	if event.PreviousIsValid || !lock.StateIsValid {
+		t.Errorf("unexpected validity of event %+v", event)
+	}
+	lock.update(&api.LockStateResponse{State: api.LockState_LOCK_STATE_LOCKED}, time.Now(), nil)
+	lock.update(&api.LockStateResponse{State: api.LockState_LOCK_STATE_LOCKED}, time.Now(), nil)
+	event = lock.update(&api.LockStateResponse{State: api.LockState_LOCK_STATE_JAMMED}, time.Now(), nil).(LockStateEvent)
+	if !reflect.DeepEqual(states, []LockState{LockStateNone, LockStateLocked, LockStateJammed}) {
+		t.Errorf("unexpected states %v", states)
+	}
+	if !event.PreviousIsValid || event.Previous != LockStateLocked {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestMediaPlayer(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesMediaPlayerResponse{
+		ObjectId: "speaker",
+		Key:      1,
+		UniqueId: "test-speaker",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	player := c.Entities().MediaPlayer["test-speaker"]
+	if player == nil {
+		t.Fatal("media player not found")
+	}
+
+	if err := player.PlayMedia("http://example.com/doorbell.mp3", true); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.MediaPlayerCommandRequestType, 1).(*api.MediaPlayerCommandRequest)
+	if request.HasCommand || !request.HasMediaUrl || request.MediaUrl != "http://example.com/doorbell.mp3" ||
+		!request.HasAnnouncement || !request.Announcement {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := player.SetVolume(.5); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.MediaPlayerCommandRequestType, 2).(*api.MediaPlayerCommandRequest)
+	if request.HasCommand || !request.HasVolume || request.Volume != .5 {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := player.Mute(); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.MediaPlayerCommandRequestType, 3).(*api.MediaPlayerCommandRequest)
+	if !request.HasCommand || request.Command != api.MediaPlayerCommand_MEDIA_PLAYER_COMMAND_MUTE {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"volume", player.SetVolume(1.5), RangeError{ObjectID: "speaker", Value: 1.5, Min: 0, Max: 1}},
+		{"pause", player.Pause(), UnsupportedError{ObjectID: "speaker", Feature: "pause"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+
+	var states []MediaPlayerState
+	player.HandleState = func(state MediaPlayerState) { states = append(states, state) }
+	player.update(&api.MediaPlayerStateResponse{State: api.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING, Volume: .5}, time.Now(), nil)
+	player.update(&api.MediaPlayerStateResponse{State: api.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING, Volume: .5}, time.Now(), nil)
+	player.update(&api.MediaPlayerStateResponse{State: api.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING, Volume: .5, Muted: true}, time.Now(), nil)
+	want := []MediaPlayerState{
+		{Playback: MediaPlayerPlaying, Volume: .5},
+		{Playback: MediaPlayerPlaying, Volume: .5, Muted: true},
+	}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("unexpected states %v", states)
+	}
+}
+
+func TestSiren(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesSirenResponse{
+		ObjectId:         "siren",
+		Key:              1,
+		UniqueId:         "test-siren",
+		Tones:            []string{"beep", "wail"},
+		SupportsDuration: true,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	siren := c.Entities().Siren["test-siren"]
+	if siren == nil {
+		t.Fatal("siren not found")
+	}
+
+	if err := siren.TurnOn(SirenOptions{Tone: "wail", Duration: 1500 * time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.SirenCommandRequestType, 1).(*api.SirenCommandRequest)
+	if !request.HasState || !request.State || !request.HasTone || request.Tone != "wail" ||
+		!request.HasDuration || request.Duration != 2 || request.HasVolume {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"tone", siren.TurnOn(SirenOptions{Tone: "horn"}), OptionError{ObjectID: "siren", Option: "horn"}},
+		{"volume", siren.TurnOn(SirenOptions{Volume: .5}), UnsupportedError{ObjectID: "siren", Feature: "volume"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+}
+
+func TestValve(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesValveResponse{
+		ObjectId: "water",
+		Key:      1,
+		UniqueId: "test-water",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	valve := c.Entities().Valve["test-water"]
+	if valve == nil {
+		t.Fatal("valve not found")
+	}
+
+	if err := valve.Open(); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.ValveCommandRequestType, 1).(*api.ValveCommandRequest)
+	if !request.HasPosition || request.Position != ValveOpen || request.Stop {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"position", valve.SetPosition(.5), UnsupportedError{ObjectID: "water", Feature: "position"}},
+		{"range", valve.SetPosition(2), RangeError{ObjectID: "water", Value: 2, Min: ValveClosed, Max: ValveOpen}},
+		{"stop", valve.Stop(), UnsupportedError{ObjectID: "water", Feature: "stop"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+
+	var states []ValveState
+	valve.HandleState = func(state ValveState) { states = append(states, state) }
+	valve.update(&api.ValveStateResponse{CurrentOperation: api.ValveOperation_VALVE_OPERATION_IS_OPENING}, time.Now(), nil)
+	valve.update(&api.ValveStateResponse{Position: 1}, time.Now(), nil)
+	want := []ValveState{{CurrentOperation: ValveOperationOpening}, {Position: ValveOpen}}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("unexpected states %v", states)
+	}
+}
+
+func TestAlarmControlPanel(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesAlarmControlPanelResponse{
+		ObjectId:          "alarm",
+		Key:               1,
+		UniqueId:          "test-alarm",
+		SupportedFeatures: uint32(AlarmControlPanelArmAway | AlarmControlPanelArmHome),
+		RequiresCode:      true,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	panel := c.Entities().AlarmControlPanel["test-alarm"]
+	if panel == nil {
+		t.Fatal("alarm control panel not found")
+	}
+
+	if err := panel.ArmAway(""); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.AlarmControlPanelCommandRequestType, 1).(*api.AlarmControlPanelCommandRequest)
+	if request.Command != api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_ARM_AWAY || request.Code != "" {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	if err := panel.Disarm("1234"); err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.AlarmControlPanelCommandRequestType, 2).(*api.AlarmControlPanelCommandRequest)
+	if request.Command != api.AlarmControlPanelStateCommand_ALARM_CONTROL_PANEL_DISARM || request.Code != "1234" {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"disarm", panel.Disarm(""), CodeError{ObjectID: "alarm"}},
+		{"arm night", panel.ArmNight(""), UnsupportedError{ObjectID: "alarm", Feature: "arm night"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+
+	var states []AlarmControlPanelState
+	panel.HandleState = func(state AlarmControlPanelState) { states = append(states, state) }
+	panel.update(&api.AlarmControlPanelStateResponse{State: api.AlarmControlPanelState_ALARM_STATE_DISARMED}, time.Now(), nil)
+	panel.update(&api.AlarmControlPanelStateResponse{State: api.AlarmControlPanelState_ALARM_STATE_PENDING}, time.Now(), nil)
+	panel.update(&api.AlarmControlPanelStateResponse{State: api.AlarmControlPanelState_ALARM_STATE_TRIGGERED}, time.Now(), nil)
+	want := []AlarmControlPanelState{
+		AlarmControlPanelStateDisarmed,
+		AlarmControlPanelStatePending,
+		AlarmControlPanelStateTriggered,
+	}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("unexpected states %v", states)
+	}
+}
+
+func TestText(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesTextResponse{
+		ObjectId:  "message",
+		Key:       1,
+		UniqueId:  "test-message",
+		MinLength: 1,
+		MaxLength: 8,
+		Pattern:   `[a-z]+`,
+	}, &api.ListEntitiesTextResponse{
+		ObjectId:  "note",
+		Key:       2,
+		UniqueId:  "test-note",
+		MinLength: 1,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	var (
+		text = c.Entities().Text["test-message"]
+		note = c.Entities().Text["test-note"]
+	)
+	if text == nil || note == nil {
+		t.Fatal("text not found")
+	}
+
+	if err := text.SetValue("hello"); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.TextCommandRequestType, 1).(*api.TextCommandRequest)
+	if request.State != "hello" {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	// Without a maximum length, any length is accepted.
+	long := strings.Repeat("x", 1024)
+	if err := note.SetValue(long); err != nil {
+		t.Fatal(err)
+	}
+	if request = lastRequest(t, d, api.TextCommandRequestType, 2).(*api.TextCommandRequest); request.State != long {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"empty", text.SetValue(""), LengthError{ObjectID: "message", Length: 0, Min: 1, Max: 8}},
+		{"long", text.SetValue("greetings"), LengthError{ObjectID: "message", Length: 9, Min: 1, Max: 8}},
+		{"pattern", text.SetValue("Hello"), PatternError{ObjectID: "message", Pattern: `[a-z]+`}},
+		{"unbounded empty", note.SetValue(""), LengthError{ObjectID: "note", Length: 0, Min: 1}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesDateResponse{
+		ObjectId: "start",
+		Key:      1,
+		UniqueId: "test-start",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	date := c.Entities().Date["test-start"]
+	if date == nil {
+		t.Fatal("date not found")
+	}
+
+	if err := date.SetDate(CivilDate{Year: 2024, Month: time.February, Day: 29}); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.DateCommandRequestType, 1).(*api.DateCommandRequest)
+	if request.Year != 2024 || request.Month != 2 || request.Day != 29 {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	err := date.SetDate(CivilDate{Year: 2023, Month: time.February, Day: 29})
+	if want := (RangeError{ObjectID: "start", Value: 29, Min: 1, Max: 28}); err != want {
+		t.Errorf("expected %v, got %v", want, err)
+	}
+
+	var states []CivilDate
+	date.HandleState = func(state CivilDate) { states = append(states, state) }
+	date.update(&api.DateStateResponse{MissingState: true}, time.Now(), nil)
+	date.update(&api.DateStateResponse{Year: 2024, Month: 12, Day: 31}, time.Now(), nil)
+	if want := []CivilDate{{2024, time.December, 31}}; !reflect.DeepEqual(states, want) {
+		t.Errorf("unexpected states %v", states)
+	}
+	if s := date.State.String(); s != "2024-12-31" {
+		t.Errorf("unexpected string %q", s)
+	}
+}
+
+func TestTime(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesTimeResponse{
+		ObjectId: "wake",
+		Key:      1,
+		UniqueId: "test-wake",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	entity := c.Entities().Time["test-wake"]
+	if entity == nil {
+		t.Fatal("time not found")
+	}
+
+	if err := entity.SetTime(CivilTime{Hour: 6, Minute: 30}); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.TimeCommandRequestType, 1).(*api.TimeCommandRequest)
+	if request.Hour != 6 || request.Minute != 30 || request.Second != 0 {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	err := entity.SetTime(CivilTime{Hour: 24})
+	if want := (RangeError{ObjectID: "wake", Value: 24, Min: 0, Max: 23}); err != want {
+		t.Errorf("expected %v, got %v", want, err)
+	}
+
+	entity.update(&api.TimeStateResponse{Hour: 7, Minute: 5, Second: 9}, time.Now(), nil)
+	if s := entity.State.String(); !entity.StateIsValid || s != "07:05:09" {
+		t.Errorf("unexpected state %q", s)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesDateTimeResponse{
+		ObjectId: "alarm",
+		Key:      1,
+		UniqueId: "test-alarm",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	entity := c.Entities().DateTime["test-alarm"]
+	if entity == nil {
+		t.Fatal("date time not found")
+	}
+
+	when := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	if err := entity.SetDateTime(when); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.DateTimeCommandRequestType, 1).(*api.DateTimeCommandRequest)
+	if int64(request.EpochSeconds) != when.Unix() {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	var states []time.Time
+	entity.HandleState = func(state time.Time) { states = append(states, state) }
+	entity.update(&api.DateTimeStateResponse{EpochSeconds: uint32(when.Unix())}, time.Now(), nil)
+	entity.update(&api.DateTimeStateResponse{EpochSeconds: uint32(when.Unix())}, time.Now(), nil)
+	if len(states) != 1 || !states[0].Equal(when) {
+		t.Errorf("unexpected states %v", states)
+	}
+}
+
+func TestEventEntity(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesEventResponse{
+		ObjectId:   "doorbell",
+		Key:        1,
+		UniqueId:   "test-doorbell",
+		EventTypes: []string{"single_press", "long_press"},
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	doorbell := c.Entities().Event["test-doorbell"]
+	if doorbell == nil {
+		t.Fatal("event not found")
+	}
+
+	events, cancel := c.Subscribe(EventFilter{Kinds: []EntityKind{KindEvent}})
+	defer cancel()
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected state subscription, got %d", n)
+	}
+	d.Broadcast(&api.EventResponse{Key: 1, EventType: "single_press"})
+	d.Broadcast(&api.EventResponse{Key: 1, EventType: "single_press"})
+
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-events:
+			fired, ok := event.(FiredEvent)
+			if !ok {
+				t.Fatalf("expected FiredEvent, got %T", event)
+			}
+			if fired.EventEntity != doorbell || fired.EventType != "single_press" || fired.Time().IsZero() {
+				t.Errorf("unexpected event %+v", fired)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for event")
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesUpdateResponse{
+		ObjectId: "firmware",
+		Key:      1,
+		UniqueId: "test-firmware",
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	update := c.Entities().Update["test-firmware"]
+	if update == nil {
+		t.Fatal("update not found")
+	}
+
+	if err := update.Install(); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.UpdateCommandRequestType, 1).(*api.UpdateCommandRequest)
+	if request.Command != api.UpdateCommand_UPDATE_COMMAND_UPDATE {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	var states []UpdateState
+	update.HandleState = func(state UpdateState) { states = append(states, state) }
+	update.update(&api.UpdateStateResponse{CurrentVersion: "1.0", LatestVersion: "1.1"}, time.Now(), nil)
+	update.update(&api.UpdateStateResponse{CurrentVersion: "1.0", LatestVersion: "1.1", InProgress: true, HasProgress: true, Progress: 50}, time.Now(), nil)
+	if len(states) != 2 || !states[0].Available() || !states[1].InProgress || states[1].Progress != 50 {
+		t.Errorf("unexpected states %+v", states)
+	}
+}
+
+func TestLightCommand(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesLightResponse{
+		ObjectId:            "lamp",
+		Key:                 1,
+		UniqueId:            "test-lamp",
+		SupportedColorModes: []api.ColorMode{api.ColorMode_COLOR_MODE_RGB_COLOR_TEMPERATURE},
+		MinMireds:           153,
+		MaxMireds:           500,
+		Effects:             []string{"Rainbow"},
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	light := c.Entities().Light["test-lamp"]
+	if light == nil {
+		t.Fatal("light not found")
+	}
+
+	if err := light.SetColor(color.RGBA{R: 0xff, G: 0x80, B: 0x00, A: 0xff}); err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest(t, d, api.LightCommandRequestType, 1).(*api.LightCommandRequest)
+	if !request.HasRgb || request.Red != 1 || !equal(request.Green, 0x80/255.0) || request.Blue != 0 ||
+		request.HasState || request.HasBrightness || request.HasEffect {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	err := light.Command().
+		State(true).
+		Kelvin(10000).
+		Transition(1500 * time.Millisecond).
+		Effect("Rainbow").
+		Apply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request = lastRequest(t, d, api.LightCommandRequestType, 2).(*api.LightCommandRequest)
+	if !request.HasState || !request.State ||
+		!request.HasColorTemperature || request.ColorTemperature != 153 ||
+		!request.HasTransitionLength || request.TransitionLength != 1500 ||
+		!request.HasEffect || request.Effect != "Rainbow" ||
+		request.HasRgb || request.HasFlashLength {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	tests := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"brightness", light.Command().State(true).Brightness(2).Apply(), RangeError{ObjectID: "lamp", Value: 2, Max: 1}},
+		{"effect", light.SetEffect("Strobe"), OptionError{ObjectID: "lamp", Option: "Strobe"}},
+	}
+	for _, test := range tests {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+	if n := len(d.Requests(api.LightCommandRequestType)); n != 2 {
+		t.Errorf("expected invalid commands not to be sent, got %d requests", n)
+	}
+}
+
+func TestLightColorAlpha(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesLightResponse{
+		ObjectId:            "lamp",
+		Key:                 1,
+		UniqueId:            "test-lamp",
+		SupportedColorModes: []api.ColorMode{api.ColorMode_COLOR_MODE_RGB},
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	light := c.Entities().Light["test-lamp"]
+	if light == nil {
+		t.Fatal("light not found")
+	}
+
+	for i, test := range []struct {
+		Color            color.Color
+		Red, Green, Blue float32
+	}{
+		// Non-premultiplied colors keep their values, even if transparent.
+		{color.NRGBA{R: 0xff, G: 0x80, B: 0x00, A: 0x00}, 1, 0x80 / 255.0, 0},
+		{color.NRGBA64{R: 0x0000, G: 0xffff, B: 0x8000, A: 0x1000}, 0, 1, 0x8000 / 65535.0},
+		// Premultiplied colors are converted.
+		{color.RGBA{R: 0x80, G: 0x40, B: 0x00, A: 0x80}, 1, 0x7fff / 65535.0, 0},
+		{color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x00}, 0, 0, 0},
+	} {
+		if err := light.SetColor(test.Color); err != nil {
+			t.Fatal(err)
+		}
+		request := lastRequest(t, d, api.LightCommandRequestType, i+1).(*api.LightCommandRequest)
+		if !request.HasRgb || !equal(request.Red, test.Red) || !equal(request.Green, test.Green) ||
+			!equal(request.Blue, test.Blue) {
+			t.Errorf("%#v: expected rgb %g %g %g, got %g %g %g", test.Color, test.Red, test.Green, test.Blue,
+				request.Red, request.Green, request.Blue)
+		}
+	}
+}
+
+func TestLightColorModes(t *testing.T) {
+	d := newTestDevice(t,
+		&api.ListEntitiesLightResponse{
+			ObjectId: "strip",
+			Key:      1,
+			UniqueId: "test-strip",
+			SupportedColorModes: []api.ColorMode{
+				api.ColorMode_COLOR_MODE_COLD_WARM_WHITE,
+				api.ColorMode_COLOR_MODE_RGB,
+				api.ColorMode_COLOR_MODE_RGB_WHITE,
+			},
+		},
+		&api.ListEntitiesLightResponse{
+			ObjectId:                 "bulb",
+			Key:                      2,
+			UniqueId:                 "test-bulb",
+			LegacySupportsBrightness: true,
+			LegacySupportsRgb:        true,
+		})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	var (
+		entities = c.Entities()
+		strip    = entities.Light["test-strip"]
+		bulb     = entities.Light["test-bulb"]
+	)
+	if strip == nil || bulb == nil {
+		t.Fatal("light not found")
+	}
+	if modes := bulb.Capabilities.ColorModes; !reflect.DeepEqual(modes, []LightColorMode{LightColorModeRGB}) {
+		t.Errorf("unexpected legacy color modes %v", modes)
+	}
+	if !strip.Capabilities.RGB || !strip.Capabilities.WhiteValue || strip.Capabilities.ColorTemperature {
+		t.Errorf("unexpected capabilities %+v", strip.Capabilities)
+	}
+
+	strip.update(&api.LightStateResponse{Key: 1, State: true, ColorMode: api.ColorMode_COLOR_MODE_COLD_WARM_WHITE}, time.Now(), nil)
+	if strip.State.ColorMode != LightColorModeColdWarmWhite {
+		t.Errorf("unexpected color mode %s", strip.State.ColorMode)
+	}
+
+	tests := []struct {
+		Name    string
+		Command *LightCommand
+		Mode    api.ColorMode // COLOR_MODE_UNKNOWN if the color mode is not sent
+	}{
+		{"current mode", strip.Command().ColdWhite(1).WarmWhite(.5), api.ColorMode_COLOR_MODE_UNKNOWN},
+		{"color temperature", strip.Command().ColorTemperature(300), api.ColorMode_COLOR_MODE_UNKNOWN},
+		{"fewest capabilities", strip.Command().Color(color.White), api.ColorMode_COLOR_MODE_RGB},
+		{"combined", strip.Command().Color(color.White).White(1), api.ColorMode_COLOR_MODE_RGB_WHITE},
+		{"explicit", strip.Command().ColorMode(LightColorModeRGBWhite).Color(color.White), api.ColorMode_COLOR_MODE_RGB_WHITE},
+		{"legacy", bulb.Command().Color(color.White), api.ColorMode_COLOR_MODE_UNKNOWN},
+	}
+	for i, test := range tests {
+		if err := test.Command.Apply(); err != nil {
+			t.Fatalf("%s: %v", test.Name, err)
+		}
+		request := lastRequest(t, d, api.LightCommandRequestType, i+1).(*api.LightCommandRequest)
+		if request.HasColorMode != (test.Mode != api.ColorMode_COLOR_MODE_UNKNOWN) || request.ColorMode != test.Mode {
+			t.Errorf("%s: unexpected request %+v", test.Name, request)
+		}
+	}
+
+	errors := []struct {
+		Name string
+		Err  error
+		Want error
+	}{
+		{"unsupported", strip.Command().ColdWhite(1).Color(color.White).Apply(), UnsupportedError{ObjectID: "strip", Feature: "cold/warm white and RGB"}},
+		{"mode", strip.Command().ColorMode(LightColorModeWhite).Apply(), UnsupportedError{ObjectID: "strip", Feature: "color mode white"}},
+		{"legacy white", bulb.Command().White(1).Apply(), UnsupportedError{ObjectID: "bulb", Feature: "white"}},
+		{"legacy color brightness", bulb.Command().ColorBrightness(1).Apply(), UnsupportedError{ObjectID: "bulb", Feature: "color brightness"}},
+	}
+	for _, test := range errors {
+		if test.Err != test.Want {
+			t.Errorf("%s: expected %v, got %v", test.Name, test.Want, test.Err)
+		}
+	}
+}
+
+func TestLightStateCallbacks(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesLightResponse{
+		ObjectId:                 "bulb",
+		Key:                      1,
+		UniqueId:                 "test-bulb",
+		LegacySupportsBrightness: true,
+	})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	bulb := c.Entities().Light["test-bulb"]
+	if bulb == nil {
+		t.Fatal("light not found")
+	}
+
+	var (
+		states     []bool
+		brightness []float32
+	)
+	bulb.HandleState = func(on bool) { states = append(states, on) }
+	bulb.HandleBrightness = func(level float32) { brightness = append(brightness, level) }
+
+	bulb.update(&api.LightStateResponse{Key: 1, State: true, Brightness: .5}, time.Now(), nil)
+	bulb.update(&api.LightStateResponse{Key: 1, State: true, Brightness: .5}, time.Now(), nil)
+	bulb.update(&api.LightStateResponse{Key: 1, State: true, Brightness: 1}, time.Now(), nil)
+	event := bulb.update(&api.LightStateResponse{Key: 1, State: false, Brightness: 1}, time.Now(), nil).(LightStateEvent)
+
+	if !reflect.DeepEqual(states, []bool{true, false}) {
+		t.Errorf("unexpected states %v", states)
+	}
+	if !reflect.DeepEqual(brightness, []float32{.5, 1}) {
+		t.Errorf("unexpected brightness %v", brightness)
+	}
+	if !event.Previous.On || event.State.On {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
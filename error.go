@@ -1,6 +1,11 @@
 package esphome
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"maze.io/x/esphome/api"
+)
 
 // Errors.
 var (
@@ -8,4 +13,157 @@ var (
 	ErrTimeout  = errors.New("esphome: timeout")
 	ErrObjectID = errors.New("esphome: unknown object identifier")
 	ErrEntity   = errors.New("esphome: entity not found")
+	ErrClosed   = errors.New("esphome: connection closed")
+	ErrOverflow = errors.New("esphome: receive buffer overflow")
+
+	// ErrEncryptionKey is returned if an encryption key is not a base64 encoded 32 byte key.
+	ErrEncryptionKey = errors.New("esphome: invalid encryption key")
+
+	// ErrEncryptionRequired is returned if the node uses encryption, see DialEncrypted.
+	ErrEncryptionRequired = api.ErrEncryptionRequired
+
+	// ErrPlaintext is returned by DialEncrypted if the node doesn't use encryption.
+	ErrPlaintext = api.ErrPlaintext
+
+	// ErrHandshake is wrapped by the error returned by DialEncrypted if the encryption handshake fails, this
+	// usually means the encryption key is incorrect.
+	ErrHandshake = api.ErrHandshake
 )
+
+// UnsupportedError is returned by commands that are not supported by an entity.
+type UnsupportedError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Feature that is not supported.
+	Feature string
+}
+
+func (err UnsupportedError) Error() string {
+	return fmt.Sprintf("esphome: %s does not support %s", err.ObjectID, err.Feature)
+}
+
+// RangeError is returned by commands if a value is outside of the range supported by an entity.
+type RangeError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Value that is out of range.
+	Value float32
+
+	// Min and Max are the supported range.
+	Min, Max float32
+}
+
+func (err RangeError) Error() string {
+	return fmt.Sprintf("esphome: %s value %g out of range [%g, %g]", err.ObjectID, err.Value, err.Min, err.Max)
+}
+
+// StepError is returned by commands if a value is not a multiple of the step size supported by an entity.
+type StepError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Value that is not a multiple of Step.
+	Value float32
+
+	// Step size.
+	Step float32
+}
+
+func (err StepError) Error() string {
+	return fmt.Sprintf("esphome: %s value %g is not a multiple of %g", err.ObjectID, err.Value, err.Step)
+}
+
+// TargetRangeError is returned by Climate.SetTargetRange if the low target temperature is above the high target
+// temperature.
+type TargetRangeError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Low and High are the target temperatures.
+	Low, High float32
+}
+
+func (err TargetRangeError) Error() string {
+	return fmt.Sprintf("esphome: %s low target temperature %g is above high target temperature %g", err.ObjectID,
+		err.Low, err.High)
+}
+
+// OptionError is returned by commands if an option is not one of the options supported by an entity.
+type OptionError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Option that is not supported.
+	Option string
+}
+
+func (err OptionError) Error() string {
+	return fmt.Sprintf("esphome: %s has no option %q", err.ObjectID, err.Option)
+}
+
+// CodeError is returned by commands if a code is required but missing, or if it doesn't match the code format of an
+// entity.
+type CodeError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Format the code doesn't match, empty if the code is missing.
+	Format string
+}
+
+func (err CodeError) Error() string {
+	if err.Format == "" {
+		return fmt.Sprintf("esphome: %s requires a code", err.ObjectID)
+	}
+	return fmt.Sprintf("esphome: %s code does not match format %q", err.ObjectID, err.Format)
+}
+
+// LengthError is returned by commands if the length of a value is outside of the range supported by an entity.
+type LengthError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Length of the value in bytes.
+	Length int
+
+	// Min and Max are the supported range, a Max of 0 is unbounded.
+	Min, Max int
+}
+
+func (err LengthError) Error() string {
+	if err.Max == 0 {
+		return fmt.Sprintf("esphome: %s length %d less than %d", err.ObjectID, err.Length, err.Min)
+	}
+	return fmt.Sprintf("esphome: %s length %d out of range [%d, %d]", err.ObjectID, err.Length, err.Min, err.Max)
+}
+
+// PatternError is returned by commands if a value doesn't match the pattern of an entity.
+type PatternError struct {
+	// ObjectID of the entity.
+	ObjectID string
+
+	// Pattern the value doesn't match.
+	Pattern string
+}
+
+func (err PatternError) Error() string {
+	return fmt.Sprintf("esphome: %s value does not match pattern %q", err.ObjectID, err.Pattern)
+}
+
+// ArgumentError is returned by Service.Execute if an argument is invalid.
+type ArgumentError struct {
+	// Service name.
+	Service string
+
+	// Argument name.
+	Argument string
+
+	// Reason the argument is invalid.
+	Reason string
+}
+
+func (err ArgumentError) Error() string {
+	return fmt.Sprintf("esphome: service %s argument %s: %s", err.Service, err.Argument, err.Reason)
+}
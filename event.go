@@ -0,0 +1,425 @@
+package esphome
+
+import (
+	"sync"
+	"time"
+)
+
+// EntityKind identifies the type of an entity.
+type EntityKind int
+
+// Entity kinds.
+const (
+	KindUnknown EntityKind = iota
+	KindBinarySensor
+	KindCamera
+	KindClimate
+	KindCover
+	KindFan
+	KindLight
+	KindSensor
+	KindSwitch
+	KindTextSensor
+	KindNumber
+	KindSelect
+	KindLock
+	KindMediaPlayer
+	KindSiren
+	KindValve
+	KindAlarmControlPanel
+	KindText
+	KindDate
+	KindTime
+	KindDateTime
+	KindEvent
+	KindUpdate
+)
+
+func (kind EntityKind) String() string {
+	switch kind {
+	case KindBinarySensor:
+		return "binary sensor"
+	case KindCamera:
+		return "camera"
+	case KindClimate:
+		return "climate"
+	case KindCover:
+		return "cover"
+	case KindFan:
+		return "fan"
+	case KindLight:
+		return "light"
+	case KindSensor:
+		return "sensor"
+	case KindSwitch:
+		return "switch"
+	case KindTextSensor:
+		return "text sensor"
+	case KindNumber:
+		return "number"
+	case KindSelect:
+		return "select"
+	case KindLock:
+		return "lock"
+	case KindMediaPlayer:
+		return "media player"
+	case KindSiren:
+		return "siren"
+	case KindValve:
+		return "valve"
+	case KindAlarmControlPanel:
+		return "alarm control panel"
+	case KindText:
+		return "text"
+	case KindDate:
+		return "date"
+	case KindTime:
+		return "time"
+	case KindDateTime:
+		return "date time"
+	case KindEvent:
+		return "event"
+	case KindUpdate:
+		return "update"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a state update of an entity, see Client.Subscribe.
+//
+// The concrete types are AlarmControlPanelStateEvent, BinarySensorStateEvent, ClimateStateEvent, CoverStateEvent,
+// DateStateEvent, DateTimeStateEvent, FanStateEvent, FiredEvent, LightStateEvent, LockStateEvent,
+// MediaPlayerStateEvent, NumberStateEvent, SelectStateEvent, SensorStateEvent, SirenStateEvent, SwitchStateEvent,
+// TextStateEvent, TextSensorStateEvent, TimeStateEvent, UpdateStateEvent and ValveStateEvent.
+type Event interface {
+	// Kind of entity that was updated.
+	Kind() EntityKind
+
+	// Entity that was updated.
+	Entity() *Entity
+
+	// Time the update was received.
+	Time() time.Time
+}
+
+type event struct {
+	kind   EntityKind
+	entity *Entity
+	time   time.Time
+}
+
+func newEvent(kind EntityKind, entity *Entity, received time.Time) event {
+	return event{
+		kind:   kind,
+		entity: entity,
+		time:   received,
+	}
+}
+
+func (e event) Kind() EntityKind { return e.kind }
+func (e event) Entity() *Entity  { return e.entity }
+func (e event) Time() time.Time  { return e.time }
+
+// AlarmControlPanelStateEvent is an AlarmControlPanel state update.
+type AlarmControlPanelStateEvent struct {
+	event
+	AlarmControlPanel *AlarmControlPanel
+	Previous, State   AlarmControlPanelState
+}
+
+// BinarySensorStateEvent is a BinarySensor state update.
+type BinarySensorStateEvent struct {
+	event
+	BinarySensor    *BinarySensor
+	Previous, State bool
+
+	// PreviousIsValid and StateIsValid indicate if the binary sensor had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// ClimateStateEvent is a Climate state update.
+type ClimateStateEvent struct {
+	event
+	Climate         *Climate
+	Previous, State ClimateState
+}
+
+// CoverStateEvent is a Cover state update.
+type CoverStateEvent struct {
+	event
+	Cover           *Cover
+	Previous, State CoverState
+}
+
+// DateStateEvent is a Date state update.
+type DateStateEvent struct {
+	event
+	Date            *Date
+	Previous, State CivilDate
+
+	// PreviousIsValid and StateIsValid indicate if the date had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// DateTimeStateEvent is a DateTime state update.
+type DateTimeStateEvent struct {
+	event
+	DateTime        *DateTime
+	Previous, State time.Time
+
+	// PreviousIsValid and StateIsValid indicate if the date time had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// FanStateEvent is a Fan state update.
+type FanStateEvent struct {
+	event
+	Fan             *Fan
+	Previous, State FanState
+}
+
+// FiredEvent is an event fired by an EventEntity, Time is the time the event was received.
+type FiredEvent struct {
+	event
+	EventEntity *EventEntity
+	EventType   string
+}
+
+// LightStateEvent is a Light state update.
+type LightStateEvent struct {
+	event
+	Light           *Light
+	Previous, State LightState
+}
+
+// LockStateEvent is a Lock state update.
+type LockStateEvent struct {
+	event
+	Lock            *Lock
+	Previous, State LockState
+
+	// PreviousIsValid indicates if the lock had a valid state.
+	PreviousIsValid bool
+}
+
+// MediaPlayerStateEvent is a MediaPlayer state update.
+type MediaPlayerStateEvent struct {
+	event
+	MediaPlayer     *MediaPlayer
+	Previous, State MediaPlayerState
+}
+
+// NumberStateEvent is a Number state update.
+type NumberStateEvent struct {
+	event
+	Number          *Number
+	Previous, State float32
+
+	// PreviousIsValid and StateIsValid indicate if the number had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// SelectStateEvent is a Select state update.
+type SelectStateEvent struct {
+	event
+	Select          *Select
+	Previous, State string
+
+	// PreviousIsValid and StateIsValid indicate if the select had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// SensorStateEvent is a Sensor state update.
+type SensorStateEvent struct {
+	event
+	Sensor          *Sensor
+	Previous, State float32
+
+	// PreviousIsValid and StateIsValid indicate if the sensor had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// SirenStateEvent is a Siren state update.
+type SirenStateEvent struct {
+	event
+	Siren           *Siren
+	Previous, State bool
+}
+
+// SwitchStateEvent is a Switch state update.
+type SwitchStateEvent struct {
+	event
+	Switch          *Switch
+	Previous, State bool
+}
+
+// TextStateEvent is a Text state update.
+type TextStateEvent struct {
+	event
+	Text            *Text
+	Previous, State string
+
+	// PreviousIsValid and StateIsValid indicate if the text had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// TextSensorStateEvent is a TextSensor state update.
+type TextSensorStateEvent struct {
+	event
+	TextSensor      *TextSensor
+	Previous, State string
+
+	// PreviousIsValid and StateIsValid indicate if the text sensor had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// TimeStateEvent is a Time state update.
+type TimeStateEvent struct {
+	event
+
+	// TimeEntity is the Time entity, Time is the time the update was received.
+	TimeEntity      *Time
+	Previous, State CivilTime
+
+	// PreviousIsValid and StateIsValid indicate if the time had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// UpdateStateEvent is an Update state update.
+type UpdateStateEvent struct {
+	event
+	Update          *Update
+	Previous, State UpdateState
+
+	// PreviousIsValid and StateIsValid indicate if the update had a valid state.
+	PreviousIsValid, StateIsValid bool
+}
+
+// ValveStateEvent is a Valve state update.
+type ValveStateEvent struct {
+	event
+	Valve           *Valve
+	Previous, State ValveState
+}
+
+// EventFilter selects events, see Client.Subscribe.
+//
+// An event matches if it matches every non-empty field of the filter, and a field matches if any of its values
+// matches. The zero value matches all events.
+type EventFilter struct {
+	Kinds     []EntityKind
+	ObjectIDs []string
+	UniqueIDs []string
+}
+
+func (filter EventFilter) match(event Event) bool {
+	if len(filter.Kinds) > 0 && !containsKind(filter.Kinds, event.Kind()) {
+		return false
+	}
+	entity := event.Entity()
+	if len(filter.ObjectIDs) > 0 && !containsString(filter.ObjectIDs, entity.ObjectID) {
+		return false
+	}
+	if len(filter.UniqueIDs) > 0 && !containsString(filter.UniqueIDs, entity.UniqueID) {
+		return false
+	}
+	return true
+}
+
+func containsKind(kinds []EntityKind, kind EntityKind) bool {
+	for _, other := range kinds {
+		if other == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, other := range values {
+		if other == value {
+			return true
+		}
+	}
+	return false
+}
+
+// eventSubscribers keeps track of the Client.Subscribe subscribers.
+type eventSubscribers struct {
+	mu          sync.Mutex
+	subscribers map[*eventSubscriber]struct{}
+	closed      bool
+}
+
+type eventSubscriber struct {
+	filter EventFilter
+	events chan Event
+}
+
+func (s *eventSubscribers) add(filter EventFilter, size int) *eventSubscriber {
+	subscriber := &eventSubscriber{
+		filter: filter,
+		events: make(chan Event, size),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(subscriber.events)
+		return subscriber
+	}
+	if s.subscribers == nil {
+		s.subscribers = make(map[*eventSubscriber]struct{})
+	}
+	s.subscribers[subscriber] = struct{}{}
+	return subscriber
+}
+
+func (s *eventSubscribers) remove(subscriber *eventSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subscribers[subscriber]; ok {
+		delete(s.subscribers, subscriber)
+		close(subscriber.events)
+	}
+}
+
+// publish delivers the event to all matching subscribers. Like the dispatcher, it never blocks: if a subscriber's
+// buffer is full, the event is dropped for that subscriber.
+func (s *eventSubscribers) publish(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for subscriber := range s.subscribers {
+		if !subscriber.filter.match(event) {
+			continue
+		}
+		select {
+		case subscriber.events <- event:
+		default:
+			// The subscriber doesn't keep up, drop the event.
+		}
+	}
+}
+
+// close removes all subscribers, closing their channels.
+func (s *eventSubscribers) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for subscriber := range s.subscribers {
+		close(subscriber.events)
+	}
+	s.subscribers = nil
+	s.closed = true
+}
+
+// Subscribe returns a channel that receives state update events of the entities matching the filter. An event is
+// published for every state update sent by the node, so Previous and State may be equal.
+//
+// Events are buffered; if the buffer is full, new events are dropped for the subscriber, so receivers should keep
+// up. The channel is closed by calling the returned cancel function, or when the client is closed.
+func (c *Client) Subscribe(filter EventFilter) (<-chan Event, func()) {
+	subscriber := c.events.add(filter, streamBuffer)
+	return subscriber.events, func() {
+		c.events.remove(subscriber)
+	}
+}
@@ -0,0 +1,83 @@
+package esphome
+
+import (
+	"testing"
+	"time"
+
+	"maze.io/x/esphome/api"
+)
+
+func TestEventFilter(t *testing.T) {
+	var (
+		sensor = &Sensor{Entity: Entity{ObjectID: "temperature", UniqueID: "test-temperature"}}
+		event  = SensorStateEvent{event: newEvent(KindSensor, &sensor.Entity, time.Now()), Sensor: sensor}
+	)
+	tests := []struct {
+		Name   string
+		Filter EventFilter
+		Match  bool
+	}{
+		{"all", EventFilter{}, true},
+		{"kind", EventFilter{Kinds: []EntityKind{KindSwitch, KindSensor}}, true},
+		{"other kind", EventFilter{Kinds: []EntityKind{KindSwitch}}, false},
+		{"object id", EventFilter{ObjectIDs: []string{"temperature"}}, true},
+		{"unique id", EventFilter{UniqueIDs: []string{"test-humidity"}}, false},
+		{"kind and object id", EventFilter{Kinds: []EntityKind{KindSensor}, ObjectIDs: []string{"humidity"}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(it *testing.T) {
+			if match := test.Filter.match(event); match != test.Match {
+				it.Errorf("expected match %t, got %t", test.Match, match)
+			}
+		})
+	}
+}
+
+func TestClientSubscribe(t *testing.T) {
+	d := newTestDevice(t,
+		&api.ListEntitiesSensorResponse{ObjectId: "temperature", Key: 1, UniqueId: "test-temperature"},
+		&api.ListEntitiesBinarySensorResponse{ObjectId: "door", Key: 2, UniqueId: "test-door"})
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	events, cancel := c.Subscribe(EventFilter{Kinds: []EntityKind{KindSensor}})
+	defer cancel()
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected state subscription, got %d", n)
+	}
+	d.Broadcast(&api.BinarySensorStateResponse{Key: 2, State: true})
+	d.Broadcast(&api.SensorStateResponse{Key: 1, State: 21.5})
+	d.Broadcast(&api.SensorStateResponse{Key: 1, State: 22})
+
+	for _, want := range []struct {
+		Previous, State float32
+		PreviousIsValid bool
+	}{
+		{0, 21.5, false},
+		{21.5, 22, true},
+	} {
+		select {
+		case event := <-events:
+			state, ok := event.(SensorStateEvent)
+			if !ok {
+				t.Fatalf("expected SensorStateEvent, got %T", event)
+			}
+			if state.Previous != want.Previous || state.State != want.State || state.PreviousIsValid != want.PreviousIsValid {
+				t.Errorf("unexpected event %+v", state)
+			}
+			if state.Entity().UniqueID != "test-temperature" || state.Time().IsZero() {
+				t.Errorf("unexpected event source %+v at %s", state.Entity(), state.Time())
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for event")
+		}
+	}
+
+	cancel()
+	if _, ok := <-events; ok {
+		t.Error("expected channel to be closed")
+	}
+}
@@ -0,0 +1,430 @@
+package esphome
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome/api"
+)
+
+// StateProvider provides Home Assistant entity states to nodes, see Client.ServeStates.
+type StateProvider interface {
+	// State returns the current state of a Home Assistant entity, ok is false if the state is unknown.
+	State(entityID string) (state string, ok bool)
+
+	// Watch calls update whenever the state of a Home Assistant entity changes, until cancel is called.
+	Watch(entityID string, update func(state string)) (cancel func())
+}
+
+// StateMap is an in-memory StateProvider.
+type StateMap struct {
+	mu       sync.Mutex
+	states   map[string]string
+	watchers map[string]map[*stateWatcher]struct{}
+}
+
+type stateWatcher struct {
+	update func(string)
+}
+
+// NewStateMap returns an empty StateMap.
+func NewStateMap() *StateMap {
+	return &StateMap{
+		states:   make(map[string]string),
+		watchers: make(map[string]map[*stateWatcher]struct{}),
+	}
+}
+
+// Set the state of a Home Assistant entity, watchers are notified if the state changed.
+func (m *StateMap) Set(entityID, state string) {
+	m.mu.Lock()
+	if previous, ok := m.states[entityID]; ok && previous == state {
+		m.mu.Unlock()
+		return
+	}
+	m.states[entityID] = state
+	watchers := make([]*stateWatcher, 0, len(m.watchers[entityID]))
+	for watcher := range m.watchers[entityID] {
+		watchers = append(watchers, watcher)
+	}
+	m.mu.Unlock()
+
+	// Notify outside of the lock, so watchers may call back into the map.
+	for _, watcher := range watchers {
+		watcher.update(state)
+	}
+}
+
+// State implements StateProvider.
+func (m *StateMap) State(entityID string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[entityID]
+	return state, ok
+}
+
+// Watch implements StateProvider.
+func (m *StateMap) Watch(entityID string, update func(string)) func() {
+	watcher := &stateWatcher{update: update}
+	m.mu.Lock()
+	if m.watchers[entityID] == nil {
+		m.watchers[entityID] = make(map[*stateWatcher]struct{})
+	}
+	m.watchers[entityID][watcher] = struct{}{}
+	m.mu.Unlock()
+	return func() {
+		m.mu.Lock()
+		delete(m.watchers[entityID], watcher)
+		if len(m.watchers[entityID]) == 0 {
+			delete(m.watchers, entityID)
+		}
+		m.mu.Unlock()
+	}
+}
+
+// ServeStates makes the client the source of Home Assistant states for the node, replacing Home Assistant for nodes
+// that use homeassistant sensors. The node requests the states of the entities it needs; the client answers with
+// the current state from the provider, and pushes updates whenever the provider reports a change.
+//
+// The states are served in the background, until the client is closed.
+func (c *Client) ServeStates(provider StateProvider) error {
+	return c.ServeStatesContext(context.Background(), provider)
+}
+
+// ServeStatesContext is like ServeStates with a context, the states are served until the context is done or the
+// client is closed.
+func (c *Client) ServeStatesContext(ctx context.Context, provider StateProvider) error {
+	requests := c.dispatcher.subscribe(streamBuffer, api.SubscribeHomeAssistantStateResponseType)
+
+	sendCtx, cancel := timeoutContext(ctx, c.Timeout)
+	defer cancel()
+	request := &api.SubscribeHomeAssistantStatesRequest{}
+	if err := c.sendContext(sendCtx, request); err != nil {
+		c.dispatcher.cancel(requests)
+		return err
+	}
+	c.subscribe(requests, request)
+
+	go c.serveStates(ctx, provider, requests)
+	return nil
+}
+
+func (c *Client) serveStates(ctx context.Context, provider StateProvider, requests *subscription) {
+	// Entities that are watched, nodes request their entities again after a reconnect.
+	var (
+		watches = make(map[string]func())
+		updates = newStateUpdates()
+	)
+	defer func() {
+		for _, cancel := range watches {
+			cancel()
+		}
+	}()
+	defer c.dispatcher.cancel(requests)
+	defer c.unsubscribe(requests)
+
+	for {
+		var message proto.Message
+		select {
+		case message = <-requests.C:
+		case <-updates.notify:
+			for entityID, state := range updates.take() {
+				c.sendState(entityID, state)
+			}
+			continue
+		case <-ctx.Done():
+			return
+		case <-c.done:
+			return
+		}
+
+		// A pending update is older than the current state, which is read after discarding it.
+		entityID := message.(*api.SubscribeHomeAssistantStateResponse).EntityId
+		updates.discard(entityID)
+		if state, ok := provider.State(entityID); ok {
+			c.sendState(entityID, state)
+		}
+		if _, ok := watches[entityID]; !ok {
+			watches[entityID] = provider.Watch(entityID, func(state string) {
+				updates.set(entityID, state)
+			})
+		}
+	}
+}
+
+// stateUpdates are the state changes reported by a StateProvider, they are handed to the goroutine serving the
+// states so watchers never wait for the node. Only the latest state of an entity is kept.
+type stateUpdates struct {
+	mu     sync.Mutex
+	states map[string]string
+	notify chan struct{}
+}
+
+func newStateUpdates() *stateUpdates {
+	return &stateUpdates{
+		states: make(map[string]string),
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// set the latest state of an entity, and notify the serving goroutine.
+func (updates *stateUpdates) set(entityID, state string) {
+	updates.mu.Lock()
+	updates.states[entityID] = state
+	updates.mu.Unlock()
+	select {
+	case updates.notify <- struct{}{}:
+	default:
+		// A notification is pending already.
+	}
+}
+
+// discard the pending state of an entity.
+func (updates *stateUpdates) discard(entityID string) {
+	updates.mu.Lock()
+	delete(updates.states, entityID)
+	updates.mu.Unlock()
+}
+
+// take the pending states.
+func (updates *stateUpdates) take() map[string]string {
+	updates.mu.Lock()
+	defer updates.mu.Unlock()
+	states := updates.states
+	updates.states = make(map[string]string)
+	return states
+}
+
+// sendState sends a Home Assistant state to the node. Errors are ignored: if the connection is lost, the node
+// requests the state again after reconnecting.
+func (c *Client) sendState(entityID, state string) {
+	ctx, cancel := c.context()
+	defer cancel()
+	_ = c.sendContext(ctx, &api.HomeAssistantStateResponse{
+		EntityId: entityID,
+		State:    state,
+	})
+}
+
+// HomeAssistantAction is a Home Assistant service call or event sent by a node, see Client.HomeAssistantServices.
+//
+// The concrete types are ServiceCall and HomeAssistantEvent.
+type HomeAssistantAction interface {
+	// Name of the service or event.
+	Name() string
+
+	// Values of the action.
+	Values() HomeAssistantData
+}
+
+// HomeAssistantData are the values of a Home Assistant service call or event.
+type HomeAssistantData struct {
+	// Data are static values.
+	Data map[string]string
+
+	// DataTemplate are templates, that are rendered against the Variables.
+	DataTemplate map[string]string
+
+	// Variables for rendering the DataTemplate.
+	Variables map[string]string
+}
+
+// Render the DataTemplate against the Variables, it returns the Data merged with the rendered templates.
+func (data HomeAssistantData) Render(renderer TemplateRenderer) (map[string]string, error) {
+	values := make(map[string]string, len(data.Data)+len(data.DataTemplate))
+	for key, value := range data.Data {
+		values[key] = value
+	}
+	for key, template := range data.DataTemplate {
+		value, err := renderer.Render(template, data.Variables)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// ServiceCall is a Home Assistant service call, such as light.turn_on.
+type ServiceCall struct {
+	Service string
+	HomeAssistantData
+}
+
+// Name of the service.
+func (call ServiceCall) Name() string { return call.Service }
+
+// Values of the service call.
+func (call ServiceCall) Values() HomeAssistantData { return call.HomeAssistantData }
+
+// HomeAssistantEvent is a Home Assistant event, such as esphome.button_pressed.
+type HomeAssistantEvent struct {
+	Event string
+	HomeAssistantData
+}
+
+// Name of the event.
+func (event HomeAssistantEvent) Name() string { return event.Event }
+
+// Values of the event.
+func (event HomeAssistantEvent) Values() HomeAssistantData { return event.HomeAssistantData }
+
+func newHomeAssistantAction(message *api.HomeassistantServiceResponse) HomeAssistantAction {
+	data := HomeAssistantData{
+		Data:         serviceMap(message.Data),
+		DataTemplate: serviceMap(message.DataTemplate),
+		Variables:    serviceMap(message.Variables),
+	}
+	if message.IsEvent {
+		return HomeAssistantEvent{Event: message.Service, HomeAssistantData: data}
+	}
+	return ServiceCall{Service: message.Service, HomeAssistantData: data}
+}
+
+func serviceMap(items []*api.HomeassistantServiceMap) map[string]string {
+	values := make(map[string]string, len(items))
+	for _, item := range items {
+		values[item.Key] = item.Value
+	}
+	return values
+}
+
+// HomeAssistantServices streams the Home Assistant service calls and events sent by the node.
+func (c *Client) HomeAssistantServices() (<-chan HomeAssistantAction, error) {
+	return c.HomeAssistantServicesContext(context.Background())
+}
+
+// HomeAssistantServicesContext is like HomeAssistantServices with a context. The returned channel is closed once
+// the context is done or the client is closed.
+func (c *Client) HomeAssistantServicesContext(ctx context.Context) (<-chan HomeAssistantAction, error) {
+	services := c.dispatcher.subscribe(streamBuffer, api.HomeAssistantServiceResponseType)
+
+	sendCtx, cancel := timeoutContext(ctx, c.Timeout)
+	defer cancel()
+	request := &api.SubscribeHomeassistantServicesRequest{}
+	if err := c.sendContext(sendCtx, request); err != nil {
+		c.dispatcher.cancel(services)
+		return nil, err
+	}
+	c.subscribe(services, request)
+
+	out := make(chan HomeAssistantAction)
+	go func(services *subscription, out chan HomeAssistantAction) {
+		defer close(out)
+		defer c.dispatcher.cancel(services)
+		defer c.unsubscribe(services)
+		for {
+			var message proto.Message
+			select {
+			case message = <-services.C:
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
+			}
+
+			select {
+			case out <- newHomeAssistantAction(message.(*api.HomeassistantServiceResponse)):
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
+			}
+		}
+	}(services, out)
+
+	return out, nil
+}
+
+// ServiceMux dispatches Home Assistant service calls and events to handlers registered by name.
+type ServiceMux struct {
+	// Renderer renders the data templates, rendered values are merged into the Data of service calls and events
+	// before they are handled. If nil, templates are not rendered.
+	Renderer TemplateRenderer
+
+	// HandleError is called if a template can't be rendered, the action is not handled.
+	HandleError func(HomeAssistantAction, error)
+
+	// HandleUnknown is called for actions without handler.
+	HandleUnknown func(HomeAssistantAction)
+
+	mu       sync.RWMutex
+	services map[string]func(ServiceCall)
+	events   map[string]func(HomeAssistantEvent)
+}
+
+// NewServiceMux returns a ServiceMux without handlers.
+func NewServiceMux() *ServiceMux {
+	return &ServiceMux{
+		services: make(map[string]func(ServiceCall)),
+		events:   make(map[string]func(HomeAssistantEvent)),
+	}
+}
+
+// Handle registers the handler for a service, such as light.turn_on.
+func (mux *ServiceMux) Handle(service string, handler func(ServiceCall)) {
+	mux.mu.Lock()
+	mux.services[service] = handler
+	mux.mu.Unlock()
+}
+
+// HandleEvent registers the handler for an event.
+func (mux *ServiceMux) HandleEvent(event string, handler func(HomeAssistantEvent)) {
+	mux.mu.Lock()
+	mux.events[event] = handler
+	mux.mu.Unlock()
+}
+
+// Dispatch an action to its handler, it reports if there was a handler.
+func (mux *ServiceMux) Dispatch(action HomeAssistantAction) bool {
+	mux.mu.RLock()
+	var (
+		service func(ServiceCall)
+		event   func(HomeAssistantEvent)
+	)
+	switch action := action.(type) {
+	case ServiceCall:
+		service = mux.services[action.Service]
+	case HomeAssistantEvent:
+		event = mux.events[action.Event]
+	}
+	mux.mu.RUnlock()
+
+	if service == nil && event == nil {
+		if mux.HandleUnknown != nil {
+			mux.HandleUnknown(action)
+		}
+		return false
+	}
+
+	data := action.Values()
+	if mux.Renderer != nil && len(data.DataTemplate) > 0 {
+		values, err := data.Render(mux.Renderer)
+		if err != nil {
+			if mux.HandleError != nil {
+				mux.HandleError(action, err)
+			}
+			return true
+		}
+		data.Data = values
+	}
+
+	switch action := action.(type) {
+	case ServiceCall:
+		action.HomeAssistantData = data
+		service(action)
+	case HomeAssistantEvent:
+		action.HomeAssistantData = data
+		event(action)
+	}
+	return true
+}
+
+// Serve dispatches all actions, until the channel is closed.
+func (mux *ServiceMux) Serve(actions <-chan HomeAssistantAction) {
+	for action := range actions {
+		mux.Dispatch(action)
+	}
+}
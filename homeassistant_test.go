@@ -0,0 +1,164 @@
+package esphome
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome/api"
+)
+
+func TestClientServeStates(t *testing.T) {
+	d := newTestDevice(t)
+	d.handle = func(conn *testConn, message proto.Message) {
+		if _, ok := message.(*api.SubscribeHomeAssistantStatesRequest); ok {
+			_ = conn.send(&api.SubscribeHomeAssistantStateResponse{EntityId: "sun.sun"})
+			_ = conn.send(&api.SubscribeHomeAssistantStateResponse{EntityId: "sensor.unknown"})
+		}
+	}
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	states := NewStateMap()
+	states.Set("sun.sun", "above_horizon")
+	if err := c.ServeStates(states); err != nil {
+		t.Fatal(err)
+	}
+
+	state := lastRequest(t, d, api.HomeAssistantStateResponseType, 1).(*api.HomeAssistantStateResponse)
+	if state.EntityId != "sun.sun" || state.State != "above_horizon" {
+		t.Errorf("unexpected state %+v", state)
+	}
+
+	// Wait for the unknown entity to be watched.
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		states.mu.Lock()
+		n := len(states.watchers)
+		states.mu.Unlock()
+		if n == 2 {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("expected 2 watched entities, got %d", n)
+		}
+	}
+	states.Set("sensor.unknown", "42")
+	state = lastRequest(t, d, api.HomeAssistantStateResponseType, 2).(*api.HomeAssistantStateResponse)
+	if state.EntityId != "sensor.unknown" || state.State != "42" {
+		t.Errorf("unexpected state %+v", state)
+	}
+}
+
+func TestClientServeStatesSlowNode(t *testing.T) {
+	d := newTestDevice(t)
+	d.handle = func(conn *testConn, message proto.Message) {
+		if _, ok := message.(*api.SubscribeHomeAssistantStatesRequest); ok {
+			_ = conn.send(&api.SubscribeHomeAssistantStateResponse{EntityId: "sensor.value"})
+		}
+	}
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	states := NewStateMap()
+	if err := c.ServeStates(states); err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		states.mu.Lock()
+		n := len(states.watchers)
+		states.mu.Unlock()
+		if n == 1 {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatal("expected the entity to be watched")
+		}
+	}
+
+	// Setting states doesn't wait for the node, while sending is blocked the updates are coalesced.
+	c.writeMutex.Lock()
+	set := make(chan struct{})
+	go func() {
+		defer close(set)
+		for _, state := range []string{"1", "2", "3"} {
+			states.Set("sensor.value", state)
+		}
+	}()
+	select {
+	case <-set:
+	case <-time.After(time.Second):
+		c.writeMutex.Unlock()
+		t.Fatal("setting states is blocked by the node")
+	}
+	c.writeMutex.Unlock()
+
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		sent := d.Requests(api.HomeAssistantStateResponseType)
+		if n := len(sent); n > 0 && sent[n-1].(*api.HomeAssistantStateResponse).State == "3" {
+			if n > 2 {
+				t.Errorf("expected the updates to be coalesced, got %d states", n)
+			}
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("expected the latest state to be sent, got %d states", n)
+		}
+	}
+}
+
+func TestClientHomeAssistantServices(t *testing.T) {
+	d := newTestDevice(t)
+	d.handle = func(conn *testConn, message proto.Message) {
+		if _, ok := message.(*api.SubscribeHomeassistantServicesRequest); ok {
+			_ = conn.send(&api.HomeassistantServiceResponse{
+				Service:      "light.turn_on",
+				Data:         []*api.HomeassistantServiceMap{{Key: "entity_id", Value: "light.kitchen"}},
+				DataTemplate: []*api.HomeassistantServiceMap{{Key: "brightness", Value: "{{ level | int }}"}},
+				Variables:    []*api.HomeassistantServiceMap{{Key: "level", Value: "42.5"}},
+			})
+			_ = conn.send(&api.HomeassistantServiceResponse{
+				Service: "esphome.button_pressed",
+				IsEvent: true,
+			})
+		}
+	}
+	defer d.Close()
+
+	c := testClient(t, d)
+	defer c.Close()
+
+	actions, err := c.HomeAssistantServices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mux     = NewServiceMux()
+		calls   = make(chan ServiceCall, 1)
+		events  = make(chan HomeAssistantEvent, 1)
+		timeout = time.After(time.Second)
+	)
+	mux.Renderer = JinjaRenderer{}
+	mux.Handle("light.turn_on", func(call ServiceCall) { calls <- call })
+	mux.HandleEvent("esphome.button_pressed", func(event HomeAssistantEvent) { events <- event })
+	go mux.Serve(actions)
+
+	select {
+	case call := <-calls:
+		if call.Data["entity_id"] != "light.kitchen" || call.Data["brightness"] != "42" {
+			t.Errorf("unexpected service call data %v", call.Data)
+		}
+	case <-timeout:
+		t.Fatal("timeout waiting for service call")
+	}
+	select {
+	case event := <-events:
+		if event.Name() != "esphome.button_pressed" {
+			t.Errorf("unexpected event %q", event.Name())
+		}
+	case <-timeout:
+		t.Fatal("timeout waiting for event")
+	}
+}
@@ -0,0 +1,221 @@
+package esphome
+
+import (
+	"context"
+	"time"
+)
+
+// Reconnect defaults.
+const (
+	DefaultReconnectMinDelay = time.Second
+	DefaultReconnectMaxDelay = time.Minute
+	DefaultReconnectFactor   = 2
+)
+
+// ReconnectOptions configures automatic reconnection, see DialReconnect.
+type ReconnectOptions struct {
+	// MinDelay is the delay before the first reconnection attempt.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum delay between reconnection attempts.
+	MaxDelay time.Duration
+
+	// Factor by which the delay grows after each failed attempt.
+	Factor float64
+
+	// PSK is the base64 encoded encryption key, for nodes that use encryption.
+	PSK string
+
+	// MaxAttempts is the number of consecutive failed attempts before giving up, zero means retry forever.
+	MaxAttempts int
+
+	// HandleConnection is called for every connection state change. It is called from the goroutine managing the
+	// connection, so it should not block.
+	HandleConnection func(ConnectionEvent)
+}
+
+// ConnectionState is the state of a reconnecting client.
+type ConnectionState int
+
+// Connection states.
+const (
+	// StateConnecting indicates a connection attempt is started.
+	StateConnecting ConnectionState = iota
+
+	// StateConnected indicates the connection is established and the client is logged in.
+	StateConnected
+
+	// StateDisconnected indicates the connection was lost.
+	StateDisconnected
+
+	// StateGaveUp indicates the client stopped reconnecting, the client is closed.
+	StateGaveUp
+)
+
+func (state ConnectionState) String() string {
+	switch state {
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateDisconnected:
+		return "disconnected"
+	case StateGaveUp:
+		return "gave up"
+	default:
+		return "unknown"
+	}
+}
+
+// ConnectionEvent describes a connection state change.
+type ConnectionEvent struct {
+	// State is the new connection state.
+	State ConnectionState
+
+	// Attempt is the reconnection attempt, starting at 1.
+	Attempt int
+
+	// Err is the error that caused the state change, if any.
+	Err error
+
+	// Time of the state change.
+	Time time.Time
+}
+
+// DialReconnect connects and logs in to the ESPHome native API on the supplied TCP address. When the connection
+// is lost, the client reconnects using exponential backoff, logs in with the same password, rebinds the existing
+// entities by their unique identifier and restores the state and log subscriptions.
+//
+// Calls made while the client is disconnected return an error. The initial connection is not retried.
+func DialReconnect(ctx context.Context, addr, password string, options ReconnectOptions) (*Client, error) {
+	if options.MinDelay <= 0 {
+		options.MinDelay = DefaultReconnectMinDelay
+	}
+	if options.MaxDelay < options.MinDelay {
+		options.MaxDelay = DefaultReconnectMaxDelay
+		if options.MaxDelay < options.MinDelay {
+			options.MaxDelay = options.MinDelay
+		}
+	}
+	if options.Factor < 1 {
+		options.Factor = DefaultReconnectFactor
+	}
+
+	var (
+		c   *Client
+		err error
+	)
+	if options.PSK != "" {
+		c, err = DialEncryptedContext(ctx, addr, options.PSK)
+	} else {
+		c, err = DialContext(ctx, addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Reconnecting is set during the initial login, so a failing login doesn't start a reconnect.
+	c.mu.Lock()
+	c.password = password
+	c.reconnect = &options
+	c.reconnecting = true
+	c.mu.Unlock()
+
+	if err = c.LoginContext(ctx, password); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	c.connected()
+	return c, nil
+}
+
+// connected clears the reconnecting state, and starts reconnecting if the connection dropped in the meantime.
+func (c *Client) connected() {
+	c.mu.Lock()
+	c.reconnecting = false
+	c.mu.Unlock()
+
+	if _, connDone := c.connection(); isClosed(connDone) {
+		c.disconnected()
+	}
+}
+
+func isClosed(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+// reconnectLoop reconnects until it succeeds, gives up or the client is closed.
+func (c *Client) reconnectLoop() {
+	c.mu.RLock()
+	var (
+		options  = *c.reconnect
+		password = c.password
+		err      = c.err
+	)
+	c.mu.RUnlock()
+
+	c.emit(options, ConnectionEvent{State: StateDisconnected, Err: err})
+
+	delay := options.MinDelay
+	for attempt := 1; ; attempt++ {
+		// Also wait before the first attempt, a node that dropped the connection is likely rebooting.
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-c.done:
+			timer.Stop()
+			return
+		}
+
+		c.emit(options, ConnectionEvent{State: StateConnecting, Attempt: attempt})
+		if err = c.redial(password); err == nil {
+			c.emit(options, ConnectionEvent{State: StateConnected, Attempt: attempt})
+			c.connected()
+			return
+		}
+
+		if c.isDone() {
+			return
+		}
+		if options.MaxAttempts > 0 && attempt >= options.MaxAttempts {
+			c.emit(options, ConnectionEvent{State: StateGaveUp, Attempt: attempt, Err: err})
+			c.finish()
+			return
+		}
+
+		if delay = time.Duration(float64(delay) * options.Factor); delay > options.MaxDelay {
+			delay = options.MaxDelay
+		}
+	}
+}
+
+// redial establishes a new connection and logs in.
+func (c *Client) redial(password string) error {
+	ctx, cancel := c.context()
+	defer cancel()
+	if err := c.connect(ctx); err != nil {
+		return err
+	}
+
+	loginCtx, loginCancel := c.context()
+	defer loginCancel()
+	if err := c.LoginContext(loginCtx, password); err != nil {
+		conn, connDone := c.connection()
+		_ = conn.Close()
+		<-connDone
+		return err
+	}
+	return nil
+}
+
+func (c *Client) emit(options ReconnectOptions, event ConnectionEvent) {
+	if options.HandleConnection != nil {
+		event.Time = c.Clock()
+		options.HandleConnection(event)
+	}
+}
@@ -0,0 +1,264 @@
+package esphome
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"maze.io/x/esphome/api"
+)
+
+func TestDialReconnect(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesSensorResponse{
+		ObjectId: "temperature",
+		Key:      1,
+		Name:     "Temperature",
+		UniqueId: "test-temperature",
+	})
+	defer d.Close()
+
+	events := make(chan ConnectionEvent, 16)
+	c, err := DialReconnect(context.Background(), d.Addr(), "", ReconnectOptions{
+		MinDelay:         10 * time.Millisecond,
+		HandleConnection: func(event ConnectionEvent) { events <- event },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	sensor := c.Entities().Sensor["test-temperature"]
+	if sensor == nil {
+		t.Fatal("sensor not found")
+	}
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected 1 state subscription, got %d", n)
+	}
+
+	// Node reboots with a different key for the same entity.
+	d.entities[0].(*api.ListEntitiesSensorResponse).Key = 2
+	d.Drop()
+
+	for _, want := range []ConnectionState{StateDisconnected, StateConnecting, StateConnected} {
+		select {
+		case event := <-events:
+			if event.State != want {
+				t.Fatalf("expected %s, got %s (%v)", want, event.State, event.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s", want)
+		}
+	}
+
+	if got := c.Entities().Sensor["test-temperature"]; got != sensor {
+		t.Fatalf("expected sensor to be rebound, got %p, want %p", got, sensor)
+	}
+	if sensor.Key != 2 {
+		t.Fatalf("expected rebound key 2, got %d", sensor.Key)
+	}
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 2); n != 2 {
+		t.Fatalf("expected 2 state subscriptions, got %d", n)
+	}
+	if _, err = c.DeviceInfo(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDialReconnectListing(t *testing.T) {
+	d := newTestDevice(t, &api.ListEntitiesNumberResponse{
+		ObjectId: "setpoint",
+		Key:      1,
+		Name:     "Setpoint",
+		UniqueId: "test-setpoint",
+		MaxValue: 10,
+		Step:     1,
+	}, &api.ListEntitiesLightResponse{
+		ObjectId: "strip",
+		Key:      2,
+		Name:     "Strip",
+		UniqueId: "test-strip",
+		Effects:  []string{"rainbow"},
+	})
+	defer d.Close()
+
+	events := make(chan ConnectionEvent, 16)
+	c, err := DialReconnect(context.Background(), d.Addr(), "", ReconnectOptions{
+		MinDelay:         10 * time.Millisecond,
+		HandleConnection: func(event ConnectionEvent) { events <- event },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var (
+		number = c.Entities().Number["test-setpoint"]
+		light  = c.Entities().Light["test-strip"]
+		states = make(chan float32, 1)
+	)
+	number.HandleState = func(state float32) { states <- state }
+	d.Broadcast(&api.NumberStateResponse{Key: 1, State: 5})
+	select {
+	case <-states:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for number state")
+	}
+
+	// Node is updated with a wider range and another effect.
+	d.entities[0].(*api.ListEntitiesNumberResponse).MaxValue = 20
+	d.entities[1].(*api.ListEntitiesLightResponse).Effects = []string{"rainbow", "strobe"}
+	d.Drop()
+
+	for _, want := range []ConnectionState{StateDisconnected, StateConnecting, StateConnected} {
+		select {
+		case event := <-events:
+			if event.State != want {
+				t.Fatalf("expected %s, got %s (%v)", want, event.State, event.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s", want)
+		}
+	}
+
+	if got := c.Entities().Number["test-setpoint"]; got != number {
+		t.Fatalf("expected number to be rebound, got %p, want %p", got, number)
+	}
+	if number.Max != 20 {
+		t.Errorf("expected rebound max 20, got %g", number.Max)
+	}
+	if !number.StateIsValid || number.State != 5 {
+		t.Errorf("expected state 5 to be retained, got %g (valid %t)", number.State, number.StateIsValid)
+	}
+	if number.HandleState == nil {
+		t.Error("expected handler to be retained")
+	}
+	if len(light.Effects) != 2 {
+		t.Errorf("expected rebound effects, got %q", light.Effects)
+	}
+}
+
+func TestDialReconnectSubscriptions(t *testing.T) {
+	d := newTestDevice(t)
+	defer d.Close()
+
+	events := make(chan ConnectionEvent, 16)
+	c, err := DialReconnect(context.Background(), d.Addr(), "", ReconnectOptions{
+		MinDelay:         10 * time.Millisecond,
+		HandleConnection: func(event ConnectionEvent) { events <- event },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err = c.LogsContext(ctx, LogDebug); err != nil {
+		t.Fatal(err)
+	}
+	otherCtx, otherCancel := context.WithCancel(context.Background())
+	other, err := c.LogsContext(otherCtx, LogInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := d.WaitRequests(api.SubscribeLogsRequestType, 2); n != 2 {
+		t.Fatalf("expected 2 log subscriptions, got %d", n)
+	}
+
+	// Cancelling one subscription must not cancel the other.
+	otherCancel()
+	for range other {
+	}
+	d.Drop()
+
+	for _, want := range []ConnectionState{StateDisconnected, StateConnecting, StateConnected} {
+		select {
+		case event := <-events:
+			if event.State != want {
+				t.Fatalf("expected %s, got %s (%v)", want, event.State, event.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s", want)
+		}
+	}
+
+	if n := d.WaitRequests(api.SubscribeLogsRequestType, 3); n != 3 {
+		t.Fatalf("expected 3 log subscriptions, got %d", n)
+	}
+	requests := d.Requests(api.SubscribeLogsRequestType)
+	if level := requests[2].(*api.SubscribeLogsRequest).Level; level != api.LogLevel(LogDebug) {
+		t.Fatalf("expected replayed level %s, got %s", api.LogLevel(LogDebug), level)
+	}
+}
+
+func TestDialReconnectGiveUp(t *testing.T) {
+	d := newTestDevice(t)
+
+	events := make(chan ConnectionEvent, 16)
+	c, err := DialReconnect(context.Background(), d.Addr(), "", ReconnectOptions{
+		MinDelay:         time.Millisecond,
+		MaxAttempts:      2,
+		HandleConnection: func(event ConnectionEvent) { events <- event },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Close()
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.State == StateGaveUp {
+				if event.Attempt != 2 {
+					t.Fatalf("expected to give up after 2 attempts, got %d", event.Attempt)
+				}
+				if _, err = c.DeviceInfo(); err == nil {
+					t.Fatal("expected error after giving up")
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatal("timeout waiting to give up")
+		}
+	}
+}
+
+func TestDialReconnectMinDelay(t *testing.T) {
+	d := newTestDevice(t)
+	defer d.Close()
+
+	const delay = 100 * time.Millisecond
+	events := make(chan ConnectionEvent, 16)
+	c, err := DialReconnect(context.Background(), d.Addr(), "", ReconnectOptions{
+		MinDelay:         delay,
+		HandleConnection: func(event ConnectionEvent) { events <- event },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if n := d.WaitRequests(api.SubscribeStatesRequestType, 1); n != 1 {
+		t.Fatalf("expected 1 state subscription, got %d", n)
+	}
+	d.Drop()
+
+	var disconnected time.Time
+	for _, want := range []ConnectionState{StateDisconnected, StateConnecting} {
+		select {
+		case event := <-events:
+			if event.State != want {
+				t.Fatalf("expected %s, got %s (%v)", want, event.State, event.Err)
+			}
+			if want == StateDisconnected {
+				disconnected = event.Time
+			} else if waited := event.Time.Sub(disconnected); waited < delay {
+				t.Fatalf("expected the first attempt after %s, got %s", delay, waited)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s", want)
+		}
+	}
+}
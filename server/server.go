@@ -0,0 +1,496 @@
+// Package server implements the device side of the ESPHome native API, it can be used to emulate ESPHome nodes.
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome"
+	"maze.io/x/esphome/api"
+)
+
+// Native API version implemented by the server.
+const (
+	APIVersionMajor = 1
+	APIVersionMinor = 10
+)
+
+// Errors.
+var (
+	ErrClosed    = errors.New("esphome/server: server closed")
+	ErrDuplicate = errors.New("esphome/server: duplicate entity key")
+	ErrKey       = errors.New("esphome/server: entity has no key")
+	ErrOverflow  = errors.New("esphome/server: client send queue overflow")
+	ErrPSK       = errors.New("esphome/server: invalid encryption key")
+)
+
+const (
+	// sendQueueSize is the number of pending sends per client, a client that falls further behind is dropped.
+	sendQueueSize = 64
+
+	// writeTimeout is the time allowed to write a queued send to a client.
+	writeTimeout = 10 * time.Second
+)
+
+// Server emulates an ESPHome node.
+//
+// The zero value is a server without entities, the Server must not be copied after first use.
+type Server struct {
+	// Info about the node, sent to clients requesting device info. UsesPassword is set if Password is not empty.
+	Info esphome.DeviceInfo
+
+	// ServerInfo identifies the server in the hello handshake.
+	ServerInfo string
+
+	// Password required to connect, empty if no password is required.
+	Password string
+
+	// PSK is the base64 encoded encryption key, if set clients must use the Noise encrypted transport.
+	PSK string
+
+	// Clock returns the current time, used to answer time requests.
+	Clock func() time.Time
+
+	// HandleMessage is called for requests from authenticated clients, that are not handled by the server or an
+	// entity handler. Like entity handlers, it is called from the goroutine reading the requests of the client, so the
+	// next request of the client is read once it returns.
+	HandleMessage func(conn *Conn, message proto.Message)
+
+	initOnce  sync.Once
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	conns     map[*Conn]struct{}
+	entities  []proto.Message
+	handlers  map[uint32]func(proto.Message)
+	states    map[uint32]proto.Message
+	closed    bool
+}
+
+// keyed are listing, state and command messages.
+type keyed interface {
+	GetKey() uint32
+}
+
+func (s *Server) init() {
+	s.initOnce.Do(func() {
+		s.listeners = make(map[net.Listener]struct{})
+		s.conns = make(map[*Conn]struct{})
+		s.handlers = make(map[uint32]func(proto.Message))
+		s.states = make(map[uint32]proto.Message)
+	})
+}
+
+// AddEntity adds an entity, listing is the ListEntities*Response message describing the entity. Commands for the
+// entity, such as a SwitchCommandRequest with the key of the entity, are passed to the handler. The handler may be
+// nil for read-only entities.
+//
+// The handler is called from the goroutine reading the requests of the client, a slow handler delays the next request
+// of that client but not other clients. The handler may call Publish, to report the new state.
+//
+// Entities should be added before serving, clients only list entities when they connect.
+func (s *Server) AddEntity(listing proto.Message, handler func(command proto.Message)) error {
+	entity, ok := listing.(keyed)
+	if !ok {
+		return ErrKey
+	}
+
+	s.init()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, other := range s.entities {
+		if other.(keyed).GetKey() == entity.GetKey() {
+			return ErrDuplicate
+		}
+	}
+	s.entities = append(s.entities, listing)
+	if handler != nil {
+		s.handlers[entity.GetKey()] = handler
+	}
+	return nil
+}
+
+// Publish the state of an entity, state is a *StateResponse message. The state is sent to all clients that
+// subscribed to states, and to clients that subscribe later.
+//
+// Publish doesn't wait for the clients, the state is queued while holding the server lock so all clients receive
+// states in the order they are published.
+func (s *Server) Publish(state proto.Message) error {
+	entity, ok := state.(keyed)
+	if !ok {
+		return ErrKey
+	}
+
+	s.init()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[entity.GetKey()] = state
+	for conn := range s.conns {
+		if conn.subscribedStates() {
+			_ = conn.Send(state)
+		}
+	}
+	return nil
+}
+
+// Log sends a log message to all clients that subscribed to logs at level or higher.
+func (s *Server) Log(level esphome.LogLevel, tag, message string) {
+	s.init()
+	s.mu.Lock()
+	conns := s.connections()
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		if conn.subscribedLogs(level) {
+			_ = conn.Send(&api.SubscribeLogsResponse{
+				Level:   api.LogLevel(level),
+				Tag:     tag,
+				Message: message,
+			})
+		}
+	}
+}
+
+// ListenAndServe listens on the TCP address and serves clients. ESPHome nodes listen on port 6053.
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve clients on the listener, until the server is closed. Serve always returns a non-nil error.
+func (s *Server) Serve(l net.Listener) error {
+	var psk []byte
+	if s.PSK != "" {
+		var err error
+		if psk, err = base64.StdEncoding.DecodeString(s.PSK); err != nil || len(psk) != 32 {
+			_ = l.Close()
+			return ErrPSK
+		}
+	}
+
+	s.init()
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		_ = l.Close()
+		return ErrClosed
+	}
+	s.listeners[l] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.listeners, l)
+		s.mu.Unlock()
+	}()
+
+	for {
+		netConn, err := l.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return ErrClosed
+			}
+			return err
+		}
+
+		conn := &Conn{
+			Conn:   netConn,
+			server: s,
+			psk:    psk,
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = netConn.Close()
+			return ErrClosed
+		}
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		go conn.serve()
+	}
+}
+
+// Close stops serving and closes all client connections.
+func (s *Server) Close() error {
+	s.init()
+	s.mu.Lock()
+	s.closed = true
+	var err error
+	for l := range s.listeners {
+		if closeErr := l.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	conns := s.connections()
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+	return err
+}
+
+// connections returns a snapshot of the connected clients, the server lock must be held.
+func (s *Server) connections() []*Conn {
+	conns := make([]*Conn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+func (s *Server) remove(conn *Conn) {
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+}
+
+func (s *Server) now() time.Time {
+	if s.Clock != nil {
+		return s.Clock()
+	}
+	return time.Now()
+}
+
+func (s *Server) deviceInfo() *api.DeviceInfoResponse {
+	return &api.DeviceInfoResponse{
+		UsesPassword:    s.Password != "",
+		Name:            s.Info.Name,
+		MacAddress:      s.Info.MacAddress,
+		EsphomeVersion:  s.Info.EsphomeVersion,
+		CompilationTime: s.Info.CompilationTime,
+		Model:           s.Info.Model,
+		HasDeepSleep:    s.Info.HasDeepSleep,
+	}
+}
+
+// Conn is a client connection.
+type Conn struct {
+	net.Conn
+
+	server        *Server
+	psk           []byte
+	mu            sync.Mutex
+	queue         chan []proto.Message // nil once closed
+	clientInfo    string
+	authenticated bool
+	states        bool
+	logs          bool
+	logLevel      esphome.LogLevel
+}
+
+// Send a message to the client. The message is queued and written in order by a goroutine of the connection, Send
+// doesn't wait for the client. If the client doesn't keep up and the queue is full, the connection is closed and
+// ErrOverflow is returned.
+func (conn *Conn) Send(message proto.Message) error {
+	return conn.send(message)
+}
+
+// send queues messages, they are written without other messages in between.
+func (conn *Conn) send(messages ...proto.Message) error {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	if conn.queue == nil {
+		return ErrClosed
+	}
+	select {
+	case conn.queue <- messages:
+		return nil
+	default:
+		// Drop the client, instead of holding up the server.
+		conn.closeQueue()
+		_ = conn.Conn.Close()
+		return ErrOverflow
+	}
+}
+
+// closeQueue closes the send queue, the writer exits once the queued messages are written. The conn lock must be
+// held.
+func (conn *Conn) closeQueue() {
+	if conn.queue != nil {
+		close(conn.queue)
+		conn.queue = nil
+	}
+}
+
+// write the queued messages until the queue is closed, and then close the connection.
+func (conn *Conn) write(frames api.FrameHelper, queue <-chan []proto.Message) {
+	defer conn.Close()
+	for messages := range queue {
+		if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			return
+		}
+		for _, message := range messages {
+			if err := frames.WriteMessage(message); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ClientInfo returns the client identification sent in the hello handshake.
+func (conn *Conn) ClientInfo() string {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	return conn.clientInfo
+}
+
+func (conn *Conn) subscribedStates() bool {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	return conn.states
+}
+
+func (conn *Conn) subscribedLogs(level esphome.LogLevel) bool {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	return conn.logs && level <= conn.logLevel
+}
+
+func (conn *Conn) serve() {
+	defer conn.server.remove(conn)
+
+	frames := api.NewPlaintextFrameHelper(conn)
+	if conn.psk != nil {
+		var err error
+		if frames, err = api.NewNoiseServerFrameHelper(conn, conn.psk, conn.server.Info.Name); err != nil {
+			_ = conn.Close()
+			return
+		}
+	}
+
+	// Responses and published messages are written by the writer, which closes the connection once the queue is
+	// closed and the pending messages are written.
+	queue := make(chan []proto.Message, sendQueueSize)
+	conn.mu.Lock()
+	conn.queue = queue
+	conn.mu.Unlock()
+	go conn.write(frames, queue)
+	defer func() {
+		conn.mu.Lock()
+		conn.closeQueue()
+		conn.mu.Unlock()
+	}()
+
+	for {
+		message, err := frames.ReadMessage()
+		if _, ok := err.(api.UnknownTypeError); ok {
+			// Like ESPHome nodes, ignore messages of unknown type.
+			continue
+		} else if err != nil {
+			return
+		}
+		if !conn.handle(message) {
+			return
+		}
+	}
+}
+
+// handle a message from the client, it reports if the connection should be kept open.
+func (conn *Conn) handle(message proto.Message) bool {
+	s := conn.server
+	switch message := message.(type) {
+	case *api.HelloRequest:
+		conn.mu.Lock()
+		conn.clientInfo = message.ClientInfo
+		conn.mu.Unlock()
+		return conn.Send(&api.HelloResponse{
+			ApiVersionMajor: APIVersionMajor,
+			ApiVersionMinor: APIVersionMinor,
+			ServerInfo:      s.ServerInfo,
+		}) == nil
+
+	case *api.ConnectRequest:
+		invalid := s.Password != "" && message.Password != s.Password
+		if !invalid {
+			conn.mu.Lock()
+			conn.authenticated = true
+			conn.mu.Unlock()
+		}
+		return conn.Send(&api.ConnectResponse{InvalidPassword: invalid}) == nil
+
+	case *api.DisconnectRequest:
+		_ = conn.Send(&api.DisconnectResponse{})
+		return false
+
+	case *api.DisconnectResponse:
+		return false
+
+	case *api.PingRequest:
+		return conn.Send(&api.PingResponse{}) == nil
+
+	case *api.DeviceInfoRequest:
+		return conn.Send(s.deviceInfo()) == nil
+
+	case *api.GetTimeRequest:
+		return conn.Send(&api.GetTimeResponse{EpochSeconds: uint32(s.now().Unix())}) == nil
+	}
+
+	conn.mu.Lock()
+	authenticated := conn.authenticated
+	conn.mu.Unlock()
+	if !authenticated {
+		// Like ESPHome nodes, drop unauthenticated clients.
+		return false
+	}
+
+	switch message := message.(type) {
+	case *api.ListEntitiesRequest:
+		s.mu.Lock()
+		entities := append([]proto.Message(nil), s.entities...)
+		s.mu.Unlock()
+		return conn.send(append(entities, &api.ListEntitiesDoneResponse{})...) == nil
+
+	case *api.SubscribeStatesRequest:
+		// Subscribe and queue the current states while holding the server lock, so states published concurrently
+		// are queued after them.
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		conn.mu.Lock()
+		conn.states = true
+		conn.mu.Unlock()
+		if len(s.states) == 0 {
+			return true
+		}
+		states := make([]proto.Message, 0, len(s.states))
+		for _, state := range s.states {
+			states = append(states, state)
+		}
+		return conn.send(states...) == nil
+
+	case *api.SubscribeLogsRequest:
+		conn.mu.Lock()
+		conn.logs = true
+		conn.logLevel = esphome.LogLevel(message.Level)
+		conn.mu.Unlock()
+		return true
+
+	case *api.PingResponse, *api.GetTimeResponse:
+		return true
+	}
+
+	if command, ok := message.(keyed); ok {
+		s.mu.Lock()
+		handler := s.handlers[command.GetKey()]
+		s.mu.Unlock()
+		if handler != nil {
+			handler(message)
+			return true
+		}
+	}
+	if s.HandleMessage != nil {
+		s.HandleMessage(conn, message)
+	}
+	return true
+}
@@ -0,0 +1,312 @@
+package server
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"maze.io/x/esphome"
+	"maze.io/x/esphome/api"
+)
+
+func testServer(t *testing.T, s *Server) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(l)
+	return l.Addr().String()
+}
+
+func testClient(t *testing.T, addr, password string) *esphome.Client {
+	t.Helper()
+	c, err := esphome.DialTimeout(addr, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Login(password); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestServer(t *testing.T) {
+	var (
+		s        = &Server{Info: esphome.DeviceInfo{Name: "virtual"}}
+		commands = make(chan proto.Message, 1)
+	)
+	if err := s.AddEntity(&api.ListEntitiesSwitchResponse{ObjectId: "relay", Key: 1, UniqueId: "virtual-relay"}, func(command proto.Message) {
+		commands <- command
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddEntity(&api.ListEntitiesSwitchResponse{Key: 1}, nil); err != ErrDuplicate {
+		t.Fatalf("expected %v, got %v", ErrDuplicate, err)
+	}
+	defer s.Close()
+	addr := testServer(t, s)
+
+	// Two clients receive state updates.
+	var clients []*esphome.Client
+	for i := 0; i < 2; i++ {
+		c := testClient(t, addr, "")
+		defer c.Close()
+		clients = append(clients, c)
+	}
+
+	info, err := clients[0].DeviceInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "virtual" || info.UsesPassword {
+		t.Errorf("unexpected device info %+v", info)
+	}
+	if err = clients[0].Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	var events []<-chan esphome.Event
+	for _, c := range clients {
+		ch, cancel := c.Subscribe(esphome.EventFilter{})
+		defer cancel()
+		events = append(events, ch)
+	}
+	// Wait for the state subscriptions to be processed, before publishing.
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		s.mu.Lock()
+		var subscribed int
+		for conn := range s.conns {
+			if conn.subscribedStates() {
+				subscribed++
+			}
+			if info := conn.ClientInfo(); info != "maze.io go/esphome" {
+				t.Errorf("unexpected client info %q", info)
+			}
+		}
+		s.mu.Unlock()
+		if subscribed == len(clients) {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("expected %d state subscriptions, got %d", len(clients), subscribed)
+		}
+	}
+
+	if err = s.Publish(&api.SwitchStateResponse{Key: 1, State: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range events {
+		select {
+		case event := <-ch:
+			if state, ok := event.(esphome.SwitchStateEvent); !ok || !state.State {
+				t.Errorf("unexpected event %+v", event)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for state")
+		}
+	}
+
+	relay := clients[1].Entities().Switch["virtual-relay"]
+	if relay == nil {
+		t.Fatal("switch not found")
+	}
+	if err = relay.SetState(false); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case command := <-commands:
+		if request, ok := command.(*api.SwitchCommandRequest); !ok || request.State {
+			t.Errorf("unexpected command %+v", command)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for command")
+	}
+}
+
+func TestServerSlowClient(t *testing.T) {
+	s := new(Server)
+	listing := &api.ListEntitiesTextSensorResponse{ObjectId: "message", Key: 1, UniqueId: "virtual-message"}
+	if err := s.AddEntity(listing, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	addr := testServer(t, s)
+
+	// The slow client subscribes to states, but never reads.
+	slow, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer slow.Close()
+	for _, request := range []proto.Message{&api.HelloRequest{}, &api.ConnectRequest{}, &api.SubscribeStatesRequest{}} {
+		packed, err := api.Marshal(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = slow.Write(packed); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := testClient(t, addr, "")
+	defer c.Close()
+	events, cancel := c.Subscribe(esphome.EventFilter{})
+	defer cancel()
+
+	subscribed := func() (n int) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for conn := range s.conns {
+			if conn.subscribedStates() {
+				n++
+			}
+		}
+		return
+	}
+	for deadline := time.Now().Add(time.Second); subscribed() != 2; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 2 state subscriptions, got %d", subscribed())
+		}
+	}
+
+	// Publish more than the slow client can buffer, the other client keeps receiving states.
+	message := strings.Repeat("x", 1<<16)
+	for i := 0; i < 400; i++ {
+		state := message + strconv.Itoa(i)
+		published := make(chan error, 1)
+		go func() {
+			published <- s.Publish(&api.TextSensorStateResponse{Key: 1, State: state})
+		}()
+		select {
+		case err = <-published:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("publish %d blocked by the slow client", i)
+		}
+
+		select {
+		case event := <-events:
+			if update, ok := event.(esphome.TextSensorStateEvent); !ok || update.State != state {
+				t.Fatalf("unexpected event %T", event)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for state %d", i)
+		}
+	}
+
+	for deadline := time.Now().Add(time.Second); subscribed() != 1; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the slow client to be dropped")
+		}
+	}
+}
+
+func TestServerLogs(t *testing.T) {
+	s := new(Server)
+	defer s.Close()
+	addr := testServer(t, s)
+
+	c := testClient(t, addr, "")
+	defer c.Close()
+
+	logs, err := c.Logs(esphome.LogInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		s.mu.Lock()
+		var subscribed bool
+		for conn := range s.conns {
+			subscribed = conn.subscribedLogs(esphome.LogInfo)
+		}
+		s.mu.Unlock()
+		if subscribed {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatal("expected log subscription")
+		}
+	}
+
+	s.Log(esphome.LogDebug, "test", "too verbose")
+	s.Log(esphome.LogInfo, "test", "hello")
+	select {
+	case entry := <-logs:
+		if entry.Message != "hello" || entry.Tag != "test" {
+			t.Errorf("unexpected log entry %+v", entry)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for log entry")
+	}
+}
+
+func TestServerPassword(t *testing.T) {
+	s := &Server{Password: "secret"}
+	defer s.Close()
+	addr := testServer(t, s)
+
+	c, err := esphome.DialTimeout(addr, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.Login("wrong"); err != esphome.ErrPassword {
+		t.Fatalf("expected %v, got %v", esphome.ErrPassword, err)
+	}
+
+	c = testClient(t, addr, "secret")
+	defer c.Close()
+}
+
+func TestServerEncryption(t *testing.T) {
+	const psk = "px7tsbK3C7bpXHr2OevEV2ZMg/FrNBw2+O2pNPbedtA="
+
+	s := &Server{PSK: psk, Info: esphome.DeviceInfo{Name: "encrypted"}}
+	defer s.Close()
+	addr := testServer(t, s)
+
+	c, err := esphome.DialEncrypted(addr, psk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.Login(""); err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.DeviceInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "encrypted" {
+		t.Errorf("unexpected device info %+v", info)
+	}
+
+	t.Run("wrong key", func(it *testing.T) {
+		if _, err := esphome.DialEncrypted(addr, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="); !errors.Is(err, esphome.ErrHandshake) {
+			it.Fatalf("expected %v, got %v", esphome.ErrHandshake, err)
+		}
+	})
+
+	t.Run("invalid key", func(it *testing.T) {
+		if _, err := esphome.DialEncrypted(addr, "secret"); err != esphome.ErrEncryptionKey {
+			it.Fatalf("expected %v, got %v", esphome.ErrEncryptionKey, err)
+		}
+	})
+
+	t.Run("plaintext", func(it *testing.T) {
+		c, err := esphome.DialTimeout(addr, time.Second)
+		if err != nil {
+			it.Fatal(err)
+		}
+		defer c.Close()
+		if err = c.Login(""); err != esphome.ErrEncryptionRequired {
+			it.Fatalf("expected %v, got %v", esphome.ErrEncryptionRequired, err)
+		}
+	})
+}
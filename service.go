@@ -0,0 +1,310 @@
+package esphome
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"maze.io/x/esphome/api"
+)
+
+// Service is a user-defined service, that can be executed by the client.
+//
+// Services don't have an object identifier, the ObjectID of the service is its name.
+type Service struct {
+	Entity
+
+	// Args are the arguments of the service, in order.
+	Args []ServiceArg
+}
+
+// ServiceArg is a service argument.
+type ServiceArg struct {
+	Name string
+	Type ServiceArgType
+}
+
+// ServiceArgType is the type of a service argument.
+type ServiceArgType int32
+
+// Service argument types.
+const (
+	ServiceArgBool ServiceArgType = iota
+	ServiceArgInt
+	ServiceArgFloat
+	ServiceArgString
+	ServiceArgBoolArray
+	ServiceArgIntArray
+	ServiceArgFloatArray
+	ServiceArgStringArray
+)
+
+func (t ServiceArgType) String() string {
+	switch t {
+	case ServiceArgBool:
+		return "bool"
+	case ServiceArgInt:
+		return "int"
+	case ServiceArgFloat:
+		return "float"
+	case ServiceArgString:
+		return "string"
+	case ServiceArgBoolArray:
+		return "bool[]"
+	case ServiceArgIntArray:
+		return "int[]"
+	case ServiceArgFloatArray:
+		return "float[]"
+	case ServiceArgStringArray:
+		return "string[]"
+	default:
+		return "unknown"
+	}
+}
+
+func newService(client *Client, entity *api.ListEntitiesServicesResponse) *Service {
+	args := make([]ServiceArg, len(entity.Args))
+	for i, arg := range entity.Args {
+		args[i] = ServiceArg{
+			Name: arg.Name,
+			Type: ServiceArgType(arg.Type),
+		}
+	}
+	return &Service{
+		Entity: Entity{
+			Name:     entity.Name,
+			ObjectID: entity.Name,
+			Key:      entity.Key,
+			client:   client,
+		},
+		Args: args,
+	}
+}
+
+// rebind replaces the listing of entity with listing.
+func (entity *Service) rebind(listing *Service) {
+	entity.Entity.rebind(&listing.Entity)
+	entity.Args = listing.Args
+}
+
+// snapshot returns a copy of entity, taken while holding the client lock.
+func (entity *Service) snapshot() Service {
+	entity.client.mu.RLock()
+	defer entity.client.mu.RUnlock()
+	return *entity
+}
+
+// Execute the service. All arguments of the service must be supplied, with a Go value matching the argument type:
+//
+//   - bool: bool
+//   - int: any integer type, in the int32 range
+//   - float: float32, float64 or any integer type
+//   - string: string
+//   - arrays: a slice of any of the above, including []interface{}
+func (entity *Service) Execute(args map[string]interface{}) error {
+	ctx, cancel := entity.client.context()
+	defer cancel()
+	return timeoutError(entity.ExecuteContext(ctx, args))
+}
+
+// ExecuteContext is like Execute with a context.
+func (entity *Service) ExecuteContext(ctx context.Context, args map[string]interface{}) error {
+	current := entity.snapshot()
+	for name := range args {
+		if !current.hasArg(name) {
+			return ArgumentError{Service: current.Name, Argument: name, Reason: "unknown argument"}
+		}
+	}
+
+	current.client.mu.RLock()
+	signed := current.client.apiVersion.atLeast(1, 3)
+	current.client.mu.RUnlock()
+
+	request := &api.ExecuteServiceRequest{
+		Key:  current.Key,
+		Args: make([]*api.ExecuteServiceArgument, len(current.Args)),
+	}
+	for i, arg := range current.Args {
+		value, ok := args[arg.Name]
+		if !ok {
+			return ArgumentError{Service: current.Name, Argument: arg.Name, Reason: "missing argument"}
+		}
+		encoded, err := encodeServiceArg(arg.Type, value, signed)
+		if err != nil {
+			return ArgumentError{Service: current.Name, Argument: arg.Name, Reason: err.Error()}
+		}
+		request.Args[i] = encoded
+	}
+	return current.client.sendContext(ctx, request)
+}
+
+func (entity Service) hasArg(name string) bool {
+	for _, arg := range entity.Args {
+		if arg.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeServiceArg encodes a service argument. Before API 1.3 (ESPHome 1.14) integers were sent as unsigned
+// legacy_int, signed indicates if the node expects the signed int_ field.
+func encodeServiceArg(t ServiceArgType, value interface{}, signed bool) (*api.ExecuteServiceArgument, error) {
+	var (
+		arg = new(api.ExecuteServiceArgument)
+		err error
+	)
+	switch t {
+	case ServiceArgBool:
+		arg.Bool_, err = toBool(value)
+	case ServiceArgInt:
+		var v int32
+		if v, err = toInt32(value); signed {
+			arg.Int_ = v
+		} else {
+			arg.LegacyInt = v
+		}
+	case ServiceArgFloat:
+		arg.Float_, err = toFloat32(value)
+	case ServiceArgString:
+		arg.String_, err = toString(value)
+	case ServiceArgBoolArray:
+		err = eachValue(value, func(v interface{}) error {
+			b, err := toBool(v)
+			arg.BoolArray = append(arg.BoolArray, b)
+			return err
+		})
+	case ServiceArgIntArray:
+		err = eachValue(value, func(v interface{}) error {
+			i, err := toInt32(v)
+			arg.IntArray = append(arg.IntArray, i)
+			return err
+		})
+	case ServiceArgFloatArray:
+		err = eachValue(value, func(v interface{}) error {
+			f, err := toFloat32(v)
+			arg.FloatArray = append(arg.FloatArray, f)
+			return err
+		})
+	case ServiceArgStringArray:
+		err = eachValue(value, func(v interface{}) error {
+			s, err := toString(v)
+			arg.StringArray = append(arg.StringArray, s)
+			return err
+		})
+	default:
+		err = fmt.Errorf("unsupported argument type %d", t)
+	}
+	return arg, err
+}
+
+func toBool(value interface{}) (bool, error) {
+	if v, ok := value.(bool); ok {
+		return v, nil
+	}
+	return false, fmt.Errorf("expected bool, got %T", value)
+}
+
+func toInt32(value interface{}) (int32, error) {
+	var v int64
+	switch value := value.(type) {
+	case int:
+		v = int64(value)
+	case int8:
+		v = int64(value)
+	case int16:
+		v = int64(value)
+	case int32:
+		v = int64(value)
+	case int64:
+		v = value
+	case uint:
+		if uint64(value) > math.MaxInt32 {
+			return 0, fmt.Errorf("value %d out of range", value)
+		}
+		v = int64(value)
+	case uint8:
+		v = int64(value)
+	case uint16:
+		v = int64(value)
+	case uint32:
+		v = int64(value)
+	case uint64:
+		if value > math.MaxInt32 {
+			return 0, fmt.Errorf("value %d out of range", value)
+		}
+		v = int64(value)
+	default:
+		return 0, fmt.Errorf("expected int, got %T", value)
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, fmt.Errorf("value %d out of range", v)
+	}
+	return int32(v), nil
+}
+
+func toFloat32(value interface{}) (float32, error) {
+	switch value := value.(type) {
+	case float32:
+		return value, nil
+	case float64:
+		return float32(value), nil
+	}
+	v, err := toInt32(value)
+	if err != nil {
+		return 0, fmt.Errorf("expected float, got %T", value)
+	}
+	return float32(v), nil
+}
+
+func toString(value interface{}) (string, error) {
+	if v, ok := value.(string); ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("expected string, got %T", value)
+}
+
+// eachValue calls fn for each element of a slice value.
+func eachValue(value interface{}, fn func(interface{}) error) error {
+	var values []interface{}
+	switch value := value.(type) {
+	case []interface{}:
+		values = value
+	case []bool:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	case []int:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	case []int32:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	case []int64:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	case []float32:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	case []float64:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	case []string:
+		for _, v := range value {
+			values = append(values, v)
+		}
+	default:
+		return fmt.Errorf("expected array, got %T", value)
+	}
+	for i, v := range values {
+		if err := fn(v); err != nil {
+			return fmt.Errorf("element %d: %v", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package esphome
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"maze.io/x/esphome/api"
+)
+
+func TestServiceExecute(t *testing.T) {
+	for _, test := range []struct {
+		Name        string
+		Minor       uint32
+		Int, Legacy int32
+	}{
+		{"signed", 3, -5, 0},
+		{"legacy", 2, 0, -5},
+	} {
+		t.Run(test.Name, func(it *testing.T) {
+			d := newTestDevice(it, &api.ListEntitiesServicesResponse{
+				Name: "calibrate",
+				Key:  1,
+				Args: []*api.ListEntitiesServicesArgument{
+					{Name: "offset", Type: api.ServiceArgType_SERVICE_ARG_TYPE_INT},
+					{Name: "factors", Type: api.ServiceArgType_SERVICE_ARG_TYPE_FLOAT_ARRAY},
+				},
+			})
+			d.minor = test.Minor
+			defer d.Close()
+
+			c := testClient(it, d)
+			defer c.Close()
+
+			service := c.Entities().Service["calibrate"]
+			if service == nil {
+				it.Fatal("service not found")
+			}
+			if err := service.Execute(map[string]interface{}{
+				"offset":  -5,
+				"factors": []interface{}{1, 0.5},
+			}); err != nil {
+				it.Fatal(err)
+			}
+
+			request := lastRequest(it, d, api.ExecuteServiceRequestType, 1).(*api.ExecuteServiceRequest)
+			if len(request.Args) != 2 {
+				it.Fatalf("expected 2 arguments, got %d", len(request.Args))
+			}
+			if arg := request.Args[0]; arg.Int_ != test.Int || arg.LegacyInt != test.Legacy {
+				it.Errorf("unexpected int argument %+v", arg)
+			}
+			if arg := request.Args[1]; !reflect.DeepEqual(arg.FloatArray, []float32{1, 0.5}) {
+				it.Errorf("unexpected float array argument %+v", arg)
+			}
+		})
+	}
+}
+
+func TestServiceExecuteInvalid(t *testing.T) {
+	service := newService(new(Client), &api.ListEntitiesServicesResponse{
+		Name: "restart",
+		Args: []*api.ListEntitiesServicesArgument{
+			{Name: "safe_mode", Type: api.ServiceArgType_SERVICE_ARG_TYPE_BOOL},
+		},
+	})
+	for _, args := range []map[string]interface{}{
+		{},
+		{"safe_mode": true, "delay": 10},
+		{"safe_mode": "yes"},
+	} {
+		if err := service.ExecuteContext(context.Background(), args); err == nil {
+			t.Errorf("expected error for %v", args)
+		} else if _, ok := err.(ArgumentError); !ok {
+			t.Errorf("expected ArgumentError, got %T", err)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package esphome
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// TemplateRenderer renders Home Assistant data templates, see HomeAssistantData.Render.
+type TemplateRenderer interface {
+	Render(template string, variables map[string]string) (string, error)
+}
+
+// GoTemplateRenderer renders data templates as Go text/template, variables are accessed as fields of dot, for
+// example {{ .brightness }}.
+type GoTemplateRenderer struct {
+	// Funcs are additional template functions.
+	Funcs template.FuncMap
+}
+
+// Render implements TemplateRenderer.
+func (renderer GoTemplateRenderer) Render(text string, variables map[string]string) (string, error) {
+	t, err := template.New("").Funcs(renderer.Funcs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buffer bytes.Buffer
+	if err = t.Execute(&buffer, variables); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// JinjaRenderer renders the subset of Jinja templates used by ESPHome data templates. Expressions are a variable
+// or a literal, optionally followed by filters, for example {{ brightness | int }}. The supported filters are
+// default, float, int, lower, round, string, trim and upper. Statements ({% ... %}) are not supported and comments
+// ({# ... #}) are removed.
+type JinjaRenderer struct{}
+
+var (
+	jinjaExpression = regexp.MustCompile(`(?s){{(.*?)}}|{#.*?#}|{%.*?%}`)
+	jinjaFilter     = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*(?:\((.*)\))?$`)
+	jinjaIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// Render implements TemplateRenderer.
+func (JinjaRenderer) Render(text string, variables map[string]string) (string, error) {
+	var err error
+	output := jinjaExpression.ReplaceAllStringFunc(text, func(match string) string {
+		if err != nil {
+			return ""
+		}
+		switch {
+		case strings.HasPrefix(match, "{#"):
+			return ""
+		case strings.HasPrefix(match, "{%"):
+			err = fmt.Errorf("esphome: unsupported template statement %q", match)
+			return ""
+		}
+		var value interface{}
+		if value, err = evalJinja(match[2:len(match)-2], variables); err != nil {
+			return ""
+		}
+		return formatJinja(value)
+	})
+	return output, err
+}
+
+// evalJinja evaluates an expression with filters.
+func evalJinja(expression string, variables map[string]string) (interface{}, error) {
+	parts := splitJinja(expression)
+	value, defined, err := jinjaOperand(strings.TrimSpace(parts[0]), variables)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range parts[1:] {
+		match := jinjaFilter.FindStringSubmatch(strings.TrimSpace(part))
+		if match == nil {
+			return nil, fmt.Errorf("esphome: invalid template filter %q", part)
+		}
+		var arg interface{}
+		if match[2] != "" {
+			if arg, _, err = jinjaOperand(strings.TrimSpace(match[2]), variables); err != nil {
+				return nil, err
+			}
+		}
+		switch match[1] {
+		case "default", "d":
+			if !defined {
+				value, defined = arg, true
+			}
+		case "float":
+			value = jinjaFloat(value)
+		case "int":
+			value = int64(jinjaFloat(value))
+		case "round":
+			precision := 0
+			if arg != nil {
+				precision = int(jinjaFloat(arg))
+			}
+			scale := math.Pow(10, float64(precision))
+			value = math.Round(jinjaFloat(value)*scale) / scale
+		case "lower":
+			value = strings.ToLower(formatJinja(value))
+		case "upper":
+			value = strings.ToUpper(formatJinja(value))
+		case "string":
+			value = formatJinja(value)
+		case "trim":
+			value = strings.TrimSpace(formatJinja(value))
+		default:
+			return nil, fmt.Errorf("esphome: unsupported template filter %q", match[1])
+		}
+	}
+	return value, nil
+}
+
+// splitJinja splits an expression into the operand and its filters, pipes in string literals are not split.
+func splitJinja(expression string) []string {
+	var (
+		parts []string
+		quote rune
+		start int
+	)
+	for i, r := range expression {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '|':
+			parts = append(parts, expression[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, expression[start:])
+}
+
+// jinjaOperand evaluates a variable or literal, it reports if a variable is defined.
+func jinjaOperand(operand string, variables map[string]string) (interface{}, bool, error) {
+	switch {
+	case len(operand) >= 2 && (operand[0] == '\'' || operand[0] == '"') && operand[len(operand)-1] == operand[0]:
+		return operand[1 : len(operand)-1], true, nil
+	case jinjaIdentifier.MatchString(operand):
+		switch operand {
+		case "true", "True":
+			return true, true, nil
+		case "false", "False":
+			return false, true, nil
+		}
+		value, ok := variables[operand]
+		return value, ok, nil
+	}
+	if i, err := strconv.ParseInt(operand, 10, 64); err == nil {
+		return i, true, nil
+	}
+	if f, err := strconv.ParseFloat(operand, 64); err == nil {
+		return f, true, nil
+	}
+	return nil, false, fmt.Errorf("esphome: unsupported template expression %q", operand)
+}
+
+// jinjaFloat converts a value to a float like Jinja, invalid values are 0.
+func jinjaFloat(value interface{}) float64 {
+	switch value := value.(type) {
+	case int64:
+		return float64(value)
+	case float64:
+		return value
+	case bool:
+		if value {
+			return 1
+		}
+		return 0
+	case string:
+		f, _ := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+// formatJinja formats a value like Python.
+func formatJinja(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		if value {
+			return "True"
+		}
+		return "False"
+	case float64:
+		s := strconv.FormatFloat(value, 'f', -1, 64)
+		if !strings.ContainsAny(s, ".eIN") {
+			s += ".0"
+		}
+		return s
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,52 @@
+package esphome
+
+import "testing"
+
+func TestJinjaRenderer(t *testing.T) {
+	variables := map[string]string{
+		"brightness": "127.6",
+		"name":       " Kitchen ",
+	}
+	tests := []struct {
+		Template, Want string
+	}{
+		{"static", "static"},
+		{"{{ brightness }}", "127.6"},
+		{"{{ brightness | int }}", "127"},
+		{"{{ brightness | float | round }}", "128.0"},
+		{"{{ brightness|round(1) }}", "127.6"},
+		{"{{ name | trim | lower }} light", "kitchen light"},
+		{"{{ missing | default('off') }}", "off"},
+		{"{{ missing }}", ""},
+		{"{# comment #}{{ 'x' | upper }}", "X"},
+		{`{{ "a|b" | upper }}`, "A|B"},
+		{"{{ missing | default('on|off') }}", "on|off"},
+	}
+	for _, test := range tests {
+		t.Run(test.Template, func(it *testing.T) {
+			got, err := JinjaRenderer{}.Render(test.Template, variables)
+			if err != nil {
+				it.Fatal(err)
+			}
+			if got != test.Want {
+				it.Errorf("expected %q, got %q", test.Want, got)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"{% if x %}", "{{ x | unknown }}", "{{ x + 1 }}"} {
+		if _, err := (JinjaRenderer{}).Render(invalid, variables); err == nil {
+			t.Errorf("expected error rendering %q", invalid)
+		}
+	}
+}
+
+func TestGoTemplateRenderer(t *testing.T) {
+	got, err := GoTemplateRenderer{}.Render("{{ .brightness }}%", map[string]string{"brightness": "50"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "50%" {
+		t.Errorf("expected %q, got %q", "50%", got)
+	}
+}